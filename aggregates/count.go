@@ -8,9 +8,10 @@ import (
 
 var CountOverloads = []physical.AggregateDescriptor{
 	{
-		ArgumentType: octosql.Any,
-		OutputType:   octosql.Int,
-		Prototype:    NewCountPrototype(),
+		ArgumentType:  octosql.Any,
+		OutputType:    octosql.Int,
+		Prototype:     NewCountPrototype(),
+		HasEmptyValue: true,
 	},
 }
 
@@ -12,10 +12,11 @@ func DistinctAggregateOverloads(overloads []physical.AggregateDescriptor) []phys
 	out := make([]physical.AggregateDescriptor, len(overloads))
 	for i := range overloads {
 		out[i] = physical.AggregateDescriptor{
-			ArgumentType: overloads[i].ArgumentType,
-			OutputType:   overloads[i].OutputType,
-			TypeFn:       overloads[i].TypeFn,
-			Prototype:    NewDistinctPrototype(overloads[i].Prototype),
+			ArgumentType:  overloads[i].ArgumentType,
+			OutputType:    overloads[i].OutputType,
+			TypeFn:        overloads[i].TypeFn,
+			Prototype:     NewDistinctPrototype(overloads[i].Prototype),
+			HasEmptyValue: overloads[i].HasEmptyValue,
 		}
 	}
 	return out
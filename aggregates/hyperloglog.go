@@ -0,0 +1,159 @@
+package aggregates
+
+import (
+	"math"
+	"math/bits"
+
+	"github.com/cespare/xxhash"
+
+	"github.com/cube2222/octosql/execution/nodes"
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+const (
+	hyperLogLogDefaultPrecision = 14
+	hyperLogLogMinPrecision     = 4
+	hyperLogLogMaxPrecision     = 18
+)
+
+var ApproxCountDistinctOverloads = []physical.AggregateDescriptor{
+	{
+		// The precision is passed as the second item of a tuple.
+		TypeFn: func(t octosql.Type) (octosql.Type, bool) {
+			if t.TypeID != octosql.TypeIDTuple || len(t.Tuple.Elements) != 2 || t.Tuple.Elements[1].Is(octosql.Int) < octosql.TypeRelationIs {
+				return octosql.Type{}, false
+			}
+			return octosql.TypeSum(octosql.Int, octosql.Null), true
+		},
+		Prototype:     NewApproxCountDistinctPrototype(true),
+		HasEmptyValue: true,
+	},
+	{
+		TypeFn: func(t octosql.Type) (octosql.Type, bool) {
+			return octosql.Int, true
+		},
+		Prototype:     NewApproxCountDistinctPrototype(false),
+		HasEmptyValue: true,
+	},
+}
+
+// hyperLogLog estimates the number of distinct values using 2^precision registers.
+// Each value is hashed, the first bits of the hash select a register, and the register keeps
+// the maximum position of the first set bit in the remaining bits.
+// The standard error of the estimate is 1.04/sqrt(2^precision).
+type hyperLogLog struct {
+	precision uint8
+	registers []uint8
+}
+
+func newHyperLogLog(precision uint8) *hyperLogLog {
+	return &hyperLogLog{
+		precision: precision,
+		registers: make([]uint8, 1<<precision),
+	}
+}
+
+func (h *hyperLogLog) add(hash uint64) {
+	index := hash >> (64 - h.precision)
+	// The sentinel bit limits the rank when all remaining bits are zero.
+	rank := uint8(bits.LeadingZeros64(hash<<h.precision|1<<(h.precision-1))) + 1
+	if rank > h.registers[index] {
+		h.registers[index] = rank
+	}
+}
+
+func (h *hyperLogLog) estimate() float64 {
+	m := float64(len(h.registers))
+	sum := 0.0
+	zeros := 0
+	for _, register := range h.registers {
+		sum += math.Ldexp(1, -int(register))
+		if register == 0 {
+			zeros++
+		}
+	}
+
+	var alpha float64
+	switch len(h.registers) {
+	case 16:
+		alpha = 0.673
+	case 32:
+		alpha = 0.697
+	case 64:
+		alpha = 0.709
+	default:
+		alpha = 0.7213 / (1 + 1.079/m)
+	}
+	estimate := alpha * m * m / sum
+
+	// Linear counting is more accurate for small cardinalities.
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return estimate
+}
+
+// ApproxCountDistinct estimates the number of distinct values in the group using a HyperLogLog sketch,
+// whose memory usage only depends on the precision.
+// Retracted values can't be removed from the sketch, so the estimate doesn't decrease after retractions,
+// but it's capped at the number of values currently in the group, and the sketch is reset when the group becomes empty.
+type ApproxCountDistinct struct {
+	sketch *hyperLogLog
+	count  int
+	// If withPrecision is set, each value comes in a tuple with the precision.
+	withPrecision bool
+	invalid       bool
+}
+
+func NewApproxCountDistinctPrototype(withPrecision bool) func() nodes.Aggregate {
+	return func() nodes.Aggregate {
+		return &ApproxCountDistinct{
+			withPrecision: withPrecision,
+		}
+	}
+}
+
+func (c *ApproxCountDistinct) Add(retraction bool, value octosql.Value) bool {
+	precision := hyperLogLogDefaultPrecision
+	if c.withPrecision {
+		value, precision = value.Tuple[0], value.Tuple[1].Int
+	}
+	if value.TypeID == octosql.TypeIDNull {
+		return c.count == 0
+	}
+
+	if retraction {
+		c.count--
+		if c.count == 0 {
+			c.sketch = nil
+			c.invalid = false
+		}
+		return c.count == 0
+	}
+
+	c.count++
+	if c.sketch == nil {
+		if precision < hyperLogLogMinPrecision || precision > hyperLogLogMaxPrecision {
+			c.invalid = true
+			return false
+		}
+		c.sketch = newHyperLogLog(uint8(precision))
+	}
+	c.sketch.add(xxhash.Sum64String(value.String()))
+	return false
+}
+
+func (c *ApproxCountDistinct) Trigger() octosql.Value {
+	if c.invalid {
+		return octosql.NewNull()
+	}
+	if c.sketch == nil {
+		return octosql.NewInt(0)
+	}
+	estimate := int(math.Round(c.sketch.estimate()))
+	if estimate > c.count {
+		estimate = c.count
+	}
+	return octosql.NewInt(estimate)
+}
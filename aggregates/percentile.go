@@ -0,0 +1,158 @@
+package aggregates
+
+import (
+	"math"
+
+	"github.com/tidwall/btree"
+
+	"github.com/cube2222/octosql/execution/nodes"
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+// fractionArgumentTypeFn returns a TypeFn for aggregates taking a value and a fraction as a tuple.
+// The value type must satisfy valueTypeFn, which returns the output type.
+func fractionArgumentTypeFn(valueTypeFn func(octosql.Type) (octosql.Type, bool)) func(octosql.Type) (octosql.Type, bool) {
+	return func(t octosql.Type) (octosql.Type, bool) {
+		if t.TypeID != octosql.TypeIDTuple || len(t.Tuple.Elements) != 2 {
+			return octosql.Type{}, false
+		}
+		if t.Tuple.Elements[1].Is(octosql.TypeSum(octosql.Int, octosql.Float)) < octosql.TypeRelationIs {
+			return octosql.Type{}, false
+		}
+		return valueTypeFn(t.Tuple.Elements[0])
+	}
+}
+
+func numericToFloatTypeFn(t octosql.Type) (octosql.Type, bool) {
+	if t.Is(numeric) < octosql.TypeRelationIs {
+		return octosql.Type{}, false
+	}
+	return octosql.TypeSum(octosql.Float, octosql.Null), true
+}
+
+var (
+	PercentileContinuousOverloads = []physical.AggregateDescriptor{
+		{
+			TypeFn:    fractionArgumentTypeFn(numericToFloatTypeFn),
+			Prototype: NewPercentilePrototype(true, 0, true),
+		},
+	}
+	PercentileDiscreteOverloads = []physical.AggregateDescriptor{
+		{
+			TypeFn: fractionArgumentTypeFn(func(t octosql.Type) (octosql.Type, bool) {
+				// Values are ordered by type first, so Ints and Floats can't be mixed.
+				if octosql.Int.Is(t) != octosql.TypeRelationIsnt && octosql.Float.Is(t) != octosql.TypeRelationIsnt {
+					return octosql.Type{}, false
+				}
+				return octosql.TypeSum(octosql.NonNullable(t), octosql.Null), true
+			}),
+			Prototype: NewPercentilePrototype(true, 0, false),
+		},
+	}
+	MedianOverloads = []physical.AggregateDescriptor{
+		{
+			TypeFn:    numericToFloatTypeFn,
+			Prototype: NewPercentilePrototype(false, 0.5, true),
+		},
+	}
+)
+
+// Percentile keeps all the values in the group, so that it can compute exact percentiles and handle retractions.
+type Percentile struct {
+	items *btree.Generic[*percentileKey]
+	count int
+	// If withFraction is set, each value comes in a tuple with the fraction, otherwise the fraction is fixed.
+	withFraction bool
+	fraction     float64
+	continuous   bool
+}
+
+func NewPercentilePrototype(withFraction bool, fraction float64, continuous bool) func() nodes.Aggregate {
+	return func() nodes.Aggregate {
+		return &Percentile{
+			items: btree.NewGenericOptions(func(key, than *percentileKey) bool {
+				return key.value.Compare(than.value) == -1
+			}, btree.Options{NoLocks: true}),
+			withFraction: withFraction,
+			fraction:     fraction,
+			continuous:   continuous,
+		}
+	}
+}
+
+type percentileKey struct {
+	value octosql.Value
+	count int
+}
+
+func (c *Percentile) Add(retraction bool, value octosql.Value) bool {
+	if c.withFraction {
+		value, c.fraction = value.Tuple[0], numericToFloat(value.Tuple[1])
+	}
+	if value.TypeID == octosql.TypeIDNull {
+		return c.count == 0
+	}
+	if c.continuous {
+		// Ints and Floats may be mixed, so they're all ordered as Floats.
+		value = octosql.NewFloat(numericToFloat(value))
+	}
+
+	var hint btree.PathHint
+
+	item, ok := c.items.GetHint(&percentileKey{value: value}, &hint)
+	if !ok {
+		item = &percentileKey{value: value, count: 0}
+		c.items.SetHint(item, &hint)
+	}
+	if !retraction {
+		item.count++
+		c.count++
+	} else {
+		item.count--
+		c.count--
+	}
+	if item.count == 0 {
+		c.items.DeleteHint(item, &hint)
+	}
+	return c.count == 0
+}
+
+func (c *Percentile) Trigger() octosql.Value {
+	if c.count == 0 || !validFraction(c.fraction) {
+		return octosql.NewNull()
+	}
+
+	if !c.continuous {
+		// The first value for which the fraction of values less than or equal to it is at least the requested fraction.
+		index := int(math.Ceil(c.fraction*float64(c.count))) - 1
+		if index < 0 {
+			index = 0
+		}
+		return c.nth(index)
+	}
+
+	// Interpolate between the two values closest to the requested position.
+	position := c.fraction * float64(c.count-1)
+	lower := c.nth(int(math.Floor(position))).Float
+	upper := c.nth(int(math.Ceil(position))).Float
+	return octosql.NewFloat(lower + (position-math.Floor(position))*(upper-lower))
+}
+
+// nth returns the value at the given 0-based position in the sorted values, counting duplicates.
+func (c *Percentile) nth(index int) octosql.Value {
+	var out octosql.Value
+	c.items.Scan(func(item *percentileKey) bool {
+		if index < item.count {
+			out = item.value
+			return false
+		}
+		index -= item.count
+		return true
+	})
+	return out
+}
+
+func validFraction(fraction float64) bool {
+	return fraction >= 0 && fraction <= 1
+}
@@ -0,0 +1,181 @@
+package aggregates
+
+import (
+	"math"
+
+	"github.com/cube2222/octosql/execution/nodes"
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+var numeric = octosql.TypeSum(octosql.TypeSum(octosql.Int, octosql.Float), octosql.Null)
+
+// statisticOverloads returns the overloads of an aggregate over a single numeric argument.
+// Ints and floats may be mixed.
+func statisticOverloads(statistic func(m *moments) (float64, bool)) []physical.AggregateDescriptor {
+	return []physical.AggregateDescriptor{
+		{
+			TypeFn: func(t octosql.Type) (octosql.Type, bool) {
+				if t.Is(numeric) < octosql.TypeRelationIs {
+					return octosql.Type{}, false
+				}
+				return octosql.TypeSum(octosql.Float, octosql.Null), true
+			},
+			Prototype: NewStatisticPrototype(statistic),
+		},
+	}
+}
+
+// pairStatisticOverloads returns the overloads of an aggregate over a pair of numeric arguments, which are passed as a tuple.
+func pairStatisticOverloads(statistic func(m *moments) (float64, bool)) []physical.AggregateDescriptor {
+	return []physical.AggregateDescriptor{
+		{
+			TypeFn: func(t octosql.Type) (octosql.Type, bool) {
+				if t.TypeID != octosql.TypeIDTuple || len(t.Tuple.Elements) != 2 {
+					return octosql.Type{}, false
+				}
+				for _, element := range t.Tuple.Elements {
+					if element.Is(numeric) < octosql.TypeRelationIs {
+						return octosql.Type{}, false
+					}
+				}
+				return octosql.TypeSum(octosql.Float, octosql.Null), true
+			},
+			Prototype: NewStatisticPrototype(statistic),
+		},
+	}
+}
+
+var (
+	VarianceSampleOverloads = statisticOverloads(func(m *moments) (float64, bool) {
+		if m.count < 2 {
+			return 0, false
+		}
+		return m.m2X / float64(m.count-1), true
+	})
+	VariancePopulationOverloads = statisticOverloads(func(m *moments) (float64, bool) {
+		if m.count < 1 {
+			return 0, false
+		}
+		return m.m2X / float64(m.count), true
+	})
+	StddevSampleOverloads = statisticOverloads(func(m *moments) (float64, bool) {
+		if m.count < 2 {
+			return 0, false
+		}
+		return math.Sqrt(m.m2X / float64(m.count-1)), true
+	})
+	StddevPopulationOverloads = statisticOverloads(func(m *moments) (float64, bool) {
+		if m.count < 1 {
+			return 0, false
+		}
+		return math.Sqrt(m.m2X / float64(m.count)), true
+	})
+	CovarianceSampleOverloads = pairStatisticOverloads(func(m *moments) (float64, bool) {
+		if m.count < 2 {
+			return 0, false
+		}
+		return m.cXY / float64(m.count-1), true
+	})
+	CovariancePopulationOverloads = pairStatisticOverloads(func(m *moments) (float64, bool) {
+		if m.count < 1 {
+			return 0, false
+		}
+		return m.cXY / float64(m.count), true
+	})
+	CorrelationOverloads = pairStatisticOverloads(func(m *moments) (float64, bool) {
+		if m.count < 1 || m.m2X == 0 || m.m2Y == 0 {
+			return 0, false
+		}
+		return m.cXY / math.Sqrt(m.m2X*m.m2Y), true
+	})
+	// The first argument is the dependent variable and the second the independent one, as in PostgreSQL.
+	RegressionSlopeOverloads = pairStatisticOverloads(func(m *moments) (float64, bool) {
+		if m.count < 1 || m.m2Y == 0 {
+			return 0, false
+		}
+		return m.cXY / m.m2Y, true
+	})
+)
+
+// moments are the running count, means and sums of squared differences from the means (co-moments) of one or two variables,
+// updated using Welford's algorithm, which is numerically stable and can be reversed to handle retractions.
+type moments struct {
+	count        int
+	meanX, meanY float64
+	m2X, m2Y     float64
+	cXY          float64
+}
+
+func (m *moments) add(x, y float64) {
+	m.count++
+	dx := x - m.meanX
+	dy := y - m.meanY
+	m.meanX += dx / float64(m.count)
+	m.meanY += dy / float64(m.count)
+	m.m2X += dx * (x - m.meanX)
+	m.m2Y += dy * (y - m.meanY)
+	m.cXY += dx * (y - m.meanY)
+}
+
+func (m *moments) remove(x, y float64) {
+	if m.count <= 1 {
+		// Start from scratch, so that rounding errors don't accumulate.
+		*m = moments{}
+		return
+	}
+	m.count--
+	// This reverses add, computing the previous means first.
+	prevMeanX := m.meanX - (x-m.meanX)/float64(m.count)
+	prevMeanY := m.meanY - (y-m.meanY)/float64(m.count)
+	m.m2X -= (x - prevMeanX) * (x - m.meanX)
+	m.m2Y -= (y - prevMeanY) * (y - m.meanY)
+	m.cXY -= (x - prevMeanX) * (y - m.meanY)
+	m.meanX, m.meanY = prevMeanX, prevMeanY
+}
+
+type Statistic struct {
+	moments   moments
+	statistic func(m *moments) (float64, bool)
+}
+
+func NewStatisticPrototype(statistic func(m *moments) (float64, bool)) func() nodes.Aggregate {
+	return func() nodes.Aggregate {
+		return &Statistic{
+			statistic: statistic,
+		}
+	}
+}
+
+func (c *Statistic) Add(retraction bool, value octosql.Value) bool {
+	x, y := value, value
+	if value.TypeID == octosql.TypeIDTuple {
+		x, y = value.Tuple[0], value.Tuple[1]
+		// Pairs with a null are skipped.
+		if x.TypeID == octosql.TypeIDNull || y.TypeID == octosql.TypeIDNull {
+			return c.moments.count == 0
+		}
+	}
+
+	if !retraction {
+		c.moments.add(numericToFloat(x), numericToFloat(y))
+	} else {
+		c.moments.remove(numericToFloat(x), numericToFloat(y))
+	}
+	return c.moments.count == 0
+}
+
+func (c *Statistic) Trigger() octosql.Value {
+	out, ok := c.statistic(&c.moments)
+	if !ok {
+		return octosql.NewNull()
+	}
+	return octosql.NewFloat(out)
+}
+
+func numericToFloat(value octosql.Value) float64 {
+	if value.TypeID == octosql.TypeIDInt {
+		return float64(value.Int)
+	}
+	return value.Float
+}
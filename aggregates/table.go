@@ -45,4 +45,56 @@ var Aggregates = map[string]physical.AggregateDetails{
 		Description: "Returns minimum item in the group.",
 		Descriptors: MinOverloads,
 	},
+	"stddev_samp": {
+		Description: "Returns the sample standard deviation of the items in the group.",
+		Descriptors: StddevSampleOverloads,
+	},
+	"stddev_pop": {
+		Description: "Returns the population standard deviation of the items in the group.",
+		Descriptors: StddevPopulationOverloads,
+	},
+	"var_samp": {
+		Description: "Returns the sample variance of the items in the group.",
+		Descriptors: VarianceSampleOverloads,
+	},
+	"var_pop": {
+		Description: "Returns the population variance of the items in the group.",
+		Descriptors: VariancePopulationOverloads,
+	},
+	"covar_samp": {
+		Description: "Returns the sample covariance of the pairs of items in the group. Pairs containing a null are skipped.",
+		Descriptors: CovarianceSampleOverloads,
+	},
+	"covar_pop": {
+		Description: "Returns the population covariance of the pairs of items in the group. Pairs containing a null are skipped.",
+		Descriptors: CovariancePopulationOverloads,
+	},
+	"corr": {
+		Description: "Returns the correlation coefficient of the pairs of items in the group. Pairs containing a null are skipped.",
+		Descriptors: CorrelationOverloads,
+	},
+	"regr_slope": {
+		Description: "Returns the slope of the least-squares-fit line of the pairs of items in the group, with the first item being the dependent variable and the second the independent one. Pairs containing a null are skipped.",
+		Descriptors: RegressionSlopeOverloads,
+	},
+	"percentile_cont": {
+		Description: "Returns the given percentile of the items in the group, interpolating between the two closest items. Called as percentile_cont(item, fraction), with the fraction between 0 and 1. Returns null if the fraction is out of range.",
+		Descriptors: PercentileContinuousOverloads,
+	},
+	"percentile_disc": {
+		Description: "Returns the first item in the group which is greater than or equal to the given fraction of items. Called as percentile_disc(item, fraction), with the fraction between 0 and 1. Returns null if the fraction is out of range.",
+		Descriptors: PercentileDiscreteOverloads,
+	},
+	"median": {
+		Description: "Returns the median of the items in the group, interpolating between the two middle items if there is an even number of them.",
+		Descriptors: MedianOverloads,
+	},
+	"approx_percentile": {
+		Description: "Returns an approximation of the given percentile of the items in the group, using a t-digest sketch with bounded memory usage. Called as approx_percentile(item, fraction), with the fraction between 0 and 1. The result is exact for small groups. Retracted items are removed from the closest centroid of the sketch, so the result may become less accurate after retractions in large groups.",
+		Descriptors: ApproxPercentileOverloads,
+	},
+	"approx_count_distinct": {
+		Description: "Returns an approximation of the number of distinct items in the group, using a HyperLogLog sketch with memory usage independent of the number of items. Called as approx_count_distinct(item) or approx_count_distinct(item, precision), with the precision between 4 and 18, 14 by default. Higher precision uses 2^precision bytes of memory and has a standard error of 1.04/sqrt(2^precision). Returns null if the precision is out of range. Retracted items can't be removed from the sketch, so the estimate doesn't decrease after retractions, but it never exceeds the number of items in the group.",
+		Descriptors: ApproxCountDistinctOverloads,
+	},
 }
@@ -0,0 +1,189 @@
+package aggregates
+
+import (
+	"math"
+	"sort"
+
+	"github.com/cube2222/octosql/execution/nodes"
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+var ApproxPercentileOverloads = []physical.AggregateDescriptor{
+	{
+		TypeFn:    fractionArgumentTypeFn(numericToFloatTypeFn),
+		Prototype: NewApproxPercentilePrototype(),
+	},
+}
+
+const (
+	tDigestCompression = 100
+	tDigestBufferSize  = 5 * tDigestCompression
+)
+
+type centroid struct {
+	mean   float64
+	weight float64
+}
+
+// tDigest is a merging t-digest, a sketch of a distribution which keeps a bounded number of centroids.
+// Centroids near the tails hold few values, so extreme percentiles are accurate.
+// While there are few enough values, each of them gets its own centroid and percentiles are exact.
+type tDigest struct {
+	// centroids are sorted by mean.
+	centroids []centroid
+	// buffer holds values which haven't been merged into the centroids yet.
+	buffer []centroid
+	count  float64
+	// min and max are the extremes of the added values, used to interpolate beyond the outermost centroids.
+	// They aren't updated on retractions.
+	min, max float64
+}
+
+func (d *tDigest) add(x float64) {
+	if d.count == 0 || x < d.min {
+		d.min = x
+	}
+	if d.count == 0 || x > d.max {
+		d.max = x
+	}
+	d.buffer = append(d.buffer, centroid{mean: x, weight: 1})
+	d.count++
+	if len(d.buffer) >= tDigestBufferSize {
+		d.compress()
+	}
+}
+
+// remove removes the value from the centroid closest to it. This is exact as long as the value has its own centroid.
+func (d *tDigest) remove(x float64) {
+	d.compress()
+	if len(d.centroids) == 0 {
+		return
+	}
+
+	i := sort.Search(len(d.centroids), func(i int) bool { return d.centroids[i].mean >= x })
+	if i == len(d.centroids) || (i > 0 && x-d.centroids[i-1].mean < d.centroids[i].mean-x) {
+		i--
+	}
+
+	d.count--
+	c := &d.centroids[i]
+	if c.weight <= 1 {
+		d.centroids = append(d.centroids[:i], d.centroids[i+1:]...)
+		return
+	}
+	c.mean = (c.mean*c.weight - x) / (c.weight - 1)
+	c.weight--
+	// Keep the centroids sorted.
+	if i > 0 && c.mean < d.centroids[i-1].mean {
+		c.mean = d.centroids[i-1].mean
+	}
+	if i < len(d.centroids)-1 && c.mean > d.centroids[i+1].mean {
+		c.mean = d.centroids[i+1].mean
+	}
+}
+
+// compress merges the buffer into the centroids. Neighbouring centroids are merged as long as
+// the result stays within the size limit given by the k1 scale function, which is smallest at the tails.
+func (d *tDigest) compress() {
+	if len(d.buffer) == 0 {
+		return
+	}
+	all := append(d.centroids, d.buffer...)
+	sort.Slice(all, func(i, j int) bool { return all[i].mean < all[j].mean })
+
+	merged := make([]centroid, 0, len(d.centroids)+1)
+	current := all[0]
+	weightSoFar := 0.0
+	limit := d.count * tDigestQuantile(tDigestScale(0)+1)
+	for _, next := range all[1:] {
+		if weightSoFar+current.weight+next.weight <= limit {
+			current.mean += (next.mean - current.mean) * next.weight / (current.weight + next.weight)
+			current.weight += next.weight
+			continue
+		}
+		weightSoFar += current.weight
+		merged = append(merged, current)
+		limit = d.count * tDigestQuantile(tDigestScale(weightSoFar/d.count)+1)
+		current = next
+	}
+	merged = append(merged, current)
+
+	d.centroids = merged
+	d.buffer = d.buffer[:0]
+}
+
+// tDigestScale is the k1 scale function, mapping a quantile to a centroid index.
+func tDigestScale(q float64) float64 {
+	return tDigestCompression / (2 * math.Pi) * math.Asin(2*q-1)
+}
+
+// tDigestQuantile is the inverse of tDigestScale.
+func tDigestQuantile(k float64) float64 {
+	if k >= tDigestCompression/4 {
+		return 1
+	}
+	return (math.Sin(k*2*math.Pi/tDigestCompression) + 1) / 2
+}
+
+// quantile interpolates between the centroids closest to the requested position.
+// Each centroid is placed at the middle position of the values it holds,
+// so that if all centroids hold single values, the result is the same as percentile_cont.
+func (d *tDigest) quantile(q float64) float64 {
+	d.compress()
+	position := q * (d.count - 1)
+
+	previousMean, previousPosition := d.min, 0.0
+	weightSoFar := 0.0
+	for _, c := range d.centroids {
+		centroidPosition := weightSoFar + (c.weight-1)/2
+		if position <= centroidPosition {
+			return interpolate(position, previousPosition, previousMean, centroidPosition, c.mean)
+		}
+		previousMean, previousPosition = c.mean, centroidPosition
+		weightSoFar += c.weight
+	}
+	return interpolate(position, previousPosition, previousMean, d.count-1, d.max)
+}
+
+func interpolate(position, fromPosition, from, toPosition, to float64) float64 {
+	if toPosition <= fromPosition {
+		return to
+	}
+	return from + (position-fromPosition)/(toPosition-fromPosition)*(to-from)
+}
+
+// ApproxPercentile computes approximate percentiles using a t-digest, whose memory usage is bounded.
+// Retractions remove the value from the closest centroid, which is exact as long as there are few values in the group,
+// and approximate afterwards.
+type ApproxPercentile struct {
+	digest   tDigest
+	fraction float64
+}
+
+func NewApproxPercentilePrototype() func() nodes.Aggregate {
+	return func() nodes.Aggregate {
+		return &ApproxPercentile{}
+	}
+}
+
+func (c *ApproxPercentile) Add(retraction bool, value octosql.Value) bool {
+	value, c.fraction = value.Tuple[0], numericToFloat(value.Tuple[1])
+	if value.TypeID == octosql.TypeIDNull {
+		return c.digest.count == 0
+	}
+
+	if !retraction {
+		c.digest.add(numericToFloat(value))
+	} else {
+		c.digest.remove(numericToFloat(value))
+	}
+	return c.digest.count == 0
+}
+
+func (c *ApproxPercentile) Trigger() octosql.Value {
+	if c.digest.count == 0 || !validFraction(c.fraction) {
+		return octosql.NewNull()
+	}
+	return octosql.NewFloat(c.digest.quantile(c.fraction))
+}
@@ -7,10 +7,13 @@ import (
 	"log"
 	"os"
 	"os/exec"
+	"regexp"
 	"runtime/debug"
 	"runtime/trace"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/Masterminds/semver"
 	"github.com/pkg/profile"
@@ -214,7 +217,9 @@ octosql "SELECT * FROM plugins.plugins"`,
 				Databases:    databases,
 				FileHandlers: fileHandlers,
 			},
-			PhysicalConfig:  nil,
+			PhysicalConfig: map[string]interface{}{
+				"max_recursion_depth": maxRecursionDepth,
+			},
 			VariableContext: nil,
 		}
 		statement, err := sqlparser.Parse(args[0])
@@ -225,6 +230,10 @@ octosql "SELECT * FROM plugins.plugins"`,
 		if err != nil {
 			return fmt.Errorf("couldn't parse query: %w", err)
 		}
+		parameters, err := parseParameters(params)
+		if err != nil {
+			return fmt.Errorf("couldn't parse query parameters: %w", err)
+		}
 		tableValuedFunctions := map[string]logical.TableValuedFunctionDescription{
 			"max_diff_watermark": table_valued_functions.MaxDiffWatermark,
 			"tumble":             table_valued_functions.Tumble,
@@ -240,6 +249,7 @@ octosql "SELECT * FROM plugins.plugins"`,
 				CommonTableExpressions: map[string]logical.CommonTableExpression{},
 				TableValuedFunctions:   tableValuedFunctions,
 				UniqueNameGenerator:    uniqueNameGenerator,
+				Parameters:             parameters,
 			},
 		)
 		if err != nil {
@@ -256,13 +266,18 @@ octosql "SELECT * FROM plugins.plugins"`,
 					Mapping: mapping,
 				},
 				UniqueNameGenerator: uniqueNameGenerator,
+				Parameters:          parameters,
 			})
 			if err != nil {
 				return fmt.Errorf("couldn't typecheck order by expression with index %d: %w", i, err)
 			}
 			physicalOrderByExpressions[i] = physicalExpr
 		}
+		// The batch output printers order records by the order by keys, or by all values if there are none,
+		// so the keys are kept for them even if the ordering is already done by the plan.
+		orderedByPlan := false
 		if physicalPlan.Schema.NoRetractions && len(physicalOrderByExpressions) > 0 {
+			orderedByPlan = true
 			physicalPlan = physical.Node{
 				Schema:   physicalPlan.Schema,
 				NodeType: physical.NodeTypeOrderBy,
@@ -272,24 +287,36 @@ octosql "SELECT * FROM plugins.plugins"`,
 					DirectionMultipliers: logical.DirectionsToMultipliers(outputOptions.OrderByDirections),
 				},
 			}
-			physicalOrderByExpressions = nil
 		}
-		if physicalPlan.Schema.NoRetractions && len(physicalOrderByExpressions) == 0 {
+		if physicalPlan.Schema.NoRetractions && (outputOptions.Limit > 0 || outputOptions.Offset > 0) {
+			limit := &physical.Limit{
+				Source: physicalPlan,
+			}
+			if outputOptions.Limit > 0 {
+				limit.Limit = &physical.Expression{
+					Type:           octosql.Int,
+					ExpressionType: physical.ExpressionTypeConstant,
+					Constant: &physical.Constant{
+						Value: octosql.NewInt(outputOptions.Limit),
+					},
+				}
+			}
+			if outputOptions.Offset > 0 {
+				limit.Offset = &physical.Expression{
+					Type:           octosql.Int,
+					ExpressionType: physical.ExpressionTypeConstant,
+					Constant: &physical.Constant{
+						Value: octosql.NewInt(outputOptions.Offset),
+					},
+				}
+			}
 			physicalPlan = physical.Node{
 				Schema:   physicalPlan.Schema,
 				NodeType: physical.NodeTypeLimit,
-				Limit: &physical.Limit{
-					Source: physicalPlan,
-					Limit: physical.Expression{
-						Type:           octosql.Int,
-						ExpressionType: physical.ExpressionTypeConstant,
-						Constant: &physical.Constant{
-							Value: octosql.NewInt(outputOptions.Limit),
-						},
-					},
-				},
+				Limit:    limit,
 			}
 			outputOptions.Limit = 0
+			outputOptions.Offset = 0
 		}
 
 		queryTelemetry := telemetry.GetQueryTelemetryData(physicalPlan, installedPlugins)
@@ -308,6 +335,7 @@ octosql "SELECT * FROM plugins.plugins"`,
 			}
 			outSchema = DescribeNodeSchema
 			outputOptions.Limit = 0
+			outputOptions.Offset = 0
 			outputOptions.OrderByExpressions = nil
 			outputOptions.OrderByDirections = nil
 		} else {
@@ -377,6 +405,7 @@ octosql "SELECT * FROM plugins.plugins"`,
 				orderByExpressions,
 				logical.DirectionsToMultipliers(outputOptions.OrderByDirections),
 				outputOptions.Limit,
+				outputOptions.Offset,
 				outSchema,
 				func(writer io.Writer) batch.Format {
 					return formats.NewTableFormatter(writer)
@@ -389,6 +418,7 @@ octosql "SELECT * FROM plugins.plugins"`,
 				orderByExpressions,
 				logical.DirectionsToMultipliers(outputOptions.OrderByDirections),
 				outputOptions.Limit,
+				outputOptions.Offset,
 				outSchema,
 				func(writer io.Writer) batch.Format {
 					return formats.NewTableFormatter(writer)
@@ -402,6 +432,7 @@ octosql "SELECT * FROM plugins.plugins"`,
 					orderByExpressions,
 					logical.DirectionsToMultipliers(outputOptions.OrderByDirections),
 					outputOptions.Limit,
+					outputOptions.Offset,
 					outSchema,
 					func(writer io.Writer) batch.Format {
 						return formats.NewCSVFormatter(writer)
@@ -424,6 +455,7 @@ octosql "SELECT * FROM plugins.plugins"`,
 					orderByExpressions,
 					logical.DirectionsToMultipliers(outputOptions.OrderByDirections),
 					outputOptions.Limit,
+					outputOptions.Offset,
 					outSchema,
 					func(writer io.Writer) batch.Format {
 						return formats.NewJSONFormatter(writer)
@@ -441,15 +473,16 @@ octosql "SELECT * FROM plugins.plugins"`,
 			}
 
 		case "stream_native":
-			if len(orderByExpressions) > 0 {
+			if len(orderByExpressions) > 0 && !orderedByPlan {
 				executionPlan = nodes.NewBatchOrderBy(
 					executionPlan,
 					orderByExpressions,
 					logical.DirectionsToMultipliers(outputOptions.OrderByDirections),
+					nil,
 				)
 			}
-			if outputOptions.Limit > 0 {
-				return fmt.Errorf("LIMIT clause not supported with stream output")
+			if outputOptions.Limit > 0 || outputOptions.Offset > 0 {
+				return fmt.Errorf("LIMIT and OFFSET clauses not supported with stream output")
 			}
 
 			sink = stream.NewOutputPrinter(
@@ -479,18 +512,54 @@ func Execute(ctx context.Context) {
 
 var describe bool
 var explain int
+var maxRecursionDepth int
 var optimize bool
 var output string
+var params []string
 var prof string
 
 func init() {
 	rootCmd.Flags().BoolVar(&describe, "describe", false, "Describe query output schema.")
 	rootCmd.Flags().IntVar(&explain, "explain", 0, "Describe query output schema.")
+	rootCmd.Flags().IntVar(&maxRecursionDepth, "max-recursion-depth", physical.DefaultMaxRecursionDepth, "Maximum number of iterations of recursive common table expressions.")
 	rootCmd.Flags().BoolVar(&optimize, "optimize", true, "Whether OctoSQL should optimize the query.")
 	rootCmd.Flags().StringVar(&output, "output", "live_table", "Output format to use. Available options are live_table, batch_table, csv, json and stream_native.")
+	rootCmd.Flags().StringArrayVar(&params, "param", nil, "Value of a :name or $1 query parameter, as name=value. Can be repeated. Quote the value with single quotes to always use a string. Numbers with leading zeros, like 01234, are strings too.")
 	rootCmd.Flags().StringVar(&prof, "profile", "", "Enable profiling of the given type: cpu, memory, trace.")
 }
 
+// numberParameterRegexp matches plain decimal numbers. Other values, like 01234, stay strings.
+var numberParameterRegexp = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][-+]?[0-9]+)?$`)
+
+// parseParameters parses name=value parameter bindings.
+// The type of the value is inferred, unless it's quoted with single quotes, in which case it's a string.
+func parseParameters(params []string) (map[string]octosql.Value, error) {
+	out := make(map[string]octosql.Value)
+	for _, param := range params {
+		parts := strings.SplitN(param, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid parameter '%s', should be name=value", param)
+		}
+		name, value := strings.TrimLeft(parts[0], ":$"), parts[1]
+		isNumber := numberParameterRegexp.MatchString(value)
+
+		if len(value) >= 2 && strings.HasPrefix(value, "'") && strings.HasSuffix(value, "'") {
+			out[name] = octosql.NewString(value[1 : len(value)-1])
+		} else if i, err := strconv.ParseInt(value, 10, 64); isNumber && err == nil {
+			out[name] = octosql.NewInt(int(i))
+		} else if f, err := strconv.ParseFloat(value, 64); isNumber && err == nil {
+			out[name] = octosql.NewFloat(f)
+		} else if value == "true" || value == "false" {
+			out[name] = octosql.NewBoolean(value == "true")
+		} else if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+			out[name] = octosql.NewTime(t)
+		} else {
+			out[name] = octosql.NewString(value)
+		}
+	}
+	return out, nil
+}
+
 func typecheckNode(ctx context.Context, node logical.Node, env physical.Environment, logicalEnv logical.Environment) (_ physical.Node, _ map[string]string, outErr error) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -54,7 +54,7 @@ func (d *functionSignaturesExecuting) Run(ctx ExecutionContext, produce ProduceF
 				case "strict":
 					row[i] = octosql.NewBoolean(descriptor.Strict)
 				case "simple_signature":
-					row[i] = octosql.NewBoolean(descriptor.TypeFn == nil)
+					row[i] = octosql.NewBoolean(descriptor.TypeFn == nil && descriptor.TypecheckFn == nil)
 				}
 			}
 			output = append(output, row)
@@ -432,6 +432,44 @@ func (c *Coalesce) Evaluate(ctx ExecutionContext) (octosql.Value, error) {
 	return octosql.NewNull(), nil
 }
 
+type Case struct {
+	conditions        []Expression
+	values            []Expression
+	elseValue         Expression
+	objectLayoutFixer *ObjectLayoutFixer
+}
+
+func NewCase(conditions, values []Expression, elseValue Expression, objectLayoutFixer *ObjectLayoutFixer) *Case {
+	return &Case{
+		conditions:        conditions,
+		values:            values,
+		elseValue:         elseValue,
+		objectLayoutFixer: objectLayoutFixer,
+	}
+}
+
+func (c *Case) Evaluate(ctx ExecutionContext) (octosql.Value, error) {
+	for i := range c.conditions {
+		condition, err := c.conditions[i].Evaluate(ctx)
+		if err != nil {
+			return octosql.ZeroValue, fmt.Errorf("couldn't evaluate %d CASE condition: %w", i, err)
+		}
+		if condition.TypeID != octosql.TypeIDBoolean || !condition.Boolean {
+			continue
+		}
+		value, err := c.values[i].Evaluate(ctx)
+		if err != nil {
+			return octosql.ZeroValue, fmt.Errorf("couldn't evaluate %d CASE value: %w", i, err)
+		}
+		return c.objectLayoutFixer.FixLayout(i, value), nil
+	}
+	value, err := c.elseValue.Evaluate(ctx)
+	if err != nil {
+		return octosql.ZeroValue, fmt.Errorf("couldn't evaluate CASE else value: %w", err)
+	}
+	return c.objectLayoutFixer.FixLayout(len(c.values), value), nil
+}
+
 type Tuple struct {
 	args []Expression
 }
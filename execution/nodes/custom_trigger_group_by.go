@@ -12,26 +12,33 @@ import (
 
 type CustomTriggerGroupBy struct {
 	aggregatePrototypes []func() Aggregate
-	aggregateExprs      []Expression
-	keyExprs            []Expression
-	keyEventTimeIndex   int
-	source              Node
-	triggerPrototype    func() Trigger
+	// aggregateHasEmptyValue says for each aggregate if it's triggered even if no values were aggregated.
+	aggregateHasEmptyValue []bool
+	aggregateExprs         []Expression
+	aggregateFilters       []Expression
+	keyExprs               []Expression
+	keyEventTimeIndex      int
+	source                 Node
+	triggerPrototype       func() Trigger
 }
 
 func NewCustomTriggerGroupBy(
 	aggregatePrototypes []func() Aggregate,
+	aggregateHasEmptyValue []bool,
 	aggregateExprs []Expression,
+	aggregateFilters []Expression,
 	keyExprs []Expression,
 	keyEventTimeIndex int,
 	source Node,
 	triggerPrototype func() Trigger,
 ) *CustomTriggerGroupBy {
 	return &CustomTriggerGroupBy{
-		aggregatePrototypes: aggregatePrototypes,
-		aggregateExprs:      aggregateExprs,
-		keyExprs:            keyExprs,
-		keyEventTimeIndex:   keyEventTimeIndex,
+		aggregatePrototypes:    aggregatePrototypes,
+		aggregateHasEmptyValue: aggregateHasEmptyValue,
+		aggregateExprs:         aggregateExprs,
+		aggregateFilters:       aggregateFilters,
+		keyExprs:               keyExprs,
+		keyEventTimeIndex:      keyEventTimeIndex,
 		source: &EventTimeBuffer{
 			source: source,
 		},
@@ -114,6 +121,17 @@ func (g *CustomTriggerGroupBy) Run(ctx ExecutionContext, produce ProduceFn, meta
 				itemTyped.OverallRecordCount--
 			}
 			for i, expr := range g.aggregateExprs {
+				if g.aggregateFilters[i] != nil {
+					// Retractions have the same values as the records they retract, so they are filtered out the same way.
+					filterValue, err := g.aggregateFilters[i].Evaluate(ctx)
+					if err != nil {
+						return fmt.Errorf("couldn't evaluate %d aggregate filter: %w", i, err)
+					}
+					if filterValue.TypeID != octosql.TypeIDBoolean || !filterValue.Boolean {
+						continue
+					}
+				}
+
 				aggregateInput, err := expr.Evaluate(ctx)
 				if err != nil {
 					return fmt.Errorf("couldn't evaluate %d aggregate expression: %w", i, err)
@@ -185,7 +203,7 @@ func (g *CustomTriggerGroupBy) trigger(produceCtx ProduceContext, aggregates, pr
 				copy(outputValues, key)
 
 				for i := range itemTyped.Aggregates {
-					if itemTyped.AggregatedSetSize[i] > 0 {
+					if itemTyped.AggregatedSetSize[i] > 0 || g.aggregateHasEmptyValue[i] {
 						outputValues[len(key)+i] = itemTyped.Aggregates[i].Trigger()
 					} else {
 						outputValues[len(key)+i] = octosql.NewNull()
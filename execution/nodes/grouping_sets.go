@@ -0,0 +1,58 @@
+package nodes
+
+import (
+	"fmt"
+
+	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/octosql"
+)
+
+type GroupingSets struct {
+	source Node
+	key    []Expression
+	sets   [][]int
+}
+
+func NewGroupingSets(source Node, key []Expression, sets [][]int) *GroupingSets {
+	return &GroupingSets{
+		source: source,
+		key:    key,
+		sets:   sets,
+	}
+}
+
+func (g *GroupingSets) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	if err := g.source.Run(ctx, func(produceCtx ProduceContext, record Record) error {
+		ctx := ctx.WithRecord(record)
+
+		key := make([]octosql.Value, len(g.key))
+		for i, expr := range g.key {
+			value, err := expr.Evaluate(ctx)
+			if err != nil {
+				return fmt.Errorf("couldn't evaluate %d grouping sets key expression: %w", i, err)
+			}
+			key[i] = value
+		}
+
+		for setIndex, set := range g.sets {
+			values := make([]octosql.Value, len(record.Values)+len(key)+1)
+			copy(values, record.Values)
+			for i := range key {
+				values[len(record.Values)+i] = octosql.NewNull()
+			}
+			for _, keyIndex := range set {
+				values[len(record.Values)+keyIndex] = key[keyIndex]
+			}
+			values[len(values)-1] = octosql.NewInt(setIndex)
+
+			if err := produce(produceCtx, NewRecord(values, record.Retraction, record.EventTime)); err != nil {
+				return fmt.Errorf("couldn't produce: %w", err)
+			}
+		}
+
+		return nil
+	}, metaSend); err != nil {
+		return fmt.Errorf("couldn't run source: %w", err)
+	}
+	return nil
+}
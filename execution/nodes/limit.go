@@ -8,36 +8,71 @@ import (
 	"github.com/oklog/ulid/v2"
 
 	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/octosql"
 )
 
 type Limit struct {
 	source Node
 	limit  Expression
+	offset Expression
 }
 
-func NewLimit(source Node, limit Expression) *Limit {
+// NewLimit creates a Limit node. Either limit or offset may be nil.
+func NewLimit(source Node, limit, offset Expression) *Limit {
 	return &Limit{
 		source: source,
 		limit:  limit,
+		offset: offset,
 	}
 }
 
 func (m *Limit) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
-	limit, err := m.limit.Evaluate(ctx)
-	if err != nil {
-		return fmt.Errorf("couldn't evaluate limit expression: %w", err)
+	limit := -1
+	if m.limit != nil {
+		limitValue, err := m.limit.Evaluate(ctx)
+		if err != nil {
+			return fmt.Errorf("couldn't evaluate limit expression: %w", err)
+		}
+		if limitValue.TypeID != octosql.TypeIDNull {
+			if limitValue.Int < 0 {
+				return fmt.Errorf("limit must not be negative, is %d", limitValue.Int)
+			}
+			limit = limitValue.Int
+		}
+	}
+	offset := 0
+	if m.offset != nil {
+		offsetValue, err := m.offset.Evaluate(ctx)
+		if err != nil {
+			return fmt.Errorf("couldn't evaluate offset expression: %w", err)
+		}
+		if offsetValue.TypeID != octosql.TypeIDNull {
+			if offsetValue.Int < 0 {
+				return fmt.Errorf("offset must not be negative, is %d", offsetValue.Int)
+			}
+			offset = offsetValue.Int
+		}
+	}
+	if limit == 0 {
+		return nil
 	}
 
 	limitNodeID := ulid.MustNew(ulid.Now(), rand.Reader).String()
 
+	skipped := 0
 	i := 0
 	if err := m.source.Run(ctx, func(produceCtx ProduceContext, record Record) error {
+		if skipped < offset {
+			skipped++
+			return nil
+		}
+
 		if err := produce(produceCtx, record); err != nil {
 			return fmt.Errorf("couldn't produce: %w", err)
 		}
 		i++
 
-		if i == limit.Int {
+		if i == limit {
 			// This error is returned because the limit has been reached, to stop underlying processing.
 			// It will be caught and silenced by the Limit node that emitted it.
 			return fmt.Errorf("limit %s reached", limitNodeID)
@@ -9,6 +9,10 @@ import (
 
 type LookupJoin struct {
 	source, joined Node
+
+	// Only used by outer joins.
+	outer            bool
+	joinedFieldCount int
 }
 
 func NewLookupJoin(source, joined Node) *LookupJoin {
@@ -18,6 +22,17 @@ func NewLookupJoin(source, joined Node) *LookupJoin {
 	}
 }
 
+// NewOuterLookupJoin creates a lookup join which emits the source record padded with nulls
+// if the joined stream ends up containing no records for it.
+func NewOuterLookupJoin(source, joined Node, joinedFieldCount int) *LookupJoin {
+	return &LookupJoin{
+		source:           source,
+		joined:           joined,
+		outer:            true,
+		joinedFieldCount: joinedFieldCount,
+	}
+}
+
 func (s *LookupJoin) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
 	// TODO: Add parallelism here.
 
@@ -25,7 +40,15 @@ func (s *LookupJoin) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaS
 	if err := s.source.Run(ctx, func(produceCtx ProduceContext, sourceRecord Record) error {
 		ctx := ctx.WithRecord(sourceRecord)
 
+		// Count of joined records, taking retractions into account.
+		joinedCount := 0
 		if err := s.joined.Run(ctx, func(produceCtx ProduceContext, joinedRecord Record) error {
+			if !joinedRecord.Retraction {
+				joinedCount++
+			} else {
+				joinedCount--
+			}
+
 			outputValues := make([]octosql.Value, len(sourceRecord.Values)+len(joinedRecord.Values))
 
 			copy(outputValues, sourceRecord.Values)
@@ -42,6 +65,19 @@ func (s *LookupJoin) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaS
 			return fmt.Errorf("couldn't run joined stream: %w", err)
 		}
 
+		if s.outer && joinedCount == 0 {
+			outputValues := make([]octosql.Value, len(sourceRecord.Values)+s.joinedFieldCount)
+
+			copy(outputValues, sourceRecord.Values)
+			for i := len(sourceRecord.Values); i < len(outputValues); i++ {
+				outputValues[i] = octosql.NewNull()
+			}
+
+			if err := produce(ProduceFromExecutionContext(ctx), NewRecord(outputValues, sourceRecord.Retraction, sourceRecord.EventTime)); err != nil {
+				return fmt.Errorf("couldn't produce: %w", err)
+			}
+		}
+
 		return nil
 	}, metaSend); err != nil {
 		return fmt.Errorf("couldn't run source: %w", err)
@@ -16,13 +16,17 @@ type OrderBy struct {
 	source               Node
 	keyExprs             []Expression
 	directionMultipliers []int
+	limit                Expression
 }
 
-func NewBatchOrderBy(source Node, keyExprs []Expression, directionMultipliers []int) *OrderBy {
+// NewBatchOrderBy creates an OrderBy node. If limit is not nil, only the first limit records are kept in memory,
+// which is only correct if the source contains no retractions.
+func NewBatchOrderBy(source Node, keyExprs []Expression, directionMultipliers []int, limit Expression) *OrderBy {
 	return &OrderBy{
 		source:               source,
 		keyExprs:             keyExprs,
 		directionMultipliers: directionMultipliers,
+		limit:                limit,
 	}
 }
 
@@ -56,8 +60,20 @@ func (item *orderByItem) Less(than btree.Item) bool {
 }
 
 func (o *OrderBy) Run(execCtx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	limit := -1
+	if o.limit != nil {
+		limitValue, err := o.limit.Evaluate(execCtx)
+		if err != nil {
+			return fmt.Errorf("couldn't evaluate limit expression: %w", err)
+		}
+		if limitValue.TypeID != octosql.TypeIDNull {
+			limit = limitValue.Int
+		}
+	}
+
 	recordCounts := btree.New(BTreeDefaultDegree)
-	o.source.Run(
+	recordCount := 0
+	if err := o.source.Run(
 		execCtx,
 		func(ctx ProduceContext, record Record) error {
 			key := make([]octosql.Value, len(o.keyExprs))
@@ -87,20 +103,34 @@ func (o *OrderBy) Run(execCtx ExecutionContext, produce ProduceFn, metaSend Meta
 			}
 			if !record.Retraction {
 				itemTyped.Count++
+				recordCount++
 			} else {
 				itemTyped.Count--
+				recordCount--
 			}
 			if itemTyped.Count > 0 {
 				recordCounts.ReplaceOrInsert(itemTyped)
 			} else {
 				recordCounts.Delete(itemTyped)
 			}
+
+			if limit >= 0 && recordCount > limit {
+				// Evict the last record, it won't ever be part of the output.
+				last := recordCounts.Max().(*orderByItem)
+				last.Count--
+				recordCount--
+				if last.Count == 0 {
+					recordCounts.DeleteMax()
+				}
+			}
 			return nil
 		},
 		func(ctx ProduceContext, msg MetadataMessage) error {
 			return nil
 		},
-	)
+	); err != nil {
+		return fmt.Errorf("couldn't run source: %w", err)
+	}
 
 	if err := produceOrderByItems(ProduceFromExecutionContext(execCtx), recordCounts, produce); err != nil {
 		return fmt.Errorf("couldn't produce ordered items: %w", err)
@@ -0,0 +1,145 @@
+package nodes
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tidwall/btree"
+
+	. "github.com/cube2222/octosql/execution"
+)
+
+// RecursiveCTEWorkingTable holds the records produced by the previous iteration of a recursive common table expression.
+type RecursiveCTEWorkingTable struct {
+	records []Record
+}
+
+func NewRecursiveCTEWorkingTable() *RecursiveCTEWorkingTable {
+	return &RecursiveCTEWorkingTable{}
+}
+
+// RecursiveCTEReference is the self-reference of a recursive common table expression, used in its recursive part.
+type RecursiveCTEReference struct {
+	table *RecursiveCTEWorkingTable
+}
+
+func NewRecursiveCTEReference(table *RecursiveCTEWorkingTable) *RecursiveCTEReference {
+	return &RecursiveCTEReference{
+		table: table,
+	}
+}
+
+func (r *RecursiveCTEReference) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	for _, record := range r.table.records {
+		if err := produce(ProduceFromExecutionContext(ctx), record); err != nil {
+			return fmt.Errorf("couldn't produce working table record: %w", err)
+		}
+	}
+	return nil
+}
+
+// RecursiveCTE computes a fixpoint by running the recursive part on the records produced by the previous iteration,
+// starting with the records of the anchor, until no new records are produced.
+type RecursiveCTE struct {
+	anchor, recursive Node
+	table             *RecursiveCTEWorkingTable
+	distinct          bool
+	maxDepth          int
+}
+
+func NewRecursiveCTE(anchor, recursive Node, table *RecursiveCTEWorkingTable, distinct bool, maxDepth int) *RecursiveCTE {
+	return &RecursiveCTE{
+		anchor:    anchor,
+		recursive: recursive,
+		table:     table,
+		distinct:  distinct,
+		maxDepth:  maxDepth,
+	}
+}
+
+func (r *RecursiveCTE) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	// Only used with UNION DISTINCT, contains all records produced so far.
+	produced := btree.NewGenericOptions(distinctItemLess, btree.Options{
+		NoLocks: true,
+	})
+
+	records, err := r.collect(ctx, r.anchor)
+	if err != nil {
+		return fmt.Errorf("couldn't run anchor: %w", err)
+	}
+	for depth := 0; len(records) > 0; depth++ {
+		if depth > r.maxDepth {
+			return fmt.Errorf("recursive common table expression exceeded the maximum recursion depth of %d", r.maxDepth)
+		}
+
+		if r.distinct {
+			newRecords := records[:0]
+			for _, record := range records {
+				if _, ok := produced.Get(&distinctItem{Values: record.Values}); ok {
+					continue
+				}
+				produced.Set(&distinctItem{Values: record.Values, Count: 1})
+				newRecords = append(newRecords, record)
+			}
+			records = newRecords
+		}
+
+		for _, record := range records {
+			if err := produce(ProduceFromExecutionContext(ctx), record); err != nil {
+				return fmt.Errorf("couldn't produce record: %w", err)
+			}
+		}
+
+		r.table.records = records
+		if records, err = r.collect(ctx, r.recursive); err != nil {
+			return fmt.Errorf("couldn't run recursive part with depth %d: %w", depth+1, err)
+		}
+	}
+	r.table.records = nil
+
+	return nil
+}
+
+// collect runs the node to completion and returns its final records, with retractions applied.
+func (r *RecursiveCTE) collect(ctx ExecutionContext, node Node) ([]Record, error) {
+	recordCounts := btree.NewGenericOptions(distinctItemLess, btree.Options{
+		NoLocks: true,
+	})
+
+	if err := node.Run(ctx, func(produceCtx ProduceContext, record Record) error {
+		item, ok := recordCounts.Get(&distinctItem{Values: record.Values})
+		if !ok {
+			item = &distinctItem{
+				Values: record.Values,
+				Count:  0,
+			}
+			recordCounts.Set(item)
+		}
+		if !record.Retraction {
+			item.Count++
+		} else {
+			item.Count--
+		}
+		if item.Count == 0 {
+			recordCounts.Delete(item)
+		}
+		return nil
+	}, func(ctx ProduceContext, msg MetadataMessage) error {
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var records []Record
+	recordCounts.Scan(func(item *distinctItem) bool {
+		for i := 0; i < item.Count; i++ {
+			records = append(records, NewRecord(item.Values, false, time.Time{}))
+		}
+		return true
+	})
+	return records, nil
+}
+
+func distinctItemLess(item, than *distinctItem) bool {
+	return CompareValueSlices(item.Values, than.Values)
+}
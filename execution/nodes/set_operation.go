@@ -0,0 +1,169 @@
+package nodes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tidwall/btree"
+
+	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/octosql"
+)
+
+// SetOperation implements INTERSECT and EXCEPT, by counting the occurrences of each record on both sides.
+// Whenever a count changes, the difference in the output multiplicity of the record is produced or retracted.
+type SetOperation struct {
+	first, second Node
+	except        bool
+	all           bool
+}
+
+func NewIntersect(first, second Node, all bool) *SetOperation {
+	return &SetOperation{
+		first:  first,
+		second: second,
+		all:    all,
+	}
+}
+
+func NewExcept(first, second Node, all bool) *SetOperation {
+	return &SetOperation{
+		first:  first,
+		second: second,
+		except: true,
+		all:    all,
+	}
+}
+
+type setOperationItem struct {
+	Values                  []octosql.Value
+	FirstCount, SecondCount int
+}
+
+func (s *SetOperation) outputCount(item *setOperationItem) int {
+	var count int
+	if s.except {
+		count = item.FirstCount - item.SecondCount
+		if !s.all && item.SecondCount > 0 {
+			count = 0
+		}
+	} else {
+		count = item.FirstCount
+		if item.SecondCount < count {
+			count = item.SecondCount
+		}
+	}
+	if count < 0 {
+		count = 0
+	}
+	if !s.all && count > 1 {
+		count = 1
+	}
+	return count
+}
+
+func (s *SetOperation) Run(execCtx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	ctx, cancel := context.WithCancel(execCtx.Context)
+	defer cancel()
+	execCtx = ExecutionContext{
+		Context:         ctx,
+		VariableContext: execCtx.VariableContext,
+	}
+
+	name := "intersect"
+	if s.except {
+		name = "except"
+	}
+	firstMessages := runUnionSource(execCtx, s.first, "first "+name)
+	secondMessages := runUnionSource(execCtx, s.second, "second "+name)
+
+	recordCounts := btree.NewGenericOptions(func(item, than *setOperationItem) bool {
+		return CompareValueSlices(item.Values, than.Values)
+	}, btree.Options{
+		NoLocks: true,
+	})
+
+	// A finished input won't send any more records, so it doesn't hold back the watermark.
+	var firstWatermark, secondWatermark, minWatermark time.Time
+
+	for firstMessages != nil || secondMessages != nil {
+		var msg unionAllMessage
+		var ok, isFirst bool
+		select {
+		case msg, ok = <-firstMessages:
+			if !ok {
+				firstMessages = nil
+				firstWatermark = WatermarkMaxValue
+			}
+			isFirst = true
+		case msg, ok = <-secondMessages:
+			if !ok {
+				secondMessages = nil
+				secondWatermark = WatermarkMaxValue
+			}
+		}
+		if ok && msg.err != nil {
+			return msg.err
+		}
+		if ok && !msg.metadata {
+			item, exists := recordCounts.Get(&setOperationItem{Values: msg.record.Values})
+			if !exists {
+				item = &setOperationItem{Values: msg.record.Values}
+				recordCounts.Set(item)
+			}
+			before := s.outputCount(item)
+
+			diff := 1
+			if msg.record.Retraction {
+				diff = -1
+			}
+			if isFirst {
+				item.FirstCount += diff
+			} else {
+				item.SecondCount += diff
+			}
+			after := s.outputCount(item)
+
+			if item.FirstCount == 0 && item.SecondCount == 0 {
+				recordCounts.Delete(item)
+			}
+
+			for i := before; i < after; i++ {
+				if err := produce(ProduceFromExecutionContext(execCtx), NewRecord(item.Values, false, msg.record.EventTime)); err != nil {
+					return fmt.Errorf("couldn't produce record: %w", err)
+				}
+			}
+			for i := after; i < before; i++ {
+				if err := produce(ProduceFromExecutionContext(execCtx), NewRecord(item.Values, true, msg.record.EventTime)); err != nil {
+					return fmt.Errorf("couldn't retract record: %w", err)
+				}
+			}
+			continue
+		}
+		if ok {
+			if isFirst {
+				firstWatermark = msg.metadataMessage.Watermark
+			} else {
+				secondWatermark = msg.metadataMessage.Watermark
+			}
+		}
+
+		min := firstWatermark
+		if secondWatermark.Before(min) {
+			min = secondWatermark
+		}
+		if min.After(minWatermark) && min != WatermarkMaxValue {
+			minWatermark = min
+
+			if err := metaSend(ProduceFromExecutionContext(execCtx), MetadataMessage{
+				Type:      MetadataMessageTypeWatermark,
+				Watermark: minWatermark,
+			}); err != nil {
+				return fmt.Errorf("couldn't send metadata: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
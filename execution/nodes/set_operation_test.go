@@ -0,0 +1,52 @@
+package nodes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cube2222/octosql/datasources/memory"
+	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/octosql"
+)
+
+func TestExceptAllAppliesRetractionsOfBothInputs(t *testing.T) {
+	first := &memory.Datasource{
+		Entries: []memory.Entry{
+			{Record: NewRecord([]octosql.Value{octosql.NewString("a")}, false, time.Time{})},
+			{Record: NewRecord([]octosql.Value{octosql.NewString("a")}, false, time.Time{})},
+			{Record: NewRecord([]octosql.Value{octosql.NewString("b")}, false, time.Time{})},
+			{Record: NewRecord([]octosql.Value{octosql.NewString("c")}, false, time.Time{})},
+			{Record: NewRecord([]octosql.Value{octosql.NewString("c")}, true, time.Time{})},
+		},
+	}
+	second := &memory.Datasource{
+		Entries: []memory.Entry{
+			{Record: NewRecord([]octosql.Value{octosql.NewString("a")}, false, time.Time{})},
+			{Record: NewRecord([]octosql.Value{octosql.NewString("b")}, false, time.Time{})},
+			{Record: NewRecord([]octosql.Value{octosql.NewString("b")}, true, time.Time{})},
+		},
+	}
+
+	// The inputs are read concurrently, so only the final state is deterministic.
+	counts := map[string]int{}
+	assert.NoError(t, NewExcept(first, second, true).Run(
+		ExecutionContext{Context: context.Background()},
+		func(ctx ProduceContext, record Record) error {
+			if !record.Retraction {
+				counts[record.Values[0].Str]++
+			} else {
+				counts[record.Values[0].Str]--
+			}
+			assert.GreaterOrEqual(t, counts[record.Values[0].Str], 0)
+			return nil
+		},
+		func(ctx ProduceContext, msg MetadataMessage) error {
+			return nil
+		},
+	))
+
+	assert.Equal(t, map[string]int{"a": 1, "b": 1, "c": 0}, counts)
+}
@@ -13,22 +13,29 @@ import (
 // SimpleGroupBy is a special group by that's much faster than the CustomTriggerGroupBy but only works with no custom triggers.
 type SimpleGroupBy struct {
 	aggregatePrototypes []func() Aggregate
-	aggregateExprs      []Expression
-	keyExprs            []Expression
-	source              Node
+	// aggregateHasEmptyValue says for each aggregate if it's triggered even if no values were aggregated.
+	aggregateHasEmptyValue []bool
+	aggregateExprs         []Expression
+	aggregateFilters       []Expression
+	keyExprs               []Expression
+	source                 Node
 }
 
 func NewSimpleGroupBy(
 	aggregatePrototypes []func() Aggregate,
+	aggregateHasEmptyValue []bool,
 	aggregateExprs []Expression,
+	aggregateFilters []Expression,
 	keyExprs []Expression,
 	source Node,
 ) *SimpleGroupBy {
 	return &SimpleGroupBy{
-		aggregatePrototypes: aggregatePrototypes,
-		aggregateExprs:      aggregateExprs,
-		keyExprs:            keyExprs,
-		source:              source,
+		aggregatePrototypes:    aggregatePrototypes,
+		aggregateHasEmptyValue: aggregateHasEmptyValue,
+		aggregateExprs:         aggregateExprs,
+		aggregateFilters:       aggregateFilters,
+		keyExprs:               keyExprs,
+		source:                 source,
 	}
 }
 
@@ -74,6 +81,17 @@ func (g *SimpleGroupBy) Run(ctx ExecutionContext, produce ProduceFn, metaSend Me
 				itemTyped.OverallRecordCount--
 			}
 			for i, expr := range g.aggregateExprs {
+				if g.aggregateFilters[i] != nil {
+					// Retractions have the same values as the records they retract, so they are filtered out the same way.
+					filterValue, err := g.aggregateFilters[i].Evaluate(ctx)
+					if err != nil {
+						return fmt.Errorf("couldn't evaluate %d aggregate filter: %w", i, err)
+					}
+					if filterValue.TypeID != octosql.TypeIDBoolean || !filterValue.Boolean {
+						continue
+					}
+				}
+
 				aggregateInput, err := expr.Evaluate(ctx)
 				if err != nil {
 					return fmt.Errorf("couldn't evaluate %d aggregate expression: %w", i, err)
@@ -113,7 +131,7 @@ func (g *SimpleGroupBy) Run(ctx ExecutionContext, produce ProduceFn, metaSend Me
 		copy(outputValues, key)
 
 		for i := range itemTyped.Aggregates {
-			if itemTyped.AggregatedSetSize[i] > 0 {
+			if itemTyped.AggregatedSetSize[i] > 0 || g.aggregateHasEmptyValue[i] {
 				outputValues[len(key)+i] = itemTyped.Aggregates[i].Trigger()
 			} else {
 				outputValues[len(key)+i] = octosql.NewNull()
@@ -13,6 +13,15 @@ import (
 type StreamJoin struct {
 	left, right                 Node
 	keyExprsLeft, keyExprsRight []Expression
+
+	// Only used by outer joins.
+	leftOuter, rightOuter           bool
+	leftFieldCount, rightFieldCount int
+	// Additional condition a pair of records has to satisfy to match, may be nil.
+	predicate Expression
+
+	// Semi and anti joins only emit left records, depending on whether they have any matches.
+	semi, anti bool
 }
 
 func NewStreamJoin(left, right Node, keyExprsLeft, keyExprsRight []Expression) *StreamJoin {
@@ -24,6 +33,36 @@ func NewStreamJoin(left, right Node, keyExprsLeft, keyExprsRight []Expression) *
 	}
 }
 
+// NewOuterStreamJoin creates a stream join which emits records without a match padded with nulls.
+// The null-padded record gets retracted as soon as a matching record arrives from the other side.
+func NewOuterStreamJoin(left, right Node, keyExprsLeft, keyExprsRight []Expression, predicate Expression, leftOuter, rightOuter bool, leftFieldCount, rightFieldCount int) *StreamJoin {
+	return &StreamJoin{
+		left:            left,
+		right:           right,
+		keyExprsLeft:    keyExprsLeft,
+		keyExprsRight:   keyExprsRight,
+		leftOuter:       leftOuter,
+		rightOuter:      rightOuter,
+		leftFieldCount:  leftFieldCount,
+		rightFieldCount: rightFieldCount,
+		predicate:       predicate,
+	}
+}
+
+// NewSemiStreamJoin creates a stream join which emits left records which have a match on the right side.
+// If anti is set, it emits the left records which have no match instead.
+func NewSemiStreamJoin(left, right Node, keyExprsLeft, keyExprsRight []Expression, predicate Expression, anti bool) *StreamJoin {
+	return &StreamJoin{
+		left:          left,
+		right:         right,
+		keyExprsLeft:  keyExprsLeft,
+		keyExprsRight: keyExprsRight,
+		predicate:     predicate,
+		semi:          !anti,
+		anti:          anti,
+	}
+}
+
 type streamJoinItem struct {
 	GroupKey
 	// Records for this key
@@ -35,6 +74,8 @@ type streamJoinSubitem struct {
 	GroupKey
 	// Record event times
 	EventTimes []time.Time
+	// Count of matching records from the other side, only tracked for outer, semi and anti joins.
+	Matches int
 }
 
 func (s *StreamJoin) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
@@ -319,24 +360,130 @@ receiveLoop:
 }
 
 func (s *StreamJoin) receiveRecord(ctx ExecutionContext, produce ProduceFn, myRecords, otherRecords *tbtree.Generic[*streamJoinItem], amLeft bool, record Record, oneStreamRemains bool) error {
-	ctx = ctx.WithRecord(record)
+	recordCtx := ctx.WithRecord(record)
 
 	var keyExprs []Expression
+	var myOuter, otherOuter bool
 	if amLeft {
 		keyExprs = s.keyExprsLeft
+		myOuter, otherOuter = s.leftOuter, s.rightOuter
 	} else {
 		keyExprs = s.keyExprsRight
+		myOuter, otherOuter = s.rightOuter, s.leftOuter
 	}
 
 	key := make(GroupKey, len(keyExprs))
 	for i, expr := range keyExprs {
-		value, err := expr.Evaluate(ctx)
+		value, err := expr.Evaluate(recordCtx)
 		if err != nil {
 			return fmt.Errorf("couldn't evaluate %d stream join key expression: %w", i, err)
 		}
 		key[i] = value
 	}
 
+	// A null key isn't equal to anything, so the record can't ever match and doesn't have to be stored.
+	for i := range key {
+		if key[i].TypeID != octosql.TypeIDNull {
+			continue
+		}
+		if amLeft && s.anti {
+			if err := produce(ProduceFromExecutionContext(recordCtx), NewRecord(record.Values, record.Retraction, record.EventTime)); err != nil {
+				return fmt.Errorf("couldn't produce: %w", err)
+			}
+		}
+		if myOuter {
+			if err := produce(ProduceFromExecutionContext(recordCtx), NewRecord(s.padValues(record.Values, amLeft), record.Retraction, record.EventTime)); err != nil {
+				return fmt.Errorf("couldn't produce: %w", err)
+			}
+		}
+		return nil
+	}
+
+	// Trigger with all matching records from other record tree
+	matches := 0
+	if itemTyped, ok := otherRecords.Get(&streamJoinItem{GroupKey: key}); ok {
+		var outErr error
+		itemTyped.values.Scan(func(subitemTyped *streamJoinSubitem) bool {
+			if s.predicate != nil {
+				outputValues := s.joinValues(record.Values, subitemTyped.GroupKey, amLeft)
+				ok, err := s.predicate.Evaluate(ctx.WithRecord(NewRecord(outputValues, record.Retraction, record.EventTime)))
+				if err != nil {
+					outErr = fmt.Errorf("couldn't evaluate stream join predicate: %w", err)
+					return false
+				}
+				if !ok.Boolean {
+					return true
+				}
+			}
+			matches += len(subitemTyped.EventTimes)
+
+			if s.semi || s.anti {
+				if !amLeft {
+					if err := s.updateSemiJoinMatches(recordCtx, produce, subitemTyped, record); err != nil {
+						outErr = err
+						return false
+					}
+				}
+				return true
+			}
+
+			if otherOuter && !record.Retraction && subitemTyped.Matches == 0 {
+				// The other record has its first match, so it's not null-padded anymore.
+				if err := s.producePadded(recordCtx, produce, subitemTyped, !amLeft, true, record.EventTime); err != nil {
+					outErr = err
+					return false
+				}
+			}
+
+			for i := 0; i < len(subitemTyped.EventTimes); i++ {
+				eventTime := record.EventTime
+				if subitemTyped.EventTimes[i].After(eventTime) {
+					eventTime = subitemTyped.EventTimes[i]
+				}
+				// TODO: We probably also want the event time in the columns to be equal to this. Think about this.
+				// TODO: This should be the pairwise maximum of the event times, not the overall maximum.
+
+				if err := produce(ProduceFromExecutionContext(recordCtx), NewRecord(s.joinValues(record.Values, subitemTyped.GroupKey, amLeft), record.Retraction, eventTime)); err != nil {
+					outErr = fmt.Errorf("couldn't produce: %w", err)
+					return false
+				}
+			}
+
+			if otherOuter {
+				if !record.Retraction {
+					subitemTyped.Matches++
+				} else {
+					subitemTyped.Matches--
+					if subitemTyped.Matches == 0 {
+						// The other record lost its last match, so it's null-padded again.
+						if err := s.producePadded(recordCtx, produce, subitemTyped, !amLeft, false, record.EventTime); err != nil {
+							outErr = err
+							return false
+						}
+					}
+				}
+			}
+
+			return true
+		})
+		if outErr != nil {
+			return outErr
+		}
+	}
+
+	if amLeft && (s.semi && matches > 0 || s.anti && matches == 0) {
+		if err := produce(ProduceFromExecutionContext(recordCtx), NewRecord(record.Values, record.Retraction, record.EventTime)); err != nil {
+			return fmt.Errorf("couldn't produce: %w", err)
+		}
+	}
+
+	if myOuter && matches == 0 {
+		outputValues := s.padValues(record.Values, amLeft)
+		if err := produce(ProduceFromExecutionContext(recordCtx), NewRecord(outputValues, record.Retraction, record.EventTime)); err != nil {
+			return fmt.Errorf("couldn't produce: %w", err)
+		}
+	}
+
 	if !oneStreamRemains {
 		// Update count in my record tree
 		// If only one stream remains, we won't be using it anymore, so we don't need to update it.
@@ -361,6 +508,7 @@ func (s *StreamJoin) receiveRecord(ctx ExecutionContext, produce ProduceFn, myRe
 			} else {
 				subitemTyped.EventTimes = subitemTyped.EventTimes[1:]
 			}
+			subitemTyped.Matches = matches
 			if len(subitemTyped.EventTimes) == 0 {
 				itemTyped.values.Delete(subitemTyped)
 			}
@@ -371,47 +519,81 @@ func (s *StreamJoin) receiveRecord(ctx ExecutionContext, produce ProduceFn, myRe
 		}
 	}
 
-	// Trigger with all matching records from other record tree
-	{
-		itemTyped, ok := otherRecords.Get(&streamJoinItem{GroupKey: key})
-
-		if !ok {
-			// Nothing to trigger
-			return nil
-		}
+	return nil
+}
 
-		var outErr error
-		itemTyped.values.Scan(func(subitemTyped *streamJoinSubitem) bool {
-			for i := 0; i < len(subitemTyped.EventTimes); i++ {
-				outputValues := make([]octosql.Value, len(record.Values)+len(subitemTyped.GroupKey))
+func (s *StreamJoin) joinValues(myValues, otherValues []octosql.Value, amLeft bool) []octosql.Value {
+	outputValues := make([]octosql.Value, len(myValues)+len(otherValues))
+	if amLeft {
+		copy(outputValues, myValues)
+		copy(outputValues[len(myValues):], otherValues)
+	} else {
+		copy(outputValues, otherValues)
+		copy(outputValues[len(otherValues):], myValues)
+	}
+	return outputValues
+}
 
-				eventTime := record.EventTime
-				if subitemTyped.EventTimes[i].After(eventTime) {
-					eventTime = subitemTyped.EventTimes[i]
-				}
-				// TODO: We probably also want the event time in the columns to be equal to this. Think about this.
-				// TODO: This should be the pairwise maximum of the event times, not the overall maximum.
+func (s *StreamJoin) padValues(values []octosql.Value, isLeft bool) []octosql.Value {
+	var outputValues []octosql.Value
+	if isLeft {
+		outputValues = make([]octosql.Value, len(values)+s.rightFieldCount)
+		copy(outputValues, values)
+		for i := len(values); i < len(outputValues); i++ {
+			outputValues[i] = octosql.NewNull()
+		}
+	} else {
+		outputValues = make([]octosql.Value, s.leftFieldCount+len(values))
+		for i := 0; i < s.leftFieldCount; i++ {
+			outputValues[i] = octosql.NewNull()
+		}
+		copy(outputValues[s.leftFieldCount:], values)
+	}
+	return outputValues
+}
 
-				if amLeft {
-					copy(outputValues, record.Values)
-					copy(outputValues[len(record.Values):], subitemTyped.GroupKey)
-				} else {
-					copy(outputValues, subitemTyped.GroupKey)
-					copy(outputValues[len(subitemTyped.GroupKey):], record.Values)
-				}
+// producePadded produces (or retracts) the null-padded records for all instances of a stored record.
+func (s *StreamJoin) producePadded(ctx ExecutionContext, produce ProduceFn, subitem *streamJoinSubitem, isLeft bool, retraction bool, eventTime time.Time) error {
+	for i := range subitem.EventTimes {
+		curEventTime := eventTime
+		if subitem.EventTimes[i].After(curEventTime) {
+			curEventTime = subitem.EventTimes[i]
+		}
+		if err := produce(ProduceFromExecutionContext(ctx), NewRecord(s.padValues(subitem.GroupKey, isLeft), retraction, curEventTime)); err != nil {
+			return fmt.Errorf("couldn't produce: %w", err)
+		}
+	}
+	return nil
+}
 
-				if err := produce(ProduceFromExecutionContext(ctx), NewRecord(outputValues, record.Retraction, eventTime)); err != nil {
-					outErr = fmt.Errorf("couldn't produce: %w", err)
-					return false
-				}
-			}
+// updateSemiJoinMatches updates the match count of a stored left record after receiving a matching right record.
+// The left record gets emitted or retracted if it gains its first, or loses its last, match.
+func (s *StreamJoin) updateSemiJoinMatches(ctx ExecutionContext, produce ProduceFn, subitem *streamJoinSubitem, record Record) error {
+	var changed bool
+	if !record.Retraction {
+		subitem.Matches++
+		changed = subitem.Matches == 1
+	} else {
+		subitem.Matches--
+		changed = subitem.Matches == 0
+	}
+	if !changed {
+		return nil
+	}
 
-			return true
-		})
-		if outErr != nil {
-			return outErr
+	// A semi join emits the left record when it gains its first match, an anti join retracts it.
+	retraction := record.Retraction
+	if s.anti {
+		retraction = !retraction
+	}
+	for i := range subitem.EventTimes {
+		eventTime := record.EventTime
+		if subitem.EventTimes[i].After(eventTime) {
+			eventTime = subitem.EventTimes[i]
+		}
+		if err := produce(ProduceFromExecutionContext(ctx), NewRecord(subitem.GroupKey, retraction, eventTime)); err != nil {
+			return fmt.Errorf("couldn't produce: %w", err)
 		}
 	}
-
 	return nil
 }
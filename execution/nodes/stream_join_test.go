@@ -0,0 +1,106 @@
+package nodes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cube2222/octosql/datasources/memory"
+	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/octosql"
+)
+
+func TestOuterStreamJoinRetractsNullPaddedRecords(t *testing.T) {
+	left := &memory.Datasource{
+		Entries: []memory.Entry{
+			{Record: NewRecord([]octosql.Value{octosql.NewInt(1), octosql.NewString("a")}, false, time.Unix(1, 0))},
+			{WatermarkEntry: true, Watermark: time.Unix(3, 0)},
+		},
+	}
+	right := &memory.Datasource{
+		Entries: []memory.Entry{
+			{Record: NewRecord([]octosql.Value{octosql.NewInt(1)}, false, time.Unix(2, 0))},
+			{Record: NewRecord([]octosql.Value{octosql.NewInt(1)}, true, time.Unix(3, 0))},
+			{WatermarkEntry: true, Watermark: time.Unix(3, 0)},
+		},
+	}
+
+	join := NewOuterStreamJoin(
+		left,
+		right,
+		[]Expression{NewVariable(0, 0)},
+		[]Expression{NewVariable(0, 0)},
+		nil,
+		true,
+		false,
+		2,
+		1,
+	)
+
+	var records []string
+	assert.NoError(t, join.Run(
+		ExecutionContext{Context: context.Background()},
+		func(ctx ProduceContext, record Record) error {
+			records = append(records, record.String())
+			return nil
+		},
+		func(ctx ProduceContext, msg MetadataMessage) error {
+			return nil
+		},
+	))
+
+	assert.Equal(t, []string{
+		NewRecord([]octosql.Value{octosql.NewInt(1), octosql.NewString("a"), octosql.NewNull()}, false, time.Unix(1, 0)).String(),
+		NewRecord([]octosql.Value{octosql.NewInt(1), octosql.NewString("a"), octosql.NewNull()}, true, time.Unix(2, 0)).String(),
+		NewRecord([]octosql.Value{octosql.NewInt(1), octosql.NewString("a"), octosql.NewInt(1)}, false, time.Unix(2, 0)).String(),
+		NewRecord([]octosql.Value{octosql.NewInt(1), octosql.NewString("a"), octosql.NewInt(1)}, true, time.Unix(3, 0)).String(),
+		NewRecord([]octosql.Value{octosql.NewInt(1), octosql.NewString("a"), octosql.NewNull()}, false, time.Unix(3, 0)).String(),
+	}, records)
+}
+
+func TestAntiStreamJoinRetractsMatchedRecords(t *testing.T) {
+	left := &memory.Datasource{
+		Entries: []memory.Entry{
+			{Record: NewRecord([]octosql.Value{octosql.NewInt(1), octosql.NewString("a")}, false, time.Unix(1, 0))},
+			{WatermarkEntry: true, Watermark: time.Unix(3, 0)},
+		},
+	}
+	right := &memory.Datasource{
+		Entries: []memory.Entry{
+			{Record: NewRecord([]octosql.Value{octosql.NewInt(1)}, false, time.Unix(2, 0))},
+			{Record: NewRecord([]octosql.Value{octosql.NewInt(1)}, false, time.Unix(2, 0))},
+			{Record: NewRecord([]octosql.Value{octosql.NewInt(1)}, true, time.Unix(3, 0))},
+			{Record: NewRecord([]octosql.Value{octosql.NewInt(1)}, true, time.Unix(3, 0))},
+			{WatermarkEntry: true, Watermark: time.Unix(3, 0)},
+		},
+	}
+
+	join := NewSemiStreamJoin(
+		left,
+		right,
+		[]Expression{NewVariable(0, 0)},
+		[]Expression{NewVariable(0, 0)},
+		nil,
+		true,
+	)
+
+	var records []string
+	assert.NoError(t, join.Run(
+		ExecutionContext{Context: context.Background()},
+		func(ctx ProduceContext, record Record) error {
+			records = append(records, record.String())
+			return nil
+		},
+		func(ctx ProduceContext, msg MetadataMessage) error {
+			return nil
+		},
+	))
+
+	assert.Equal(t, []string{
+		NewRecord([]octosql.Value{octosql.NewInt(1), octosql.NewString("a")}, false, time.Unix(1, 0)).String(),
+		NewRecord([]octosql.Value{octosql.NewInt(1), octosql.NewString("a")}, true, time.Unix(2, 0)).String(),
+		NewRecord([]octosql.Value{octosql.NewInt(1), octosql.NewString("a")}, false, time.Unix(3, 0)).String(),
+	}, records)
+}
@@ -0,0 +1,123 @@
+package nodes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/cube2222/octosql/execution"
+)
+
+type UnionAll struct {
+	first, second Node
+}
+
+func NewUnionAll(first, second Node) *UnionAll {
+	return &UnionAll{
+		first:  first,
+		second: second,
+	}
+}
+
+type unionAllMessage struct {
+	metadata        bool
+	metadataMessage MetadataMessage
+	record          Record
+	err             error
+}
+
+func (u *UnionAll) Run(execCtx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	ctx, cancel := context.WithCancel(execCtx.Context)
+	defer cancel()
+	execCtx = ExecutionContext{
+		Context:         ctx,
+		VariableContext: execCtx.VariableContext,
+	}
+
+	firstMessages := runUnionSource(execCtx, u.first, "first union")
+	secondMessages := runUnionSource(execCtx, u.second, "second union")
+
+	// A finished input won't send any more records, so it doesn't hold back the watermark.
+	var firstWatermark, secondWatermark, minWatermark time.Time
+
+	for firstMessages != nil || secondMessages != nil {
+		var msg unionAllMessage
+		var ok, isFirst bool
+		select {
+		case msg, ok = <-firstMessages:
+			if !ok {
+				firstMessages = nil
+				firstWatermark = WatermarkMaxValue
+			}
+			isFirst = true
+		case msg, ok = <-secondMessages:
+			if !ok {
+				secondMessages = nil
+				secondWatermark = WatermarkMaxValue
+			}
+		}
+		if ok && msg.err != nil {
+			return msg.err
+		}
+		if ok && !msg.metadata {
+			if err := produce(ProduceFromExecutionContext(execCtx), msg.record); err != nil {
+				return fmt.Errorf("couldn't produce: %w", err)
+			}
+			continue
+		}
+		if ok {
+			if isFirst {
+				firstWatermark = msg.metadataMessage.Watermark
+			} else {
+				secondWatermark = msg.metadataMessage.Watermark
+			}
+		}
+
+		min := firstWatermark
+		if secondWatermark.Before(min) {
+			min = secondWatermark
+		}
+		if min.After(minWatermark) && min != WatermarkMaxValue {
+			minWatermark = min
+
+			if err := metaSend(ProduceFromExecutionContext(execCtx), MetadataMessage{
+				Type:      MetadataMessageTypeWatermark,
+				Watermark: minWatermark,
+			}); err != nil {
+				return fmt.Errorf("couldn't send metadata: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// runUnionSource runs the source in the background, sending its records and metadata messages on the returned channel.
+func runUnionSource(ctx ExecutionContext, source Node, name string) chan unionAllMessage {
+	messages := make(chan unionAllMessage, 10000)
+
+	send := func(msg unionAllMessage) error {
+		select {
+		case messages <- msg:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	go func() {
+		defer close(messages)
+
+		if err := source.Run(ctx, func(produceCtx ProduceContext, record Record) error {
+			return send(unionAllMessage{record: record})
+		}, func(produceCtx ProduceContext, msg MetadataMessage) error {
+			return send(unionAllMessage{metadata: true, metadataMessage: msg})
+		}); err != nil {
+			send(unionAllMessage{
+				err: fmt.Errorf("couldn't run %s source: %w", name, err),
+			})
+		}
+	}()
+
+	return messages
+}
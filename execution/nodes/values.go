@@ -0,0 +1,37 @@
+package nodes
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/octosql"
+)
+
+// Values produces the rows of an inline table.
+type Values struct {
+	rows [][]Expression
+}
+
+func NewValues(rows [][]Expression) *Values {
+	return &Values{
+		rows: rows,
+	}
+}
+
+func (v *Values) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	for i := range v.rows {
+		values := make([]octosql.Value, len(v.rows[i]))
+		for j := range v.rows[i] {
+			value, err := v.rows[i][j].Evaluate(ctx)
+			if err != nil {
+				return fmt.Errorf("couldn't evaluate value with index %d of row %d: %w", j, i, err)
+			}
+			values[j] = value
+		}
+		if err := produce(ProduceFromExecutionContext(ctx), NewRecord(values, false, time.Time{})); err != nil {
+			return fmt.Errorf("couldn't produce record: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,461 @@
+package nodes
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/btree"
+
+	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/octosql"
+)
+
+type WindowFrameBoundType int
+
+const (
+	WindowFrameBoundTypeUnboundedPreceding WindowFrameBoundType = iota
+	WindowFrameBoundTypePreceding
+	WindowFrameBoundTypeCurrentRow
+	WindowFrameBoundTypeFollowing
+	WindowFrameBoundTypeUnboundedFollowing
+)
+
+type WindowFrameBound struct {
+	Type   WindowFrameBoundType
+	Offset int
+}
+
+type WindowFrame struct {
+	// Rows frames count offsets in rows, otherwise the current row bound spans all its peers.
+	Rows       bool
+	Start, End WindowFrameBound
+}
+
+// Window evaluates a single window function over partitions of its source.
+// Each partition is recomputed when the trigger fires for its key, and only the difference
+// to the previously sent records of the partition gets produced.
+type Window struct {
+	source               Node
+	partitionExprs       []Expression
+	orderExprs           []Expression
+	directionMultipliers []int
+	argumentExprs        []Expression
+	function             string
+	aggregatePrototype   func() Aggregate
+	frame                WindowFrame
+	keyEventTimeIndex    int
+	triggerPrototype     func() Trigger
+}
+
+// NewWindow creates a window node. The aggregatePrototype is only used if function is "aggregate".
+func NewWindow(
+	source Node,
+	partitionExprs []Expression,
+	orderExprs []Expression,
+	directionMultipliers []int,
+	argumentExprs []Expression,
+	function string,
+	aggregatePrototype func() Aggregate,
+	frame WindowFrame,
+	keyEventTimeIndex int,
+	triggerPrototype func() Trigger,
+) *Window {
+	return &Window{
+		source: &EventTimeBuffer{
+			source: source,
+		},
+		partitionExprs:       partitionExprs,
+		orderExprs:           orderExprs,
+		directionMultipliers: directionMultipliers,
+		argumentExprs:        argumentExprs,
+		function:             function,
+		aggregatePrototype:   aggregatePrototype,
+		frame:                frame,
+		keyEventTimeIndex:    keyEventTimeIndex,
+		triggerPrototype:     triggerPrototype,
+	}
+}
+
+type windowPartitionItem struct {
+	GroupKey
+	Rows *btree.BTree
+}
+
+type previouslySentWindowItem struct {
+	GroupKey
+	// Rows are sorted using CompareValueSlices.
+	Rows [][]octosql.Value
+}
+
+func (w *Window) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	partitions := btree.New(BTreeDefaultDegree)
+	previouslySentValues := btree.New(BTreeDefaultDegree)
+	trigger := w.triggerPrototype()
+
+	if err := w.source.Run(ctx, func(produceCtx ProduceContext, record Record) error {
+		recordCtx := ctx.WithRecord(record)
+
+		key := make(GroupKey, len(w.partitionExprs))
+		for i, expr := range w.partitionExprs {
+			value, err := expr.Evaluate(recordCtx)
+			if err != nil {
+				return fmt.Errorf("couldn't evaluate %d partition by expression: %w", i, err)
+			}
+			key[i] = value
+		}
+
+		orderKey := make([]octosql.Value, len(w.orderExprs))
+		for i, expr := range w.orderExprs {
+			value, err := expr.Evaluate(recordCtx)
+			if err != nil {
+				return fmt.Errorf("couldn't evaluate %d window order by expression: %w", i, err)
+			}
+			orderKey[i] = value
+		}
+
+		var partition *windowPartitionItem
+		if item := partitions.Get(key); item != nil {
+			var ok bool
+			partition, ok = item.(*windowPartitionItem)
+			if !ok {
+				panic(fmt.Sprintf("invalid window partition item: %v", item))
+			}
+		} else {
+			partition = &windowPartitionItem{GroupKey: key, Rows: btree.New(BTreeDefaultDegree)}
+			partitions.ReplaceOrInsert(partition)
+		}
+
+		row := &orderByItem{Key: orderKey, Values: record.Values, DirectionMultipliers: w.directionMultipliers}
+		if item := partition.Rows.Get(row); item != nil {
+			var ok bool
+			row, ok = item.(*orderByItem)
+			if !ok {
+				panic(fmt.Sprintf("invalid window row item: %v", item))
+			}
+		}
+		if !record.Retraction {
+			row.Count++
+		} else {
+			row.Count--
+		}
+		if row.Count > 0 {
+			partition.Rows.ReplaceOrInsert(row)
+		} else {
+			partition.Rows.Delete(row)
+		}
+		if partition.Rows.Len() == 0 {
+			partitions.Delete(partition)
+		}
+
+		trigger.KeyReceived(key)
+
+		if err := w.trigger(ctx, partitions, previouslySentValues, trigger, record.EventTime, produce); err != nil {
+			return fmt.Errorf("couldn't trigger keys on record receive: %w", err)
+		}
+
+		return nil
+	}, func(produceCtx ProduceContext, msg MetadataMessage) error {
+		if msg.Type == MetadataMessageTypeWatermark {
+			trigger.WatermarkReceived(msg.Watermark)
+			if err := w.trigger(ctx, partitions, previouslySentValues, trigger, msg.Watermark, produce); err != nil {
+				return fmt.Errorf("couldn't trigger keys on watermark: %w", err)
+			}
+		}
+		return metaSend(produceCtx, msg)
+	}); err != nil {
+		return fmt.Errorf("couldn't run source: %w", err)
+	}
+
+	trigger.EndOfStreamReached()
+	if err := w.trigger(ctx, partitions, previouslySentValues, trigger, WatermarkMaxValue, produce); err != nil {
+		return fmt.Errorf("couldn't trigger keys on end of stream: %w", err)
+	}
+
+	return nil
+}
+
+func (w *Window) trigger(ctx ExecutionContext, partitions, previouslySentValues *btree.BTree, trigger Trigger, curEventTime time.Time, produce ProduceFn) error {
+	produceCtx := ProduceFromExecutionContext(ctx)
+	toTrigger := trigger.Poll()
+
+	for _, key := range toTrigger {
+		eventTime := curEventTime
+		if w.keyEventTimeIndex != -1 && eventTime.After(key[w.keyEventTimeIndex].Time) {
+			eventTime = key[w.keyEventTimeIndex].Time
+		}
+
+		var outputRows [][]octosql.Value
+		if item := partitions.Get(key); item != nil {
+			partition, ok := item.(*windowPartitionItem)
+			if !ok {
+				panic(fmt.Sprintf("invalid window partition item: %v", item))
+			}
+			var err error
+			outputRows, err = w.computePartition(ctx, partition)
+			if err != nil {
+				return fmt.Errorf("couldn't compute window partition: %w", err)
+			}
+		}
+
+		sortedIndices := make([]int, len(outputRows))
+		for i := range sortedIndices {
+			sortedIndices[i] = i
+		}
+		sort.SliceStable(sortedIndices, func(i, j int) bool {
+			return CompareValueSlices(outputRows[sortedIndices[i]], outputRows[sortedIndices[j]])
+		})
+
+		var previousRows [][]octosql.Value
+		if item := previouslySentValues.Delete(key); item != nil {
+			itemTyped, ok := item.(*previouslySentWindowItem)
+			if !ok {
+				panic(fmt.Sprintf("invalid previously sent item: %v", item))
+			}
+			previousRows = itemTyped.Rows
+		}
+
+		// Merge the sorted previous and current rows, only sending the rows which changed.
+		toSend := make([]bool, len(outputRows))
+		var toRetract [][]octosql.Value
+		i, j := 0, 0
+		for i < len(previousRows) || j < len(sortedIndices) {
+			switch {
+			case j == len(sortedIndices) || i < len(previousRows) && CompareValueSlices(previousRows[i], outputRows[sortedIndices[j]]):
+				toRetract = append(toRetract, previousRows[i])
+				i++
+			case i == len(previousRows) || CompareValueSlices(outputRows[sortedIndices[j]], previousRows[i]):
+				toSend[sortedIndices[j]] = true
+				j++
+			default:
+				i++
+				j++
+			}
+		}
+
+		for _, values := range toRetract {
+			if err := produce(produceCtx, NewRecord(values, true, eventTime)); err != nil {
+				return fmt.Errorf("couldn't produce: %w", err)
+			}
+		}
+		for i, values := range outputRows {
+			if !toSend[i] {
+				continue
+			}
+			if err := produce(produceCtx, NewRecord(values, false, eventTime)); err != nil {
+				return fmt.Errorf("couldn't produce: %w", err)
+			}
+		}
+
+		if len(outputRows) > 0 {
+			sortedRows := make([][]octosql.Value, len(outputRows))
+			for i, index := range sortedIndices {
+				sortedRows[i] = outputRows[index]
+			}
+			previouslySentValues.ReplaceOrInsert(&previouslySentWindowItem{
+				GroupKey: key,
+				Rows:     sortedRows,
+			})
+		}
+	}
+
+	return nil
+}
+
+// computePartition returns the partition records, in window order, with the window function value appended.
+func (w *Window) computePartition(ctx ExecutionContext, partition *windowPartitionItem) ([][]octosql.Value, error) {
+	var rows []*orderByItem
+	partition.Rows.Ascend(func(item btree.Item) bool {
+		itemTyped, ok := item.(*orderByItem)
+		if !ok {
+			panic(fmt.Sprintf("invalid window row item: %v", item))
+		}
+		for i := 0; i < itemTyped.Count; i++ {
+			rows = append(rows, itemTyped)
+		}
+		return true
+	})
+
+	arguments := make([][]octosql.Value, len(rows))
+	for i := range rows {
+		// Duplicate rows share the same item, so they also share the arguments.
+		if i > 0 && rows[i] == rows[i-1] {
+			arguments[i] = arguments[i-1]
+			continue
+		}
+		arguments[i] = make([]octosql.Value, len(w.argumentExprs))
+		recordCtx := ctx.WithRecord(NewRecord(rows[i].Values, false, time.Time{}))
+		for j, expr := range w.argumentExprs {
+			value, err := expr.Evaluate(recordCtx)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't evaluate %d window function argument: %w", j, err)
+			}
+			arguments[i][j] = value
+		}
+	}
+
+	// Rows with equal order keys are peers.
+	peerGroupStart := make([]int, len(rows))
+	peerGroupEnd := make([]int, len(rows))
+	peerGroupIndex := make([]int, len(rows))
+	for i := range rows {
+		if i > 0 && octosql.NewList(rows[i].Key).Compare(octosql.NewList(rows[i-1].Key)) == 0 {
+			peerGroupStart[i] = peerGroupStart[i-1]
+			peerGroupIndex[i] = peerGroupIndex[i-1]
+		} else {
+			peerGroupStart[i] = i
+			if i > 0 {
+				peerGroupIndex[i] = peerGroupIndex[i-1] + 1
+			}
+		}
+	}
+	for i := len(rows) - 1; i >= 0; i-- {
+		if i < len(rows)-1 && peerGroupStart[i+1] == peerGroupStart[i] {
+			peerGroupEnd[i] = peerGroupEnd[i+1]
+		} else {
+			peerGroupEnd[i] = i
+		}
+	}
+
+	frameBounds := func(i int) (int, int) {
+		var start, end int
+		switch w.frame.Start.Type {
+		case WindowFrameBoundTypeUnboundedPreceding:
+			start = 0
+		case WindowFrameBoundTypePreceding:
+			start = i - w.frame.Start.Offset
+		case WindowFrameBoundTypeCurrentRow:
+			start = i
+			if !w.frame.Rows {
+				start = peerGroupStart[i]
+			}
+		case WindowFrameBoundTypeFollowing:
+			start = i + w.frame.Start.Offset
+		case WindowFrameBoundTypeUnboundedFollowing:
+			start = len(rows)
+		}
+		switch w.frame.End.Type {
+		case WindowFrameBoundTypeUnboundedPreceding:
+			end = -1
+		case WindowFrameBoundTypePreceding:
+			end = i - w.frame.End.Offset
+		case WindowFrameBoundTypeCurrentRow:
+			end = i
+			if !w.frame.Rows {
+				end = peerGroupEnd[i]
+			}
+		case WindowFrameBoundTypeFollowing:
+			end = i + w.frame.End.Offset
+		case WindowFrameBoundTypeUnboundedFollowing:
+			end = len(rows) - 1
+		}
+		if start < 0 {
+			start = 0
+		} else if start > len(rows) {
+			start = len(rows)
+		}
+		if end > len(rows)-1 {
+			end = len(rows) - 1
+		}
+		return start, end
+	}
+
+	results := make([]octosql.Value, len(rows))
+	switch w.function {
+	case "row_number":
+		for i := range rows {
+			results[i] = octosql.NewInt(i + 1)
+		}
+
+	case "rank":
+		for i := range rows {
+			results[i] = octosql.NewInt(peerGroupStart[i] + 1)
+		}
+
+	case "dense_rank":
+		for i := range rows {
+			results[i] = octosql.NewInt(peerGroupIndex[i] + 1)
+		}
+
+	case "lag", "lead":
+		for i := range rows {
+			offset := 1
+			if len(arguments[i]) > 1 && arguments[i][1].TypeID != octosql.TypeIDNull {
+				offset = arguments[i][1].Int
+			}
+			target := i - offset
+			if w.function == "lead" {
+				target = i + offset
+			}
+			if target >= 0 && target < len(rows) {
+				results[i] = arguments[target][0]
+			} else if len(arguments[i]) > 2 {
+				results[i] = arguments[i][2]
+			} else {
+				results[i] = octosql.NewNull()
+			}
+		}
+
+	case "first_value", "last_value":
+		for i := range rows {
+			start, end := frameBounds(i)
+			if start > end {
+				results[i] = octosql.NewNull()
+			} else if w.function == "first_value" {
+				results[i] = arguments[start][0]
+			} else {
+				results[i] = arguments[end][0]
+			}
+		}
+
+	case "aggregate":
+		// Frame bounds never move backwards, so the frame can slide over the rows,
+		// adding rows which enter it and retracting rows which leave it.
+		aggregate := w.aggregatePrototype()
+		aggregatedSetSize := 0
+		add := func(index int, retraction bool) {
+			if arguments[index][0].TypeID == octosql.TypeIDNull {
+				return
+			}
+			if !retraction {
+				aggregatedSetSize++
+			} else {
+				aggregatedSetSize--
+			}
+			aggregate.Add(retraction, arguments[index][0])
+		}
+
+		// The rows in [low, high) are currently aggregated.
+		low, high := 0, 0
+		for i := range rows {
+			start, end := frameBounds(i)
+			if end+1 < start {
+				end = start - 1
+			}
+			for ; high < end+1; high++ {
+				add(high, false)
+			}
+			for ; low < start; low++ {
+				add(low, true)
+			}
+			if aggregatedSetSize > 0 {
+				results[i] = aggregate.Trigger()
+			} else {
+				results[i] = octosql.NewNull()
+			}
+		}
+
+	default:
+		panic(fmt.Sprintf("unknown window function: %s", w.function))
+	}
+
+	out := make([][]octosql.Value, len(rows))
+	for i := range rows {
+		values := make([]octosql.Value, len(rows[i].Values)+1)
+		copy(values, rows[i].Values)
+		values[len(rows[i].Values)] = results[i]
+		out[i] = values
+	}
+
+	return out, nil
+}
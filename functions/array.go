@@ -0,0 +1,100 @@
+package functions
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+func listOf(element *octosql.Type) octosql.Type {
+	return octosql.Type{TypeID: octosql.TypeIDList, List: struct{ Element *octosql.Type }{Element: element}}
+}
+
+// sameListTypeFn is the TypeFn of functions taking a single list argument and returning a list of the same type.
+func sameListTypeFn(ts []octosql.Type) (octosql.Type, bool) {
+	if len(ts) != 1 || ts[0].TypeID != octosql.TypeIDList {
+		return octosql.Type{}, false
+	}
+	return ts[0], true
+}
+
+// resolveListIndex resolves negative indices as counting from the end of the list, and clamps the result to the list bounds.
+func resolveListIndex(index, length int) int {
+	if index < 0 {
+		index += length
+	}
+	if index < 0 {
+		return 0
+	}
+	if index > length {
+		return length
+	}
+	return index
+}
+
+func arraySort(values []octosql.Value) (octosql.Value, error) {
+	out := make([]octosql.Value, len(values[0].List))
+	copy(out, values[0].List)
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Compare(out[j]) < 0
+	})
+	return octosql.NewList(out), nil
+}
+
+func arrayDistinct(values []octosql.Value) (octosql.Value, error) {
+	out := make([]octosql.Value, 0, len(values[0].List))
+	// Lists are usually short, so a linear scan is cheaper than hashing the values.
+elementLoop:
+	for _, element := range values[0].List {
+		for i := range out {
+			if out[i].Compare(element) == 0 {
+				continue elementLoop
+			}
+		}
+		out = append(out, element)
+	}
+	return octosql.NewList(out), nil
+}
+
+// arrayJoin joins the list elements using the separator. Null elements are skipped.
+func arrayJoin(values []octosql.Value) (octosql.Value, error) {
+	return octosql.NewString(concatValues(values[1].Str, values[0].List)), nil
+}
+
+// objectTypecheck typechecks the object constructor, which takes pairs of constant field names and values.
+func objectTypecheck(args []physical.Expression) (octosql.Type, func([]octosql.Value) (octosql.Value, error), bool) {
+	if len(args)%2 != 0 {
+		return octosql.Type{}, nil, false
+	}
+	fields := make([]octosql.StructField, len(args)/2)
+	for i := range fields {
+		name := args[2*i]
+		if name.ExpressionType != physical.ExpressionTypeConstant || name.Constant.Value.TypeID != octosql.TypeIDString {
+			panic(fmt.Sprintf("object field names must be constant strings, argument %d isn't", 2*i))
+		}
+		for j := 0; j < i; j++ {
+			if fields[j].Name == name.Constant.Value.Str {
+				panic(fmt.Sprintf("duplicate object field name: %s", fields[j].Name))
+			}
+		}
+		fields[i] = octosql.StructField{
+			Name: name.Constant.Value.Str,
+			Type: args[2*i+1].Type,
+		}
+	}
+
+	outputType := octosql.Type{
+		TypeID: octosql.TypeIDStruct,
+		Struct: struct{ Fields []octosql.StructField }{Fields: fields},
+	}
+
+	return outputType, func(values []octosql.Value) (octosql.Value, error) {
+		out := make([]octosql.Value, len(values)/2)
+		for i := range out {
+			out[i] = values[2*i+1]
+		}
+		return octosql.NewStruct(out), nil
+	}, true
+}
@@ -0,0 +1,108 @@
+package functions
+
+import (
+	"github.com/cube2222/octosql/octosql"
+)
+
+// extremumTypeFn is the TypeFn of greatest and least. Null arguments are skipped,
+// so the output is only nullable if all arguments are. The arguments have to be comparable with each other.
+// Arguments which may mix Ints and Floats are left to numericExtremumTypeFn, as the function lookup picks the last match.
+func extremumTypeFn(ts []octosql.Type) (octosql.Type, bool) {
+	if len(ts) == 0 {
+		return octosql.Type{}, false
+	}
+	if _, ok := numericExtremumTypeFn(ts); ok {
+		return octosql.Type{}, false
+	}
+	for i := range ts {
+		for j := i + 1; j < len(ts); j++ {
+			if ts[i].TypeID == octosql.TypeIDNull || ts[j].TypeID == octosql.TypeIDNull {
+				continue
+			}
+			if octosql.TypeIntersection(octosql.NonNullable(ts[i]), octosql.NonNullable(ts[j])) == nil {
+				return octosql.Type{}, false
+			}
+		}
+	}
+	out := octosql.NonNullable(ts[0])
+	allNullable := true
+	for i := range ts {
+		out = octosql.TypeSum(out, octosql.NonNullable(ts[i]))
+		if octosql.Null.Is(ts[i]) < octosql.TypeRelationIs {
+			allNullable = false
+		}
+	}
+	if allNullable {
+		out = octosql.TypeSum(out, octosql.Null)
+	}
+	return out, true
+}
+
+// numericExtremumTypeFn is the TypeFn of greatest and least for a mix of Int and Float arguments.
+// The Int arguments get promoted to Float, so that they're compared by their numeric value.
+func numericExtremumTypeFn(ts []octosql.Type) (octosql.Type, bool) {
+	if len(ts) == 0 {
+		return octosql.Type{}, false
+	}
+	hasInt, hasFloat := false, false
+	allNullable := true
+	for i := range ts {
+		if ts[i].Is(octosql.TypeSum(octosql.TypeSum(octosql.Int, octosql.Float), octosql.Null)) < octosql.TypeRelationIs {
+			return octosql.Type{}, false
+		}
+		if octosql.Int.Is(ts[i]) != octosql.TypeRelationIsnt {
+			hasInt = true
+		}
+		if octosql.Float.Is(ts[i]) != octosql.TypeRelationIsnt {
+			hasFloat = true
+		}
+		if octosql.Null.Is(ts[i]) < octosql.TypeRelationIs {
+			allNullable = false
+		}
+	}
+	if !hasInt || !hasFloat {
+		return octosql.Type{}, false
+	}
+	if allNullable {
+		return octosql.TypeSum(octosql.Float, octosql.Null), true
+	}
+	return octosql.Float, true
+}
+
+// promoteIntsToFloats returns the values with all Ints replaced by the equal Floats.
+func promoteIntsToFloats(values []octosql.Value) []octosql.Value {
+	out := make([]octosql.Value, len(values))
+	for i := range values {
+		if values[i].TypeID == octosql.TypeIDInt {
+			out[i] = octosql.NewFloat(float64(values[i].Int))
+		} else {
+			out[i] = values[i]
+		}
+	}
+	return out
+}
+
+// extremum returns the non-null value for which better returns true when compared to all others, or null if there are none.
+func extremum(values []octosql.Value, better func(comparison int) bool) octosql.Value {
+	out := octosql.NewNull()
+	for i := range values {
+		if values[i].TypeID == octosql.TypeIDNull {
+			continue
+		}
+		if out.TypeID == octosql.TypeIDNull || better(values[i].Compare(out)) {
+			out = values[i]
+		}
+	}
+	return out
+}
+
+// betweenTypeFn is the TypeFn of between. The bounds have to be comparable with the value.
+func betweenTypeFn(ts []octosql.Type) (octosql.Type, bool) {
+	if len(ts) != 3 {
+		return octosql.Type{}, false
+	}
+	if octosql.TypeIntersection(ts[0], ts[1]) == nil || octosql.TypeIntersection(ts[0], ts[2]) == nil {
+		return octosql.Type{}, false
+	}
+	return octosql.Boolean, true
+}
@@ -1,15 +1,26 @@
 package functions
 
 import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"math"
+	"math/bits"
+	"math/rand"
+	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
 
+	"github.com/cespare/xxhash"
 	"github.com/dgraph-io/ristretto"
+	"github.com/google/uuid"
 
 	"github.com/cube2222/octosql/octosql"
 	"github.com/cube2222/octosql/physical"
@@ -120,6 +131,18 @@ func FunctionMap() map[string]physical.FunctionDetails {
 				},
 			},
 		},
+		"between": {
+			Description: "Returns whether the first argument is between the second and third argument, inclusive. Used as x BETWEEN a AND b.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: betweenTypeFn,
+					Strict: true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewBoolean(values[0].Compare(values[1]) >= 0 && values[0].Compare(values[2]) <= 0), nil
+					},
+				},
+			},
+		},
 		"is null": {
 			Description: "Returns true only if the argument is null.",
 			Descriptors: []physical.FunctionDescriptor{
@@ -152,6 +175,111 @@ func FunctionMap() map[string]physical.FunctionDetails {
 				},
 			},
 		},
+		"is distinct from": {
+			Description: "Returns whether the arguments are different, treating nulls as equal to each other.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Any, octosql.Any},
+					OutputType:    octosql.Boolean,
+					Strict:        false,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewBoolean(values[0].Compare(values[1]) != 0), nil
+					},
+				},
+			},
+		},
+		"is not distinct from": {
+			Description: "Returns whether the arguments are equal, treating nulls as equal to each other. Also available as the <=> operator.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Any, octosql.Any},
+					OutputType:    octosql.Boolean,
+					Strict:        false,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewBoolean(values[0].Compare(values[1]) == 0), nil
+					},
+				},
+			},
+		},
+		// conditionals
+		"nullif": {
+			Description: "Returns null if the arguments are equal, otherwise returns the first argument.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: func(ts []octosql.Type) (octosql.Type, bool) {
+						if len(ts) != 2 {
+							return octosql.Type{}, false
+						}
+						return octosql.TypeSum(ts[0], octosql.Null), true
+					},
+					Strict: false,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						if values[0].TypeID != octosql.TypeIDNull && values[0].Compare(values[1]) == 0 {
+							return octosql.NewNull(), nil
+						}
+						return values[0], nil
+					},
+				},
+			},
+		},
+		"greatest": {
+			Description: "Returns the greatest of the arguments, skipping nulls. Returns null only if all arguments are null. Mixed Int and Float arguments are compared as Floats.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: numericExtremumTypeFn,
+					Strict: false,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return extremum(promoteIntsToFloats(values), func(comparison int) bool { return comparison > 0 }), nil
+					},
+				},
+				{
+					TypeFn: extremumTypeFn,
+					Strict: false,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return extremum(values, func(comparison int) bool { return comparison > 0 }), nil
+					},
+				},
+			},
+		},
+		"least": {
+			Description: "Returns the least of the arguments, skipping nulls. Returns null only if all arguments are null. Mixed Int and Float arguments are compared as Floats.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: numericExtremumTypeFn,
+					Strict: false,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return extremum(promoteIntsToFloats(values), func(comparison int) bool { return comparison < 0 }), nil
+					},
+				},
+				{
+					TypeFn: extremumTypeFn,
+					Strict: false,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return extremum(values, func(comparison int) bool { return comparison < 0 }), nil
+					},
+				},
+			},
+		},
+		"if": {
+			Description: "Returns the second argument if the first argument is true, otherwise returns the third argument.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: func(ts []octosql.Type) (octosql.Type, bool) {
+						if len(ts) != 3 || ts[0].Is(octosql.TypeSum(octosql.Boolean, octosql.Null)) < octosql.TypeRelationIs {
+							return octosql.Type{}, false
+						}
+						return octosql.TypeSum(ts[1], ts[2]), true
+					},
+					Strict: false,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						if values[0].TypeID == octosql.TypeIDBoolean && values[0].Boolean {
+							return values[1], nil
+						}
+						return values[2], nil
+					},
+				},
+			},
+		},
 		// arithmetic operators
 		"+": {
 			Descriptors: []physical.FunctionDescriptor{
@@ -324,6 +452,9 @@ func FunctionMap() map[string]physical.FunctionDetails {
 					OutputType:    octosql.Int,
 					Strict:        true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
+						if values[1].Int == 0 {
+							return octosql.ZeroValue, errDivisionByZero
+						}
 						return octosql.NewInt(values[0].Int / values[1].Int), nil
 					},
 				},
@@ -353,6 +484,118 @@ func FunctionMap() map[string]physical.FunctionDetails {
 				},
 			},
 		},
+		"%": {
+			Descriptors: moduloDescriptors,
+		},
+		"div": {
+			Description: "Returns the integer quotient of the arguments, truncated towards zero.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Int, octosql.Int},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						if values[1].Int == 0 {
+							return octosql.ZeroValue, errDivisionByZero
+						}
+						return octosql.NewInt(values[0].Int / values[1].Int), nil
+					},
+				},
+			},
+		},
+		// bit operators
+		"&": {
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Int, octosql.Int},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInt(values[0].Int & values[1].Int), nil
+					},
+				},
+			},
+		},
+		"|": {
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Int, octosql.Int},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInt(values[0].Int | values[1].Int), nil
+					},
+				},
+			},
+		},
+		"^": {
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Int, octosql.Int},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInt(values[0].Int ^ values[1].Int), nil
+					},
+				},
+			},
+		},
+		"<<": {
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Int, octosql.Int},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						if values[1].Int < 0 {
+							return octosql.ZeroValue, fmt.Errorf("negative shift count: %d", values[1].Int)
+						}
+						return octosql.NewInt(values[0].Int << values[1].Int), nil
+					},
+				},
+			},
+		},
+		">>": {
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Int, octosql.Int},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						if values[1].Int < 0 {
+							return octosql.ZeroValue, fmt.Errorf("negative shift count: %d", values[1].Int)
+						}
+						return octosql.NewInt(values[0].Int >> values[1].Int), nil
+					},
+				},
+			},
+		},
+		"bit_not": {
+			Description: "Returns the bitwise negation of the argument. Also available as the unary ~ operator.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Int},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInt(^values[0].Int), nil
+					},
+				},
+			},
+		},
+		"bit_count": {
+			Description: "Returns the number of bits set in the argument.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Int},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInt(bits.OnesCount64(uint64(values[0].Int))), nil
+					},
+				},
+			},
+		},
 		// math
 		"abs": {
 			Description: "Returns absolute value of argument.",
@@ -469,43 +712,318 @@ func FunctionMap() map[string]physical.FunctionDetails {
 				},
 			},
 		},
-		// logic
-		"not": {
-			Description: "Returns the negation of the argument.",
+		"exp": {
+			Description: "Returns e to the power of the argument.",
 			Descriptors: []physical.FunctionDescriptor{
-				// TODO: Specializations for concrete primitive types.
 				{
-					ArgumentTypes: []octosql.Type{octosql.Boolean},
-					OutputType:    octosql.Boolean,
+					ArgumentTypes: []octosql.Type{octosql.Float},
+					OutputType:    octosql.Float,
 					Strict:        true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						return octosql.NewBoolean(!values[0].Boolean), nil
+						return octosql.NewFloat(math.Exp(values[0].Float)), nil
 					},
 				},
 			},
 		},
-		// strings
-		"like": {
-			Description: "Implements the LIKE operator. Returns whether the first argument matches the pattern in the seconds one. '_' can be used to match a single arbitrary character and '%' can be used to match any number (including 0) of characters.",
+		"round": {
+			Description: "Rounds the first argument to the number of decimal digits in the optional second argument, which may be negative. Halves are rounded away from zero, based on the decimal representation of the number, so round(1.005, 2) is 1.01.",
 			Descriptors: []physical.FunctionDescriptor{
 				{
-					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
-					OutputType:    octosql.Boolean,
+					ArgumentTypes: []octosql.Type{octosql.Int},
+					OutputType:    octosql.Int,
 					Strict:        true,
-					Function: func() func(values []octosql.Value) (octosql.Value, error) {
-						regexpCache, err := ristretto.NewCache(&ristretto.Config{
-							NumCounters: 128,     // number of keys to track frequency of (10M).
-							MaxCost:     1 << 26, // maximum cost of cache (64MB).
-							BufferItems: 64,      // number of keys per Get buffer.
-						})
-						if err != nil {
-							panic(fmt.Errorf("couldn't initialize regexp cache: %w", err))
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return values[0], nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Int, octosql.Int},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInt(roundInt(values[0].Int, values[1].Int, true)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Float},
+					OutputType:    octosql.Float,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewFloat(roundFloat(values[0].Float, 0, true)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Float, octosql.Int},
+					OutputType:    octosql.Float,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewFloat(roundFloat(values[0].Float, values[1].Int, true)), nil
+					},
+				},
+			},
+		},
+		"trunc": {
+			Description: "Truncates the first argument towards zero, to the number of decimal digits in the optional second argument, which may be negative.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Int},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return values[0], nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Int, octosql.Int},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInt(roundInt(values[0].Int, values[1].Int, false)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Float},
+					OutputType:    octosql.Float,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewFloat(math.Trunc(values[0].Float)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Float, octosql.Int},
+					OutputType:    octosql.Float,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewFloat(roundFloat(values[0].Float, values[1].Int, false)), nil
+					},
+				},
+			},
+		},
+		"mod": {
+			Description: "Returns the remainder of dividing the first argument by the second, with the sign of the first argument. Also available as the % operator.",
+			Descriptors: moduloDescriptors,
+		},
+		"sign": {
+			Description: "Returns -1, 0 or 1, depending on the sign of the argument.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Int},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						switch {
+						case values[0].Int > 0:
+							return octosql.NewInt(1), nil
+						case values[0].Int < 0:
+							return octosql.NewInt(-1), nil
+						default:
+							return octosql.NewInt(0), nil
 						}
-
-						return func(values []octosql.Value) (octosql.Value, error) {
-							// TODO: Optimize, cache compiled regexps.
-							const likeEscape = '\\'
-							const likeAny = '_'
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Float},
+					OutputType:    octosql.Float,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						switch {
+						case values[0].Float > 0:
+							return octosql.NewFloat(1), nil
+						case values[0].Float < 0:
+							return octosql.NewFloat(-1), nil
+						default:
+							return octosql.NewFloat(0), nil
+						}
+					},
+				},
+			},
+		},
+		"pi": {
+			Description: "Returns the constant pi.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{},
+					OutputType:    octosql.Float,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewFloat(math.Pi), nil
+					},
+				},
+			},
+		},
+		"sin": {
+			Description: "Returns the sine of the argument, given in radians.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Float},
+					OutputType:    octosql.Float,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewFloat(math.Sin(values[0].Float)), nil
+					},
+				},
+			},
+		},
+		"cos": {
+			Description: "Returns the cosine of the argument, given in radians.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Float},
+					OutputType:    octosql.Float,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewFloat(math.Cos(values[0].Float)), nil
+					},
+				},
+			},
+		},
+		"tan": {
+			Description: "Returns the tangent of the argument, given in radians.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Float},
+					OutputType:    octosql.Float,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewFloat(math.Tan(values[0].Float)), nil
+					},
+				},
+			},
+		},
+		"asin": {
+			Description: "Returns the arcsine of the argument, in radians.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Float},
+					OutputType:    octosql.Float,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewFloat(math.Asin(values[0].Float)), nil
+					},
+				},
+			},
+		},
+		"acos": {
+			Description: "Returns the arccosine of the argument, in radians.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Float},
+					OutputType:    octosql.Float,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewFloat(math.Acos(values[0].Float)), nil
+					},
+				},
+			},
+		},
+		"atan": {
+			Description: "Returns the arctangent of the argument, in radians.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Float},
+					OutputType:    octosql.Float,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewFloat(math.Atan(values[0].Float)), nil
+					},
+				},
+			},
+		},
+		"atan2": {
+			Description: "Returns the arctangent of the first argument divided by the second, in radians, using the signs of both to determine the quadrant.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Float, octosql.Float},
+					OutputType:    octosql.Float,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewFloat(math.Atan2(values[0].Float, values[1].Float)), nil
+					},
+				},
+			},
+		},
+		"degrees": {
+			Description: "Converts the argument from radians to degrees.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Float},
+					OutputType:    octosql.Float,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewFloat(values[0].Float * 180 / math.Pi), nil
+					},
+				},
+			},
+		},
+		"radians": {
+			Description: "Converts the argument from degrees to radians.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Float},
+					OutputType:    octosql.Float,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewFloat(values[0].Float * math.Pi / 180), nil
+					},
+				},
+			},
+		},
+		"random": {
+			Description: "Returns a random number in the range [0, 1). If a constant seed is provided, each call site returns the same sequence of numbers on every run.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes:    []octosql.Type{},
+					OutputType:       octosql.Float,
+					Strict:           true,
+					NonDeterministic: true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewFloat(rand.Float64()), nil
+					},
+				},
+				{
+					Strict:           true,
+					NonDeterministic: true,
+					TypecheckFn:      randomWithSeedTypecheck,
+				},
+			},
+		},
+		// logic
+		"not": {
+			Description: "Returns the negation of the argument.",
+			Descriptors: []physical.FunctionDescriptor{
+				// TODO: Specializations for concrete primitive types.
+				{
+					ArgumentTypes: []octosql.Type{octosql.Boolean},
+					OutputType:    octosql.Boolean,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewBoolean(!values[0].Boolean), nil
+					},
+				},
+			},
+		},
+		// strings
+		"like": {
+			Description: "Implements the LIKE operator. Returns whether the first argument matches the pattern in the seconds one. '_' can be used to match a single arbitrary character and '%' can be used to match any number (including 0) of characters.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.Boolean,
+					Strict:        true,
+					Function: func() func(values []octosql.Value) (octosql.Value, error) {
+						regexpCache, err := ristretto.NewCache(&ristretto.Config{
+							NumCounters: 128,     // number of keys to track frequency of (10M).
+							MaxCost:     1 << 26, // maximum cost of cache (64MB).
+							BufferItems: 64,      // number of keys per Get buffer.
+						})
+						if err != nil {
+							panic(fmt.Errorf("couldn't initialize regexp cache: %w", err))
+						}
+
+						return func(values []octosql.Value) (octosql.Value, error) {
+							// TODO: Optimize, cache compiled regexps.
+							const likeEscape = '\\'
+							const likeAny = '_'
 							const likeAll = '%'
 
 							needsEscaping := func(r rune) bool {
@@ -668,7 +1186,108 @@ func FunctionMap() map[string]physical.FunctionDetails {
 				},
 			},
 		},
-		// TODO: Regexp Match with capture of first group.
+		"regexp_like": {
+			Description: "Returns whether the first argument contains a match of the regexp pattern in the second one. Same as the ~ operator.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.Boolean,
+					Strict:        true,
+					Function: func() func(values []octosql.Value) (octosql.Value, error) {
+						compile := newRegexpCompiler("regexp_like")
+
+						return func(values []octosql.Value) (octosql.Value, error) {
+							reg, err := compile(values[1].Str)
+							if err != nil {
+								return octosql.ZeroValue, err
+							}
+							return octosql.NewBoolean(reg.MatchString(values[0].Str)), nil
+						}
+					}(),
+				},
+			},
+		},
+		"regexp_extract": {
+			Description: "Returns the first match of the regexp pattern in the second argument in the first argument, or null if there's no match. If the capture group index is provided as the third argument, returns that group of the match instead.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.TypeSum(octosql.String, octosql.Null),
+					Strict:        true,
+					Function:      regexpExtract(newRegexpCompiler("regexp_extract")),
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String, octosql.Int},
+					OutputType:    octosql.TypeSum(octosql.String, octosql.Null),
+					Strict:        true,
+					Function:      regexpExtract(newRegexpCompiler("regexp_extract")),
+				},
+			},
+		},
+		"regexp_extract_all": {
+			Description: "Returns a list of all matches of the regexp pattern in the second argument in the first argument. If the capture group index is provided as the third argument, returns that group of each match instead.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    stringList,
+					Strict:        true,
+					Function:      regexpExtractAll(newRegexpCompiler("regexp_extract_all")),
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String, octosql.Int},
+					OutputType:    stringList,
+					Strict:        true,
+					Function:      regexpExtractAll(newRegexpCompiler("regexp_extract_all")),
+				},
+			},
+		},
+		"regexp_replace": {
+			Description: "Replaces all matches of the regexp pattern in the second argument in the first argument with the third argument. The replacement can reference capture groups as $1 or ${name}.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String, octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func() func(values []octosql.Value) (octosql.Value, error) {
+						compile := newRegexpCompiler("regexp_replace")
+
+						return func(values []octosql.Value) (octosql.Value, error) {
+							reg, err := compile(values[1].Str)
+							if err != nil {
+								return octosql.ZeroValue, err
+							}
+							return octosql.NewString(reg.ReplaceAllString(values[0].Str, values[2].Str)), nil
+						}
+					}(),
+				},
+			},
+		},
+		"regexp_split": {
+			Description: "Splits the first argument into a list of strings, separated by matches of the regexp pattern in the second argument.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    stringList,
+					Strict:        true,
+					Function: func() func(values []octosql.Value) (octosql.Value, error) {
+						compile := newRegexpCompiler("regexp_split")
+
+						return func(values []octosql.Value) (octosql.Value, error) {
+							reg, err := compile(values[1].Str)
+							if err != nil {
+								return octosql.ZeroValue, err
+							}
+							parts := reg.Split(values[0].Str, -1)
+							out := make([]octosql.Value, len(parts))
+							for i := range parts {
+								out[i] = octosql.NewString(parts[i])
+							}
+							return octosql.NewList(out), nil
+						}
+					}(),
+				},
+			},
+		},
 		"upper": {
 			Description: "Returns the argument upper cased.",
 			Descriptors: []physical.FunctionDescriptor{
@@ -738,33 +1357,525 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						if end > len(values[0].Str) {
 							end = len(values[0].Str)
 						}
-						return octosql.NewString(values[0].Str[values[1].Int:end]), nil
+						return octosql.NewString(values[0].Str[values[1].Int:end]), nil
+					},
+				},
+			},
+		},
+		"replace": {
+			Description: "Replaces all occurrences of the second argument in the first argument by the third argument.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String, octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(strings.Replace(values[0].Str, values[1].Str, values[2].Str, -1)), nil
+					},
+				},
+			},
+		},
+		"len": {
+			Description: "Returns the length of the string.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInt(len(values[0].Str)), nil
+					},
+				},
+			},
+		},
+		"||": {
+			Description: "Concatenates the strings or the lists. For booleans, it's a logical OR, but it binds more tightly than comparisons, so comparisons combined with it need parentheses.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(values[0].Str + values[1].Str), nil
+					},
+				},
+				{
+					TypeFn: func(ts []octosql.Type) (octosql.Type, bool) {
+						if len(ts) != 2 || ts[0].TypeID != octosql.TypeIDList || ts[1].TypeID != octosql.TypeIDList {
+							return octosql.Type{}, false
+						}
+						switch {
+						case ts[0].List.Element == nil:
+							return ts[1], true
+						case ts[1].List.Element == nil:
+							return ts[0], true
+						}
+						element := octosql.TypeSum(*ts[0].List.Element, *ts[1].List.Element)
+						return listOf(&element), true
+					},
+					Strict: true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						out := make([]octosql.Value, 0, len(values[0].List)+len(values[1].List))
+						out = append(out, values[0].List...)
+						out = append(out, values[1].List...)
+						return octosql.NewList(out), nil
+					},
+				},
+				{
+					// For booleans, || is a logical OR, as it used to be before it became the concatenation operator.
+					TypeFn: func(ts []octosql.Type) (octosql.Type, bool) {
+						if len(ts) != 2 {
+							return octosql.Type{}, false
+						}
+						nullable := false
+						for _, t := range ts {
+							if t.Is(octosql.TypeSum(octosql.Boolean, octosql.Null)) < octosql.TypeRelationIs {
+								return octosql.Type{}, false
+							}
+							if octosql.Null.Is(t) != octosql.TypeRelationIsnt {
+								nullable = true
+							}
+						}
+						if nullable {
+							return octosql.TypeSum(octosql.Boolean, octosql.Null), true
+						}
+						return octosql.Boolean, true
+					},
+					Strict: false,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						nullEncountered := false
+						for i := range values {
+							if values[i].TypeID == octosql.TypeIDBoolean && values[i].Boolean {
+								return values[i], nil
+							}
+							if values[i].TypeID == octosql.TypeIDNull {
+								nullEncountered = true
+							}
+						}
+						if nullEncountered {
+							return octosql.NewNull(), nil
+						}
+						return octosql.NewBoolean(false), nil
+					},
+				},
+			},
+		},
+		"concat": {
+			Description: "Concatenates the arguments. Arguments which aren't strings are converted to their textual representation, null arguments are skipped.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: func(ts []octosql.Type) (octosql.Type, bool) {
+						if len(ts) == 0 {
+							return octosql.Type{}, false
+						}
+						return octosql.String, true
+					},
+					Strict: false,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(concatValues("", values)), nil
+					},
+				},
+			},
+		},
+		"concat_ws": {
+			Description: "Concatenates all but the first argument, separated by the first argument. Arguments which aren't strings are converted to their textual representation, null arguments are skipped.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: func(ts []octosql.Type) (octosql.Type, bool) {
+						if len(ts) < 2 || ts[0].Is(octosql.TypeSum(octosql.String, octosql.Null)) < octosql.TypeRelationIs {
+							return octosql.Type{}, false
+						}
+						if octosql.Null.Is(ts[0]) == octosql.TypeRelationIs {
+							return octosql.TypeSum(octosql.String, octosql.Null), true
+						}
+						return octosql.String, true
+					},
+					Strict: false,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						if values[0].TypeID == octosql.TypeIDNull {
+							return octosql.NewNull(), nil
+						}
+						return octosql.NewString(concatValues(values[0].Str, values[1:])), nil
+					},
+				},
+			},
+		},
+		"trim": {
+			Description: "Removes leading and trailing whitespace from the first argument. If the second argument is provided, removes leading and trailing characters contained in it instead.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(strings.TrimFunc(values[0].Str, unicode.IsSpace)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(strings.Trim(values[0].Str, values[1].Str)), nil
+					},
+				},
+			},
+		},
+		"ltrim": {
+			Description: "Removes leading whitespace from the first argument. If the second argument is provided, removes leading characters contained in it instead.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(strings.TrimLeftFunc(values[0].Str, unicode.IsSpace)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(strings.TrimLeft(values[0].Str, values[1].Str)), nil
+					},
+				},
+			},
+		},
+		"rtrim": {
+			Description: "Removes trailing whitespace from the first argument. If the second argument is provided, removes trailing characters contained in it instead.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(strings.TrimRightFunc(values[0].Str, unicode.IsSpace)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(strings.TrimRight(values[0].Str, values[1].Str)), nil
+					},
+				},
+			},
+		},
+		"lpad": {
+			Description: "Pads the first argument on the left to the length in characters provided in the second argument, using spaces or the optional third argument. Longer strings are truncated.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Int},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(pad(values[0].Str, values[1].Int, " ", true)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Int, octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(pad(values[0].Str, values[1].Int, values[2].Str, true)), nil
+					},
+				},
+			},
+		},
+		"rpad": {
+			Description: "Pads the first argument on the right to the length in characters provided in the second argument, using spaces or the optional third argument. Longer strings are truncated.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Int},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(pad(values[0].Str, values[1].Int, " ", false)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Int, octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(pad(values[0].Str, values[1].Int, values[2].Str, false)), nil
+					},
+				},
+			},
+		},
+		"split": {
+			Description: "Splits the first argument into a list of strings, separated by the second argument.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    stringList,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						parts := strings.Split(values[0].Str, values[1].Str)
+						out := make([]octosql.Value, len(parts))
+						for i := range parts {
+							out[i] = octosql.NewString(parts[i])
+						}
+						return octosql.NewList(out), nil
+					},
+				},
+			},
+		},
+		"strpos": {
+			Description: "Returns the 1-based character index of the first occurrence of the second argument in the first argument, or 0 if it's not present.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInt(strpos(values[0].Str, values[1].Str)), nil
+					},
+				},
+			},
+		},
+		"position": {
+			Description: "Returns the 1-based character index of the first occurrence of the substring in the string, or 0 if it's not present. Called as POSITION(substring IN string).",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInt(strpos(values[1].Str, values[0].Str)), nil
+					},
+				},
+			},
+		},
+		"starts_with": {
+			Description: "Returns whether the first argument starts with the second argument.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.Boolean,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewBoolean(strings.HasPrefix(values[0].Str, values[1].Str)), nil
+					},
+				},
+			},
+		},
+		"ends_with": {
+			Description: "Returns whether the first argument ends with the second argument.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.Boolean,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewBoolean(strings.HasSuffix(values[0].Str, values[1].Str)), nil
+					},
+				},
+			},
+		},
+		"repeat": {
+			Description: "Returns the first argument repeated the number of times provided in the second argument.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Int},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						if values[1].Int <= 0 {
+							return octosql.NewString(""), nil
+						}
+						return octosql.NewString(strings.Repeat(values[0].Str, values[1].Int)), nil
+					},
+				},
+			},
+		},
+		"initcap": {
+			Description: "Returns the argument with the first letter of each word upper cased and the rest lower cased.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(initcap(values[0].Str)), nil
+					},
+				},
+			},
+		},
+		"levenshtein": {
+			Description: "Returns the Levenshtein edit distance between the arguments.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInt(levenshtein(values[0].Str, values[1].Str)), nil
+					},
+				},
+			},
+		},
+		// hashing and encoding
+		"md5": {
+			Description: "Returns the hex-encoded MD5 hash of the argument.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						sum := md5.Sum([]byte(values[0].Str))
+						return octosql.NewString(hex.EncodeToString(sum[:])), nil
+					},
+				},
+			},
+		},
+		"sha1": {
+			Description: "Returns the hex-encoded SHA-1 hash of the argument.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						sum := sha1.Sum([]byte(values[0].Str))
+						return octosql.NewString(hex.EncodeToString(sum[:])), nil
+					},
+				},
+			},
+		},
+		"sha256": {
+			Description: "Returns the hex-encoded SHA-256 hash of the argument.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						sum := sha256.Sum256([]byte(values[0].Str))
+						return octosql.NewString(hex.EncodeToString(sum[:])), nil
+					},
+				},
+			},
+		},
+		"xxhash64": {
+			Description: "Returns the 64-bit xxHash of the argument as an integer. It's much faster than the cryptographic hashes, which makes it a good fit for bucketing and sampling.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInt(int(xxhash.Sum64String(values[0].Str))), nil
+					},
+				},
+			},
+		},
+		"base64_encode": {
+			Description: "Returns the argument encoded using standard base64.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(base64.StdEncoding.EncodeToString([]byte(values[0].Str))), nil
+					},
+				},
+			},
+		},
+		"base64_decode": {
+			Description: "Returns the argument decoded from standard base64, or null if it's invalid.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.TypeSum(octosql.String, octosql.Null),
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						decoded, err := base64.StdEncoding.DecodeString(values[0].Str)
+						if err != nil {
+							log.Printf("error decoding base64: %s", err)
+							return octosql.NewNull(), nil
+						}
+						return octosql.NewString(string(decoded)), nil
+					},
+				},
+			},
+		},
+		"hex": {
+			Description: "Returns the bytes of the argument encoded as lowercase hexadecimal.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(hex.EncodeToString([]byte(values[0].Str))), nil
+					},
+				},
+			},
+		},
+		"unhex": {
+			Description: "Returns the argument decoded from hexadecimal, or null if it's invalid.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.TypeSum(octosql.String, octosql.Null),
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						decoded, err := hex.DecodeString(values[0].Str)
+						if err != nil {
+							log.Printf("error decoding hex: %s", err)
+							return octosql.NewNull(), nil
+						}
+						return octosql.NewString(string(decoded)), nil
 					},
 				},
 			},
 		},
-		"replace": {
-			Description: "Replaces all occurrences of the second argument in the first argument by the third argument.",
+		"url_encode": {
+			Description: "Returns the argument escaped so that it can be safely used in a URL query.",
 			Descriptors: []physical.FunctionDescriptor{
 				{
-					ArgumentTypes: []octosql.Type{octosql.String, octosql.String, octosql.String},
+					ArgumentTypes: []octosql.Type{octosql.String},
 					OutputType:    octosql.String,
 					Strict:        true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						return octosql.NewString(strings.Replace(values[0].Str, values[1].Str, values[2].Str, -1)), nil
+						return octosql.NewString(url.QueryEscape(values[0].Str)), nil
 					},
 				},
 			},
 		},
-		"len": {
-			Description: "Returns the length of the string.",
+		"url_decode": {
+			Description: "Returns the argument with URL query escapes decoded, or null if it's invalid.",
 			Descriptors: []physical.FunctionDescriptor{
 				{
 					ArgumentTypes: []octosql.Type{octosql.String},
-					OutputType:    octosql.Int,
+					OutputType:    octosql.TypeSum(octosql.String, octosql.Null),
 					Strict:        true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						return octosql.NewInt(len(values[0].Str)), nil
+						decoded, err := url.QueryUnescape(values[0].Str)
+						if err != nil {
+							log.Printf("error decoding url: %s", err)
+							return octosql.NewNull(), nil
+						}
+						return octosql.NewString(decoded), nil
+					},
+				},
+			},
+		},
+		"uuid": {
+			Description: "Returns a new random UUID.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes:    []octosql.Type{},
+					OutputType:       octosql.String,
+					Strict:           true,
+					NonDeterministic: true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(uuid.NewString()), nil
 					},
 				},
 			},
@@ -836,6 +1947,137 @@ func FunctionMap() map[string]physical.FunctionDetails {
 				},
 			},
 		},
+		"add_months": {
+			Description: "Adds the given number of months to the time. If the day of the month doesn't exist in the resulting month, the last day of that month is used.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Time, octosql.Int},
+					OutputType:    octosql.Time,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewTime(addMonths(values[0].Time, values[1].Int)), nil
+					},
+				},
+			},
+		},
+		"date_add": {
+			Description: "Adds the amount in the second argument of the unit in the first argument to the time. The unit can be one of microsecond, millisecond, second, minute, hour, day, week, month, quarter or year.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Int, octosql.Time},
+					OutputType:    octosql.Time,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						t, err := addToTime(values[0].Str, values[1].Int, values[2].Time)
+						if err != nil {
+							return octosql.ZeroValue, err
+						}
+						return octosql.NewTime(t), nil
+					},
+				},
+			},
+		},
+		"date_diff": {
+			Description: "Returns the number of boundaries of the unit in the first argument crossed between the times in the second and third argument. The unit can be one of microsecond, millisecond, second, minute, hour, day, week, month, quarter or year.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Time, octosql.Time},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						diff, err := timeDiff(values[0].Str, values[1].Time, values[2].Time)
+						if err != nil {
+							return octosql.ZeroValue, err
+						}
+						return octosql.NewInt(diff), nil
+					},
+				},
+			},
+		},
+		"date_trunc": {
+			Description: "Truncates the time to the start of the unit in the first argument, in the time zone of the time. The unit can be one of microsecond, millisecond, second, minute, hour, day, week, month, quarter or year. Weeks start on Monday.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Time},
+					OutputType:    octosql.Time,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						t, err := truncateTime(values[0].Str, values[1].Time)
+						if err != nil {
+							return octosql.ZeroValue, err
+						}
+						return octosql.NewTime(t), nil
+					},
+				},
+			},
+		},
+		"date_part": {
+			Description: "Returns the part of the time in the first argument, in the time zone of the time. The part can be one of microsecond, millisecond, second, minute, hour, day, dow (day of week, starting with Sunday as 0), doy (day of year), week (ISO week), month, quarter, year or epoch. Also available as EXTRACT(part FROM time).",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Time},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						part, err := timePart(values[0].Str, values[1].Time)
+						if err != nil {
+							return octosql.ZeroValue, err
+						}
+						return octosql.NewInt(part), nil
+					},
+				},
+			},
+		},
+		"format_time": {
+			Description: "Formats the time in the second argument using the strftime pattern in the first argument, e.g. '%Y-%m-%d %H:%M:%S'.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Time},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						formatted, err := formatTime(values[0].Str, values[1].Time)
+						if err != nil {
+							return octosql.ZeroValue, err
+						}
+						return octosql.NewString(formatted), nil
+					},
+				},
+			},
+		},
+		"at_time_zone": {
+			Description: "Converts the time to the IANA time zone in the second argument, e.g. 'Europe/Warsaw'. Also available as time AT TIME ZONE zone.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Time, octosql.String},
+					OutputType:    octosql.Time,
+					Strict:        true,
+					Function: func() func(values []octosql.Value) (octosql.Value, error) {
+						locationCache, err := ristretto.NewCache(&ristretto.Config{
+							NumCounters: 128,
+							MaxCost:     1 << 20,
+							BufferItems: 64,
+						})
+						if err != nil {
+							panic(fmt.Errorf("couldn't initialize time zone cache: %w", err))
+						}
+
+						return func(values []octosql.Value) (octosql.Value, error) {
+							if loc, ok := locationCache.Get(values[1].Str); ok {
+								return octosql.NewTime(values[0].Time.In(loc.(*time.Location))), nil
+							}
+							loc, err := time.LoadLocation(values[1].Str)
+							if err != nil {
+								return octosql.ZeroValue, fmt.Errorf("couldn't load time zone: %w", err)
+							}
+							locationCache.Set(values[1].Str, loc, 1)
+
+							return octosql.NewTime(values[0].Time.In(loc)), nil
+						}
+					}(),
+				},
+			},
+		},
 		// Conversions
 		"int": {
 			Description: "Converts the argument to an int.",
@@ -940,7 +2182,7 @@ func FunctionMap() map[string]physical.FunctionDetails {
 		},
 		// Array Functions
 		"[]": {
-			Description: "Implements the indexing operator: list[index]",
+			Description: "Implements the indexing operator: list[index]. Indices start at 0, negative indices count from the end of the list.",
 			Descriptors: []physical.FunctionDescriptor{
 				{
 					TypeFn: func(ts []octosql.Type) (octosql.Type, bool) {
@@ -961,11 +2203,161 @@ func FunctionMap() map[string]physical.FunctionDetails {
 					},
 					Strict: true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						if values[1].Int >= len(values[0].List) {
+						index := values[1].Int
+						if index < 0 {
+							index += len(values[0].List)
+						}
+						if index < 0 || index >= len(values[0].List) {
 							return octosql.NewNull(), nil
 						}
-						return values[0].List[values[1].Int], nil
+						return values[0].List[index], nil
+					},
+				},
+			},
+		},
+		"array_length": {
+			Description: "Returns the number of elements in the list.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: func(ts []octosql.Type) (octosql.Type, bool) {
+						if len(ts) != 1 || ts[0].TypeID != octosql.TypeIDList {
+							return octosql.Type{}, false
+						}
+						return octosql.Int, true
+					},
+					Strict: true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInt(len(values[0].List)), nil
+					},
+				},
+			},
+		},
+		"array_contains": {
+			Description: "Returns whether the list in the first argument contains the second argument.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: func(ts []octosql.Type) (octosql.Type, bool) {
+						if len(ts) != 2 || ts[0].TypeID != octosql.TypeIDList {
+							return octosql.Type{}, false
+						}
+						return octosql.Boolean, true
+					},
+					Strict: true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						for i := range values[0].List {
+							if values[1].Equal(values[0].List[i]) {
+								return octosql.NewBoolean(true), nil
+							}
+						}
+						return octosql.NewBoolean(false), nil
+					},
+				},
+			},
+		},
+		"array_slice": {
+			Description: "Returns the part of the list starting at the index in the second argument, and ending before the index in the third argument. Indices start at 0, negative indices count from the end of the list.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: func(ts []octosql.Type) (octosql.Type, bool) {
+						if len(ts) != 3 || ts[0].TypeID != octosql.TypeIDList || ts[1].TypeID != octosql.TypeIDInt || ts[2].TypeID != octosql.TypeIDInt {
+							return octosql.Type{}, false
+						}
+						return ts[0], true
+					},
+					Strict: true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						start := resolveListIndex(values[1].Int, len(values[0].List))
+						end := resolveListIndex(values[2].Int, len(values[0].List))
+						if start >= end {
+							return octosql.NewList([]octosql.Value{}), nil
+						}
+						return octosql.NewList(values[0].List[start:end]), nil
+					},
+				},
+			},
+		},
+		"array_sort": {
+			Description: "Returns the list sorted in ascending order.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn:   sameListTypeFn,
+					Strict:   true,
+					Function: arraySort,
+				},
+			},
+		},
+		"array_distinct": {
+			Description: "Returns the list with duplicate elements removed, keeping the first occurrence of each element.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn:   sameListTypeFn,
+					Strict:   true,
+					Function: arrayDistinct,
+				},
+			},
+		},
+		"array_join": {
+			Description: "Joins the elements of the list into a string, using the separator in the second argument. Null elements are skipped.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: func(ts []octosql.Type) (octosql.Type, bool) {
+						if len(ts) != 2 || ts[0].TypeID != octosql.TypeIDList || ts[1].TypeID != octosql.TypeIDString {
+							return octosql.Type{}, false
+						}
+						return octosql.String, true
+					},
+					Strict:   true,
+					Function: arrayJoin,
+				},
+			},
+		},
+		"array_concat": {
+			Description: "Concatenates the lists.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: func(ts []octosql.Type) (octosql.Type, bool) {
+						if len(ts) < 2 {
+							return octosql.Type{}, false
+						}
+						var element *octosql.Type
+						for i := range ts {
+							if ts[i].TypeID != octosql.TypeIDList {
+								return octosql.Type{}, false
+							}
+							if ts[i].List.Element == nil {
+								continue
+							}
+							if element == nil {
+								element = ts[i].List.Element
+							} else {
+								sum := octosql.TypeSum(*element, *ts[i].List.Element)
+								element = &sum
+							}
+						}
+						return listOf(element), true
 					},
+					Strict: true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						var out []octosql.Value
+						for i := range values {
+							out = append(out, values[i].List...)
+						}
+						if out == nil {
+							out = []octosql.Value{}
+						}
+						return octosql.NewList(out), nil
+					},
+				},
+			},
+		},
+		"object": {
+			Description: "Creates an object from pairs of constant field names and values: object('name', value, ...). Also available as struct(value AS name, ...).",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Any},
+					OutputType:    octosql.Any,
+					Strict:        false,
+					TypecheckFn:   objectTypecheck,
 				},
 			},
 		},
@@ -1059,6 +2451,89 @@ func FunctionMap() map[string]physical.FunctionDetails {
 				},
 			},
 		},
+		"exists": {
+			Description: "Returns whether the subquery returns any records.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: func(ts []octosql.Type) (octosql.Type, bool) {
+						if len(ts) != 1 {
+							return octosql.Type{}, false
+						}
+						if ts[0].TypeID != octosql.TypeIDList {
+							return octosql.Type{}, false
+						}
+						return octosql.Boolean, true
+					},
+					Strict: true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewBoolean(len(values[0].List) > 0), nil
+					},
+				},
+			},
+		},
+		// JSON
+		"json_parse": {
+			Description: "Parses the JSON in the first argument. The type of the output is inferred from the constant JSON example in the second argument, e.g. json_parse(payload, '{\"user\": {\"id\": 0, \"name\": \"\"}, \"tags\": [\"\"]}'). JSON numbers are always Floats, so any number can be used in the example. Values not matching the example, and invalid JSON, result in null. Without the example, only scalar values can be parsed, as Any, and objects and arrays result in null as well.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.Any,
+					Strict:        true,
+					TypecheckFn:   jsonParseTypecheck,
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.Any,
+					Strict:        true,
+					TypecheckFn:   jsonParseTypecheck,
+				},
+			},
+		},
+		"json_extract": {
+			Description: "Returns the value at the JSONPath in the second argument of the JSON in the first argument, e.g. '$.items[0].name'. Strings are returned unquoted, other values as JSON. If the path contains a wildcard, all matching values are returned as a JSON array. Returns null if there's no value at the path.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.TypeSum(octosql.String, octosql.Null),
+					Strict:        true,
+					Function:      jsonExtract,
+				},
+			},
+		},
+		"json_array_length": {
+			Description: "Returns the length of the JSON array in the argument, or null if it's not an array. Lists are also accepted.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.TypeSum(octosql.Int, octosql.Null),
+					Strict:        true,
+					Function:      jsonArrayLength,
+				},
+				{
+					TypeFn: func(ts []octosql.Type) (octosql.Type, bool) {
+						if len(ts) != 1 || ts[0].TypeID != octosql.TypeIDList {
+							return octosql.Type{}, false
+						}
+						return octosql.Int, true
+					},
+					Strict: true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInt(len(values[0].List)), nil
+					},
+				},
+			},
+		},
+		"to_json": {
+			Description: "Encodes the argument as JSON.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Any},
+					OutputType:    octosql.String,
+					Strict:        false,
+					TypecheckFn:   toJSONTypecheck,
+				},
+			},
+		},
 		// Utility functions
 		"panic": {
 			Description: "Fails the execution of OctoSQL and prints the argument.",
@@ -0,0 +1,269 @@
+package functions
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/valyala/fastjson"
+
+	"github.com/cube2222/octosql/helpers/jsonvalues"
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/outputs/formats"
+	"github.com/cube2222/octosql/physical"
+)
+
+// Parsers aren't safe for concurrent use, and the values they return are only valid until the parser is reused.
+var jsonParserPool fastjson.ParserPool
+
+// jsonParseTypecheck typechecks json_parse. The output type is inferred from the constant example in the second argument,
+// or from the first argument if it's a constant. Otherwise, the output is Any, which can only hold scalar values.
+// Object fields are always nullable, so that missing fields don't make the whole value invalid.
+func jsonParseTypecheck(args []physical.Expression) (octosql.Type, func([]octosql.Value) (octosql.Value, error), bool) {
+	if len(args) != 1 && len(args) != 2 {
+		return octosql.Type{}, nil, false
+	}
+	for i := range args {
+		if args[i].Type.Is(octosql.String) < octosql.TypeRelationIs {
+			return octosql.Type{}, nil, false
+		}
+	}
+
+	example := args[len(args)-1]
+	if example.ExpressionType != physical.ExpressionTypeConstant {
+		if len(args) == 2 {
+			panic("the json_parse example must be a constant")
+		}
+		return octosql.Any, jsonParseAny, true
+	}
+
+	p := jsonParserPool.Get()
+	defer jsonParserPool.Put(p)
+	v, err := p.Parse(example.Constant.Value.Str)
+	if err != nil {
+		panic(fmt.Sprintf("couldn't parse json_parse example: %s", err))
+	}
+	t := withNullableFields(jsonvalues.GetOctoSQLType(v))
+
+	return octosql.TypeSum(t, octosql.Null), func(values []octosql.Value) (octosql.Value, error) {
+		p := jsonParserPool.Get()
+		defer jsonParserPool.Put(p)
+		v, err := p.Parse(values[0].Str)
+		if err != nil {
+			log.Printf("error parsing json: %s", err)
+			return octosql.NewNull(), nil
+		}
+		out, ok := jsonvalues.GetOctoSQLValue(t, v)
+		if !ok {
+			log.Printf("json value doesn't match the type of the example %s: %s", t, values[0].Str)
+			return octosql.NewNull(), nil
+		}
+		return out, nil
+	}, true
+}
+
+func withNullableFields(t octosql.Type) octosql.Type {
+	switch t.TypeID {
+	case octosql.TypeIDStruct:
+		fields := make([]octosql.StructField, len(t.Struct.Fields))
+		for i := range t.Struct.Fields {
+			fields[i] = octosql.StructField{
+				Name: t.Struct.Fields[i].Name,
+				Type: octosql.TypeSum(withNullableFields(t.Struct.Fields[i].Type), octosql.Null),
+			}
+		}
+		t.Struct.Fields = fields
+	case octosql.TypeIDList:
+		if t.List.Element != nil {
+			element := withNullableFields(*t.List.Element)
+			t.List.Element = &element
+		}
+	}
+	return t
+}
+
+func jsonParseAny(values []octosql.Value) (octosql.Value, error) {
+	p := jsonParserPool.Get()
+	defer jsonParserPool.Put(p)
+	v, err := p.Parse(values[0].Str)
+	if err != nil {
+		log.Printf("error parsing json: %s", err)
+		return octosql.NewNull(), nil
+	}
+	switch v.Type() {
+	case fastjson.TypeObject, fastjson.TypeArray:
+		// Any can't hold objects and arrays, those need an example of their structure.
+		log.Printf("json_parse can only parse objects and arrays with a constant example of their structure provided as the second argument, got %s", values[0].Str)
+		return octosql.NewNull(), nil
+	}
+	out, _ := jsonvalues.GetOctoSQLValue(jsonvalues.GetOctoSQLType(v), v)
+	return out, nil
+}
+
+func toJSONTypecheck(args []physical.Expression) (octosql.Type, func([]octosql.Value) (octosql.Value, error), bool) {
+	if len(args) != 1 {
+		return octosql.Type{}, nil, false
+	}
+	t := args[0].Type
+
+	return octosql.String, func(values []octosql.Value) (octosql.Value, error) {
+		var arena fastjson.Arena
+		return octosql.NewString(formats.ValueToJson(&arena, t, values[0]).String()), nil
+	}, true
+}
+
+// jsonPathStep is a single step of a JSONPath, either a field access, an array index, or a wildcard.
+type jsonPathStep struct {
+	field    string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// parseJSONPath parses the subset of JSONPath consisting of $, .field, ['field'], [index] and the wildcards .* and [*].
+// Negative indices count from the end of the array.
+func parseJSONPath(path string) ([]jsonPathStep, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("JSONPath must start with $: %s", path)
+	}
+	var steps []jsonPathStep
+	for i := 1; i < len(path); {
+		switch path[i] {
+		case '.':
+			end := i + 1
+			for end < len(path) && path[end] != '.' && path[end] != '[' {
+				end++
+			}
+			if end == i+1 {
+				return nil, fmt.Errorf("empty field name at position %d of JSONPath: %s", i, path)
+			}
+			if field := path[i+1 : end]; field == "*" {
+				steps = append(steps, jsonPathStep{wildcard: true})
+			} else {
+				steps = append(steps, jsonPathStep{field: field})
+			}
+			i = end
+		case '[':
+			end := strings.IndexByte(path[i:], ']')
+			if end == -1 {
+				return nil, fmt.Errorf("unclosed bracket at position %d of JSONPath: %s", i, path)
+			}
+			end += i
+			inner := path[i+1 : end]
+			switch {
+			case inner == "*":
+				steps = append(steps, jsonPathStep{wildcard: true})
+			case len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0]:
+				steps = append(steps, jsonPathStep{field: inner[1 : len(inner)-1]})
+			default:
+				index, err := strconv.Atoi(inner)
+				if err != nil {
+					return nil, fmt.Errorf("invalid array index '%s' at position %d of JSONPath: %s", inner, i, path)
+				}
+				steps = append(steps, jsonPathStep{index: index, isIndex: true})
+			}
+			i = end + 1
+		default:
+			return nil, fmt.Errorf("unexpected character '%c' at position %d of JSONPath: %s", path[i], i, path)
+		}
+	}
+	return steps, nil
+}
+
+func evaluateJSONPath(v *fastjson.Value, steps []jsonPathStep, out []*fastjson.Value) []*fastjson.Value {
+	if len(steps) == 0 {
+		return append(out, v)
+	}
+	step := steps[0]
+	switch {
+	case step.wildcard:
+		switch v.Type() {
+		case fastjson.TypeArray:
+			arr, _ := v.Array()
+			for i := range arr {
+				out = evaluateJSONPath(arr[i], steps[1:], out)
+			}
+		case fastjson.TypeObject:
+			obj, _ := v.Object()
+			obj.Visit(func(key []byte, v *fastjson.Value) {
+				out = evaluateJSONPath(v, steps[1:], out)
+			})
+		}
+	case step.isIndex:
+		if v.Type() != fastjson.TypeArray {
+			return out
+		}
+		arr, _ := v.Array()
+		index := step.index
+		if index < 0 {
+			index += len(arr)
+		}
+		if index >= 0 && index < len(arr) {
+			out = evaluateJSONPath(arr[index], steps[1:], out)
+		}
+	default:
+		if v.Type() != fastjson.TypeObject {
+			return out
+		}
+		if field := v.Get(step.field); field != nil {
+			out = evaluateJSONPath(field, steps[1:], out)
+		}
+	}
+	return out
+}
+
+// jsonExtract returns the value at the JSONPath, with strings unquoted and other values encoded as JSON.
+// If the path contains a wildcard, all matching values are returned as a JSON array.
+func jsonExtract(values []octosql.Value) (octosql.Value, error) {
+	steps, err := parseJSONPath(values[1].Str)
+	if err != nil {
+		return octosql.ZeroValue, err
+	}
+	p := jsonParserPool.Get()
+	defer jsonParserPool.Put(p)
+	v, err := p.Parse(values[0].Str)
+	if err != nil {
+		log.Printf("error parsing json: %s", err)
+		return octosql.NewNull(), nil
+	}
+
+	matches := evaluateJSONPath(v, steps, nil)
+	for i := range steps {
+		if steps[i].wildcard {
+			var arena fastjson.Arena
+			arr := arena.NewArray()
+			for i := range matches {
+				arr.SetArrayItem(i, matches[i])
+			}
+			return octosql.NewString(arr.String()), nil
+		}
+	}
+
+	if len(matches) == 0 {
+		return octosql.NewNull(), nil
+	}
+	switch matches[0].Type() {
+	case fastjson.TypeNull:
+		return octosql.NewNull(), nil
+	case fastjson.TypeString:
+		return octosql.NewString(string(matches[0].GetStringBytes())), nil
+	default:
+		return octosql.NewString(matches[0].String()), nil
+	}
+}
+
+func jsonArrayLength(values []octosql.Value) (octosql.Value, error) {
+	p := jsonParserPool.Get()
+	defer jsonParserPool.Put(p)
+	v, err := p.Parse(values[0].Str)
+	if err != nil {
+		log.Printf("error parsing json: %s", err)
+		return octosql.NewNull(), nil
+	}
+	if v.Type() != fastjson.TypeArray {
+		return octosql.NewNull(), nil
+	}
+	arr, _ := v.Array()
+	return octosql.NewInt(len(arr)), nil
+}
@@ -0,0 +1,128 @@
+package functions
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+	"strconv"
+	"sync"
+
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+var errDivisionByZero = fmt.Errorf("division by zero")
+
+// roundFloat rounds the number to the given number of decimal digits, which may be negative to round to tens, hundreds, etc.
+// Halves are rounded away from zero if roundHalves is set, otherwise the number is truncated.
+// The shortest decimal representation of the number is rounded, instead of its binary value, so that e.g. 1.005 rounds to 1.01.
+func roundFloat(x float64, digits int, roundHalves bool) float64 {
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return x
+	}
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(x, 'g', -1, 64))
+	if !ok {
+		return x
+	}
+	scale := new(big.Rat).SetInt(pow10(digits))
+	if digits >= 0 {
+		r.Mul(r, scale)
+	} else {
+		r.Quo(r, scale)
+	}
+
+	// QuoRem truncates towards zero.
+	quotient, remainder := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if roundHalves && new(big.Int).Lsh(new(big.Int).Abs(remainder), 1).Cmp(r.Denom()) >= 0 {
+		quotient.Add(quotient, big.NewInt(int64(r.Num().Sign())))
+	}
+
+	out := new(big.Rat).SetInt(quotient)
+	if digits >= 0 {
+		out.Quo(out, scale)
+	} else {
+		out.Mul(out, scale)
+	}
+	f, _ := out.Float64()
+	if f == 0 {
+		// Avoid returning negative zero.
+		return 0
+	}
+	return f
+}
+
+// roundInt rounds the integer to the given number of decimal digits. Only negative digits change the value.
+func roundInt(x int, digits int, roundHalves bool) int {
+	if digits >= 0 {
+		return x
+	}
+	if digits < -18 {
+		return 0
+	}
+	scale := int(pow10(digits).Int64())
+	quotient, remainder := x/scale, x%scale
+	if roundHalves && 2*abs(remainder) >= scale {
+		if x < 0 {
+			quotient--
+		} else {
+			quotient++
+		}
+	}
+	return quotient * scale
+}
+
+func pow10(digits int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(digits))), nil)
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// moduloDescriptors are shared by the % operator and the mod function.
+// The result has the sign of the dividend, as in PostgreSQL.
+var moduloDescriptors = []physical.FunctionDescriptor{
+	{
+		ArgumentTypes: []octosql.Type{octosql.Int, octosql.Int},
+		OutputType:    octosql.Int,
+		Strict:        true,
+		Function: func(values []octosql.Value) (octosql.Value, error) {
+			if values[1].Int == 0 {
+				return octosql.ZeroValue, errDivisionByZero
+			}
+			return octosql.NewInt(values[0].Int % values[1].Int), nil
+		},
+	},
+	{
+		ArgumentTypes: []octosql.Type{octosql.Float, octosql.Float},
+		OutputType:    octosql.Float,
+		Strict:        true,
+		Function: func(values []octosql.Value) (octosql.Value, error) {
+			return octosql.NewFloat(math.Mod(values[0].Float, values[1].Float)), nil
+		},
+	},
+}
+
+// randomWithSeedTypecheck typechecks random with a seed. Each call site gets its own generator,
+// so that the sequence of values it returns is the same on every run.
+func randomWithSeedTypecheck(args []physical.Expression) (octosql.Type, func([]octosql.Value) (octosql.Value, error), bool) {
+	if len(args) != 1 || args[0].Type.Is(octosql.Int) < octosql.TypeRelationIs {
+		return octosql.Type{}, nil, false
+	}
+	if args[0].ExpressionType != physical.ExpressionTypeConstant {
+		panic("the random seed must be a constant")
+	}
+
+	var mutex sync.Mutex
+	generator := rand.New(rand.NewSource(int64(args[0].Constant.Value.Int)))
+
+	return octosql.Float, func(values []octosql.Value) (octosql.Value, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return octosql.NewFloat(generator.Float64()), nil
+	}, true
+}
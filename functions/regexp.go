@@ -0,0 +1,95 @@
+package functions
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/dgraph-io/ristretto"
+
+	"github.com/cube2222/octosql/octosql"
+)
+
+var stringList = octosql.Type{TypeID: octosql.TypeIDList, List: struct{ Element *octosql.Type }{Element: &octosql.String}}
+
+// newRegexpCompiler returns a function compiling regexp patterns, which caches the compiled patterns.
+// This way a constant pattern is only compiled once, instead of for each record.
+func newRegexpCompiler(functionName string) func(pattern string) (*regexp.Regexp, error) {
+	regexpCache, err := ristretto.NewCache(&ristretto.Config{
+		NumCounters: 128,     // number of keys to track frequency of (10M).
+		MaxCost:     1 << 26, // maximum cost of cache (64MB).
+		BufferItems: 64,      // number of keys per Get buffer.
+	})
+	if err != nil {
+		panic(fmt.Errorf("couldn't initialize regexp cache: %w", err))
+	}
+
+	return func(pattern string) (*regexp.Regexp, error) {
+		if cached, ok := regexpCache.Get(pattern); ok {
+			return cached.(*regexp.Regexp), nil
+		}
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't compile %s pattern regexp expression: '%s': %w", functionName, pattern, err)
+		}
+		regexpCache.Set(pattern, compiled, 1)
+		return compiled, nil
+	}
+}
+
+func checkRegexpGroup(reg *regexp.Regexp, group int) error {
+	if group < 0 || group > reg.NumSubexp() {
+		return fmt.Errorf("regexp '%s' doesn't have capture group %d, it has %d capture groups", reg.String(), group, reg.NumSubexp())
+	}
+	return nil
+}
+
+// regexpExtract returns the implementation of regexp_extract, which takes the string, the pattern and an optional capture group.
+func regexpExtract(compile func(pattern string) (*regexp.Regexp, error)) func(values []octosql.Value) (octosql.Value, error) {
+	return func(values []octosql.Value) (octosql.Value, error) {
+		reg, err := compile(values[1].Str)
+		if err != nil {
+			return octosql.ZeroValue, err
+		}
+		group := 0
+		if len(values) == 3 {
+			group = values[2].Int
+		}
+		if err := checkRegexpGroup(reg, group); err != nil {
+			return octosql.ZeroValue, err
+		}
+
+		match := reg.FindStringSubmatchIndex(values[0].Str)
+		if match == nil || match[2*group] == -1 {
+			return octosql.NewNull(), nil
+		}
+		return octosql.NewString(values[0].Str[match[2*group]:match[2*group+1]]), nil
+	}
+}
+
+// regexpExtractAll returns the implementation of regexp_extract_all, which takes the string, the pattern and an optional capture group.
+func regexpExtractAll(compile func(pattern string) (*regexp.Regexp, error)) func(values []octosql.Value) (octosql.Value, error) {
+	return func(values []octosql.Value) (octosql.Value, error) {
+		reg, err := compile(values[1].Str)
+		if err != nil {
+			return octosql.ZeroValue, err
+		}
+		group := 0
+		if len(values) == 3 {
+			group = values[2].Int
+		}
+		if err := checkRegexpGroup(reg, group); err != nil {
+			return octosql.ZeroValue, err
+		}
+
+		matches := reg.FindAllStringSubmatchIndex(values[0].Str, -1)
+		out := make([]octosql.Value, 0, len(matches))
+		for _, match := range matches {
+			// A group which didn't participate in the match is skipped.
+			if match[2*group] == -1 {
+				continue
+			}
+			out = append(out, octosql.NewString(values[0].Str[match[2*group]:match[2*group+1]]))
+		}
+		return octosql.NewList(out), nil
+	}
+}
@@ -0,0 +1,121 @@
+package functions
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/cube2222/octosql/octosql"
+)
+
+// valueToString returns strings as they are and other values using their textual representation.
+func valueToString(v octosql.Value) string {
+	if v.TypeID == octosql.TypeIDString {
+		return v.Str
+	}
+	return v.String()
+}
+
+// concatValues concatenates the values using the separator. Null values are skipped.
+func concatValues(separator string, values []octosql.Value) string {
+	var sb strings.Builder
+	first := true
+	for i := range values {
+		if values[i].TypeID == octosql.TypeIDNull {
+			continue
+		}
+		if !first {
+			sb.WriteString(separator)
+		}
+		first = false
+		sb.WriteString(valueToString(values[i]))
+	}
+	return sb.String()
+}
+
+// pad pads the string to the length in characters by repeating the fill, on the left or the right.
+// Strings longer than the length are truncated, as in PostgreSQL.
+func pad(s string, length int, fill string, left bool) string {
+	if length <= 0 {
+		return ""
+	}
+	runes := []rune(s)
+	if len(runes) >= length {
+		return string(runes[:length])
+	}
+	fillRunes := []rune(fill)
+	if len(fillRunes) == 0 {
+		return s
+	}
+
+	padding := make([]rune, length-len(runes))
+	for i := range padding {
+		padding[i] = fillRunes[i%len(fillRunes)]
+	}
+	if left {
+		return string(padding) + s
+	}
+	return s + string(padding)
+}
+
+// strpos returns the 1-based character index of the first occurrence of the substring, or 0 if it's not present.
+func strpos(s, substring string) int {
+	index := strings.Index(s, substring)
+	if index == -1 {
+		return 0
+	}
+	return utf8.RuneCountInString(s[:index]) + 1
+}
+
+// initcap upper cases the first letter of each word and lower cases the rest.
+// Words are sequences of letters and digits.
+func initcap(s string) string {
+	var sb strings.Builder
+	sb.Grow(len(s))
+	inWord := false
+	for _, ch := range s {
+		if unicode.IsLetter(ch) || unicode.IsDigit(ch) {
+			if inWord {
+				sb.WriteRune(unicode.ToLower(ch))
+			} else {
+				sb.WriteRune(unicode.ToUpper(ch))
+			}
+			inWord = true
+		} else {
+			sb.WriteRune(ch)
+			inWord = false
+		}
+	}
+	return sb.String()
+}
+
+// levenshtein returns the minimum number of single-character insertions, deletions and substitutions
+// needed to change one string into the other.
+func levenshtein(a, b string) int {
+	ar, br := []rune(a), []rune(b)
+	// Only the previous row of the distance matrix is needed to compute the next one.
+	row := make([]int, len(br)+1)
+	for j := range row {
+		row[j] = j
+	}
+	for i := 1; i <= len(ar); i++ {
+		diagonal := row[0]
+		row[0] = i
+		for j := 1; j <= len(br); j++ {
+			cost := 1
+			if ar[i-1] == br[j-1] {
+				cost = 0
+			}
+			next := diagonal + cost
+			if row[j]+1 < next {
+				next = row[j] + 1
+			}
+			if row[j-1]+1 < next {
+				next = row[j-1] + 1
+			}
+			diagonal = row[j]
+			row[j] = next
+		}
+	}
+	return row[len(br)]
+}
@@ -0,0 +1,250 @@
+package functions
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata" // Time zones should be available even if the system doesn't have the IANA database installed.
+)
+
+// addMonths adds the months to the time, clamping the day to the length of the resulting month,
+// so that e.g. January 31st plus one month is the last day of February.
+func addMonths(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+
+	// The first day of the month is always valid, so it doesn't overflow into the next month.
+	year, month, _ = time.Date(year, month+time.Month(months), 1, 0, 0, 0, 0, t.Location()).Date()
+	if lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, t.Location()).Day(); day > lastDay {
+		day = lastDay
+	}
+
+	return time.Date(year, month, day, hour, min, sec, t.Nanosecond(), t.Location())
+}
+
+// normalizeTimeUnit lowercases the unit and strips the plural suffix, so that e.g. 'Hours' and 'hour' are equivalent.
+func normalizeTimeUnit(unit string) string {
+	unit = strings.ToLower(unit)
+	if unit != "dow" && unit != "doy" {
+		unit = strings.TrimSuffix(unit, "s")
+	}
+	return unit
+}
+
+// truncateTime truncates the time to the start of the unit, in the location of the time.
+// Weeks start on Monday.
+func truncateTime(unit string, t time.Time) (time.Time, error) {
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	loc := t.Location()
+
+	switch normalizeTimeUnit(unit) {
+	case "microsecond":
+		return time.Date(year, month, day, hour, min, sec, t.Nanosecond()/1000*1000, loc), nil
+	case "millisecond":
+		return time.Date(year, month, day, hour, min, sec, t.Nanosecond()/1000000*1000000, loc), nil
+	case "second":
+		return time.Date(year, month, day, hour, min, sec, 0, loc), nil
+	case "minute":
+		return time.Date(year, month, day, hour, min, 0, 0, loc), nil
+	case "hour":
+		return time.Date(year, month, day, hour, 0, 0, 0, loc), nil
+	case "day":
+		return time.Date(year, month, day, 0, 0, 0, 0, loc), nil
+	case "week":
+		daysSinceMonday := (int(t.Weekday()) + 6) % 7
+		return time.Date(year, month, day-daysSinceMonday, 0, 0, 0, 0, loc), nil
+	case "month":
+		return time.Date(year, month, 1, 0, 0, 0, 0, loc), nil
+	case "quarter":
+		return time.Date(year, month-(month-1)%3, 1, 0, 0, 0, 0, loc), nil
+	case "year":
+		return time.Date(year, time.January, 1, 0, 0, 0, 0, loc), nil
+	default:
+		return time.Time{}, fmt.Errorf("invalid time unit for truncation: '%s', must be one of: microsecond, millisecond, second, minute, hour, day, week, month, quarter, year", unit)
+	}
+}
+
+// timePart returns the given part of the time, in the location of the time.
+// The millisecond and microsecond parts include the seconds, as in PostgreSQL.
+func timePart(unit string, t time.Time) (int, error) {
+	switch normalizeTimeUnit(unit) {
+	case "microsecond":
+		return t.Second()*1000000 + t.Nanosecond()/1000, nil
+	case "millisecond":
+		return t.Second()*1000 + t.Nanosecond()/1000000, nil
+	case "second":
+		return t.Second(), nil
+	case "minute":
+		return t.Minute(), nil
+	case "hour":
+		return t.Hour(), nil
+	case "day":
+		return t.Day(), nil
+	case "dow", "dayofweek":
+		return int(t.Weekday()), nil
+	case "doy", "dayofyear":
+		return t.YearDay(), nil
+	case "week":
+		_, week := t.ISOWeek()
+		return week, nil
+	case "month":
+		return int(t.Month()), nil
+	case "quarter":
+		return (int(t.Month())-1)/3 + 1, nil
+	case "year":
+		return t.Year(), nil
+	case "epoch":
+		return int(t.Unix()), nil
+	default:
+		return 0, fmt.Errorf("invalid time part: '%s', must be one of: microsecond, millisecond, second, minute, hour, day, dow, doy, week, month, quarter, year, epoch", unit)
+	}
+}
+
+// addToTime adds the amount of units to the time. Days and longer units are added using the calendar,
+// so adding a day across a daylight saving time change keeps the clock time.
+func addToTime(unit string, amount int, t time.Time) (time.Time, error) {
+	switch normalizeTimeUnit(unit) {
+	case "microsecond":
+		return t.Add(time.Duration(amount) * time.Microsecond), nil
+	case "millisecond":
+		return t.Add(time.Duration(amount) * time.Millisecond), nil
+	case "second":
+		return t.Add(time.Duration(amount) * time.Second), nil
+	case "minute":
+		return t.Add(time.Duration(amount) * time.Minute), nil
+	case "hour":
+		return t.Add(time.Duration(amount) * time.Hour), nil
+	case "day":
+		return t.AddDate(0, 0, amount), nil
+	case "week":
+		return t.AddDate(0, 0, amount*7), nil
+	case "month":
+		return addMonths(t, amount), nil
+	case "quarter":
+		return addMonths(t, amount*3), nil
+	case "year":
+		return addMonths(t, amount*12), nil
+	default:
+		return time.Time{}, fmt.Errorf("invalid time unit: '%s', must be one of: microsecond, millisecond, second, minute, hour, day, week, month, quarter, year", unit)
+	}
+}
+
+// timeDiff returns the number of unit boundaries crossed between start and end, in the location of start.
+func timeDiff(unit string, start, end time.Time) (int, error) {
+	end = end.In(start.Location())
+
+	var duration time.Duration
+	switch normalizeTimeUnit(unit) {
+	case "year":
+		return end.Year() - start.Year(), nil
+	case "quarter":
+		return (end.Year()*4 + (int(end.Month())-1)/3) - (start.Year()*4 + (int(start.Month())-1)/3), nil
+	case "month":
+		return (end.Year()*12 + int(end.Month())) - (start.Year()*12 + int(start.Month())), nil
+	case "week":
+		// Both are truncated to Mondays, so the difference in days is a multiple of 7.
+		startMonday, _ := truncateTime("week", start)
+		endMonday, _ := truncateTime("week", end)
+		return (civilDay(endMonday) - civilDay(startMonday)) / 7, nil
+	case "day":
+		// Days may be longer or shorter than 24 hours, so they're counted on the calendar.
+		return civilDay(end) - civilDay(start), nil
+	case "hour":
+		duration = time.Hour
+	case "minute":
+		duration = time.Minute
+	case "second":
+		duration = time.Second
+	case "millisecond":
+		duration = time.Millisecond
+	case "microsecond":
+		duration = time.Microsecond
+	default:
+		return 0, fmt.Errorf("invalid time unit: '%s', must be one of: microsecond, millisecond, second, minute, hour, day, week, month, quarter, year", unit)
+	}
+
+	startTruncated, _ := truncateTime(unit, start)
+	endTruncated, _ := truncateTime(unit, end)
+	return int(endTruncated.Sub(startTruncated) / duration), nil
+}
+
+// civilDay returns the number of days between the unix epoch and the date of the time, ignoring its location.
+func civilDay(t time.Time) int {
+	year, month, day := t.Date()
+	return int(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / (24 * 60 * 60))
+}
+
+// formatTime formats the time using a strftime pattern.
+func formatTime(pattern string, t time.Time) (string, error) {
+	var sb strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		if pattern[i] != '%' {
+			sb.WriteByte(pattern[i])
+			continue
+		}
+		i++
+		if i == len(pattern) {
+			return "", fmt.Errorf("format pattern ends with an unfinished directive")
+		}
+		switch pattern[i] {
+		case 'a':
+			sb.WriteString(t.Format("Mon"))
+		case 'A':
+			sb.WriteString(t.Format("Monday"))
+		case 'b', 'h':
+			sb.WriteString(t.Format("Jan"))
+		case 'B':
+			sb.WriteString(t.Format("January"))
+		case 'c':
+			sb.WriteString(t.Format("Mon Jan _2 15:04:05 2006"))
+		case 'd':
+			sb.WriteString(t.Format("02"))
+		case 'e':
+			sb.WriteString(t.Format("_2"))
+		case 'f':
+			fmt.Fprintf(&sb, "%06d", t.Nanosecond()/1000)
+		case 'F':
+			sb.WriteString(t.Format("2006-01-02"))
+		case 'H':
+			sb.WriteString(t.Format("15"))
+		case 'I':
+			sb.WriteString(t.Format("03"))
+		case 'j':
+			fmt.Fprintf(&sb, "%03d", t.YearDay())
+		case 'm':
+			sb.WriteString(t.Format("01"))
+		case 'M':
+			sb.WriteString(t.Format("04"))
+		case 'p':
+			sb.WriteString(t.Format("PM"))
+		case 's':
+			sb.WriteString(strconv.FormatInt(t.Unix(), 10))
+		case 'S':
+			sb.WriteString(t.Format("05"))
+		case 'T':
+			sb.WriteString(t.Format("15:04:05"))
+		case 'u':
+			fmt.Fprintf(&sb, "%d", (int(t.Weekday())+6)%7+1)
+		case 'V':
+			_, week := t.ISOWeek()
+			fmt.Fprintf(&sb, "%02d", week)
+		case 'w':
+			fmt.Fprintf(&sb, "%d", int(t.Weekday()))
+		case 'y':
+			sb.WriteString(t.Format("06"))
+		case 'Y':
+			fmt.Fprintf(&sb, "%04d", t.Year())
+		case 'z':
+			sb.WriteString(t.Format("-0700"))
+		case 'Z':
+			sb.WriteString(t.Format("MST"))
+		case '%':
+			sb.WriteByte('%')
+		default:
+			return "", fmt.Errorf("unsupported format directive: %%%c", pattern[i])
+		}
+	}
+	return sb.String(), nil
+}
@@ -6,9 +6,11 @@ require (
 	github.com/Masterminds/semver v1.5.0
 	github.com/awalterschulze/gographviz v2.0.3+incompatible
 	github.com/c-bata/go-prompt v0.2.6
+	github.com/cespare/xxhash v1.1.0
 	github.com/dgraph-io/ristretto v0.0.3
 	github.com/golang/protobuf v1.5.2
 	github.com/google/btree v1.0.0
+	github.com/google/uuid v1.3.0
 	github.com/gosuri/uilive v0.0.4
 	github.com/jackc/pgx v3.6.2+incompatible
 	github.com/kr/text v0.2.0
@@ -33,7 +35,6 @@ require (
 
 require (
 	github.com/andybalholm/brotli v1.0.3 // indirect
-	github.com/cespare/xxhash v1.1.0 // indirect
 	github.com/cockroachdb/apd v1.1.0 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/dsnet/compress v0.0.1 // indirect
@@ -41,7 +42,6 @@ require (
 	github.com/gofrs/uuid v4.0.0+incompatible // indirect
 	github.com/golang/snappy v0.0.4 // indirect
 	github.com/google/go-cmp v0.5.6 // indirect
-	github.com/google/uuid v1.3.0 // indirect
 	github.com/inconshreveable/mousetrap v1.0.0 // indirect
 	github.com/jackc/fake v0.0.0-20150926172116-812a484cc733 // indirect
 	github.com/klauspost/compress v1.15.2 // indirect
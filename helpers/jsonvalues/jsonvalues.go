@@ -0,0 +1,147 @@
+package jsonvalues
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/valyala/fastjson"
+
+	"github.com/cube2222/octosql/octosql"
+)
+
+// GetOctoSQLType infers the type of the JSON value. Numbers are always Floats, and strings in RFC3339 format are Times.
+func GetOctoSQLType(value *fastjson.Value) octosql.Type {
+	switch value.Type() {
+	case fastjson.TypeNull:
+		return octosql.Null
+	case fastjson.TypeString:
+		v, _ := value.StringBytes()
+		if _, err := time.Parse(time.RFC3339Nano, string(v)); err == nil {
+			return octosql.Time
+		} else {
+			return octosql.String
+		}
+	case fastjson.TypeNumber:
+		return octosql.Float
+	case fastjson.TypeTrue, fastjson.TypeFalse:
+		return octosql.Boolean
+	case fastjson.TypeObject:
+		obj, _ := value.Object()
+		fields := make([]octosql.StructField, 0, obj.Len())
+		obj.Visit(func(key []byte, v *fastjson.Value) {
+			fields = append(fields, octosql.StructField{
+				Name: string(key),
+				Type: GetOctoSQLType(v),
+			})
+		})
+		sort.Slice(fields, func(i, j int) bool {
+			return fields[i].Name < fields[j].Name
+		})
+		return octosql.Type{
+			TypeID: octosql.TypeIDStruct,
+			Struct: struct{ Fields []octosql.StructField }{Fields: fields},
+		}
+	case fastjson.TypeArray:
+		arr, _ := value.Array()
+		var elementType *octosql.Type
+		for i := range arr {
+			if elementType != nil {
+				t := octosql.TypeSum(*elementType, GetOctoSQLType(arr[i]))
+				elementType = &t
+			} else {
+				t := GetOctoSQLType(arr[i])
+				elementType = &t
+			}
+		}
+		return octosql.Type{
+			TypeID: octosql.TypeIDList,
+			List: struct {
+				Element *octosql.Type
+			}{
+				Element: elementType,
+			},
+		}
+	}
+
+	panic(fmt.Sprintf("unexhaustive json input value match: %s %+v", value.Type().String(), value))
+}
+
+// GetOctoSQLValue decodes the JSON value as a value of the given type, reporting whether it matched the type.
+func GetOctoSQLValue(t octosql.Type, value *fastjson.Value) (out octosql.Value, ok bool) {
+	if value == nil {
+		return octosql.NewNull(), octosql.Null.Is(t) == octosql.TypeRelationIs
+	}
+
+	switch t.TypeID {
+	case octosql.TypeIDNull:
+		if value.Type() == fastjson.TypeNull {
+			return octosql.NewNull(), true
+		}
+	case octosql.TypeIDFloat:
+		if value.Type() == fastjson.TypeNumber {
+			v, _ := value.Float64()
+			return octosql.NewFloat(v), true
+		}
+	case octosql.TypeIDBoolean:
+		if value.Type() == fastjson.TypeTrue {
+			return octosql.NewBoolean(true), true
+		} else if value.Type() == fastjson.TypeFalse {
+			return octosql.NewBoolean(false), true
+		}
+	case octosql.TypeIDString:
+		if value.Type() == fastjson.TypeString {
+			v, _ := value.StringBytes()
+			return octosql.NewString(string(v)), true
+		}
+	case octosql.TypeIDTime:
+		if value.Type() == fastjson.TypeString {
+			v, _ := value.StringBytes()
+			if parsed, err := time.Parse(time.RFC3339Nano, string(v)); err == nil {
+				return octosql.NewTime(parsed), true
+			}
+		}
+	case octosql.TypeIDDuration:
+		if value.Type() == fastjson.TypeString {
+			v, _ := value.StringBytes()
+			if parsed, err := time.ParseDuration(string(v)); err == nil {
+				return octosql.NewDuration(parsed), true
+			}
+		}
+	case octosql.TypeIDList:
+		if value.Type() == fastjson.TypeArray {
+			arr, _ := value.Array()
+			values := make([]octosql.Value, len(arr))
+
+			outOk := true
+			for i := range arr {
+				curValue, curOk := GetOctoSQLValue(*t.List.Element, arr[i])
+				values[i] = curValue
+				outOk = outOk && curOk
+			}
+			return octosql.NewList(values), outOk
+		}
+	case octosql.TypeIDStruct:
+		if value.Type() == fastjson.TypeObject {
+			obj, _ := value.Object()
+			values := make([]octosql.Value, len(t.Struct.Fields))
+
+			outOk := true
+			for i, field := range t.Struct.Fields {
+				curValue, curOk := GetOctoSQLValue(field.Type, obj.Get(field.Name))
+				values[i] = curValue
+				outOk = outOk && curOk
+			}
+			return octosql.NewStruct(values), outOk
+		}
+	case octosql.TypeIDUnion:
+		for _, alternative := range t.Union.Alternatives {
+			v, ok := GetOctoSQLValue(alternative, value)
+			if ok {
+				return v, true
+			}
+		}
+	}
+
+	return octosql.ZeroValue, false
+}
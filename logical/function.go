@@ -43,7 +43,26 @@ descriptorLoop:
 		if descriptor.Strict {
 			argTypes = nonNullableArgumentTypes
 		}
-		if descriptor.TypeFn != nil {
+		if descriptor.TypecheckFn != nil {
+			typecheckArguments := make([]physical.Expression, len(arguments))
+			for i := range arguments {
+				typecheckArguments[i] = arguments[i]
+				typecheckArguments[i].Type = argTypes[i]
+			}
+			if outputType, function, ok := descriptor.TypecheckFn(typecheckArguments); ok {
+				descriptor.Function = function
+				found = true
+				out = physical.Expression{
+					Type:           outputType,
+					ExpressionType: physical.ExpressionTypeFunctionCall,
+					FunctionCall: &physical.FunctionCall{
+						Name:               fe.Name,
+						Arguments:          arguments,
+						FunctionDescriptor: descriptor,
+					},
+				}
+			}
+		} else if descriptor.TypeFn != nil {
 			if outputType, ok := descriptor.TypeFn(argTypes); ok {
 				found = true
 				out = physical.Expression{
@@ -95,13 +114,15 @@ descriptorLoop:
 					isMaybe[i] = true
 				}
 			}
+			assertedArguments := make([]physical.Expression, len(arguments))
 			for i := range arguments {
+				assertedArguments[i] = arguments[i]
 				if isMaybe[i] {
 					targetType := descriptor.ArgumentTypes[i]
 					if descriptor.Strict {
 						targetType = octosql.TypeSum(targetType, octosql.Null)
 					}
-					arguments[i] = physical.Expression{
+					assertedArguments[i] = physical.Expression{
 						ExpressionType: physical.ExpressionTypeTypeAssertion,
 						Type:           *octosql.TypeIntersection(targetType, arguments[i].Type),
 						TypeAssertion: &physical.TypeAssertion{
@@ -111,9 +132,27 @@ descriptorLoop:
 					}
 				}
 			}
+			outputType := descriptor.OutputType
+			if descriptor.TypecheckFn != nil {
+				typecheckArguments := make([]physical.Expression, len(assertedArguments))
+				for i := range assertedArguments {
+					typecheckArguments[i] = assertedArguments[i]
+					typecheckArguments[i].Type = argTypes[i]
+					if isMaybe[i] {
+						typecheckArguments[i].Type = *octosql.TypeIntersection(descriptor.ArgumentTypes[i], argTypes[i])
+					}
+				}
+				var function func([]octosql.Value) (octosql.Value, error)
+				var ok bool
+				if outputType, function, ok = descriptor.TypecheckFn(typecheckArguments); !ok {
+					continue
+				}
+				descriptor.Function = function
+			}
+			arguments = assertedArguments
 			found = true
 			out = physical.Expression{
-				Type:           descriptor.OutputType,
+				Type:           outputType,
 				ExpressionType: physical.ExpressionTypeFunctionCall,
 				FunctionCall: &physical.FunctionCall{
 					Name:               fe.Name,
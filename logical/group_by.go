@@ -74,20 +74,40 @@ func (w *EndOfStreamTrigger) Typecheck(ctx context.Context, env physical.Environ
 	}
 }
 
+// combineTriggers defaults to an end of stream trigger if there are no triggers.
+func combineTriggers(triggers []physical.Trigger) physical.Trigger {
+	if len(triggers) == 0 {
+		return physical.Trigger{
+			TriggerType:        physical.TriggerTypeEndOfStream,
+			EndOfStreamTrigger: &physical.EndOfStreamTrigger{},
+		}
+	} else if len(triggers) == 1 {
+		return triggers[0]
+	}
+	return physical.Trigger{
+		TriggerType: physical.TriggerTypeMulti,
+		MultiTrigger: &physical.MultiTrigger{
+			Triggers: triggers,
+		},
+	}
+}
+
 type GroupBy struct {
 	source   Node
 	key      []Expression
 	keyNames []string
 
-	expressions    []Expression
-	aggregates     []string
+	expressions []Expression
+	aggregates  []string
+	// filters contains the FILTER predicate of each aggregate, or nil if it has none.
+	filters        []Expression
 	aggregateNames []string
 
 	triggers []Trigger
 }
 
-func NewGroupBy(source Node, key []Expression, keyNames []string, expressions []Expression, aggregates []string, aggregateNames []string, triggers []Trigger) *GroupBy {
-	return &GroupBy{source: source, key: key, keyNames: keyNames, expressions: expressions, aggregates: aggregates, aggregateNames: aggregateNames, triggers: triggers}
+func NewGroupBy(source Node, key []Expression, keyNames []string, expressions []Expression, aggregates []string, filters []Expression, aggregateNames []string, triggers []Trigger) *GroupBy {
+	return &GroupBy{source: source, key: key, keyNames: keyNames, expressions: expressions, aggregates: aggregates, filters: filters, aggregateNames: aggregateNames, triggers: triggers}
 }
 
 func (node *GroupBy) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
@@ -112,85 +132,28 @@ func (node *GroupBy) Typecheck(ctx context.Context, env physical.Environment, lo
 	}
 
 	aggregates := make([]physical.Aggregate, len(node.aggregates))
-aggregateLoop:
 	for i, aggname := range node.aggregates {
-		details := env.Aggregates[aggname]
-		for _, descriptor := range details.Descriptors {
-			if descriptor.TypeFn != nil {
-				if outputType, ok := descriptor.TypeFn(expressions[i].Type); ok {
-					if octosql.Null.Is(expressions[i].Type) == octosql.TypeRelationIs {
-						outputType = octosql.TypeSum(outputType, octosql.Null)
-					}
-
-					aggregates[i] = physical.Aggregate{
-						Name:                node.aggregates[i],
-						OutputType:          outputType,
-						AggregateDescriptor: descriptor,
-					}
-					continue aggregateLoop
-				}
-			} else if expressions[i].Type.Is(octosql.TypeSum(descriptor.ArgumentType, octosql.Null)) == octosql.TypeRelationIs {
-				outputType := descriptor.OutputType
-				if octosql.Null.Is(expressions[i].Type) == octosql.TypeRelationIs {
-					outputType = octosql.TypeSum(descriptor.OutputType, octosql.Null)
-				}
+		aggregates[i], expressions[i] = TypecheckAggregate(env, aggname, expressions[i])
+	}
 
-				aggregates[i] = physical.Aggregate{
-					Name:                node.aggregates[i],
-					OutputType:          outputType,
-					AggregateDescriptor: descriptor,
-				}
-				continue aggregateLoop
-			}
+	filters := make([]*physical.Expression, len(node.filters))
+	for i := range node.filters {
+		if node.filters[i] == nil {
+			continue
 		}
-		for _, descriptor := range details.Descriptors {
-			if expressions[i].Type.Is(octosql.TypeSum(descriptor.ArgumentType, octosql.Null)) == octosql.TypeRelationMaybe {
-				assertedExprType := *octosql.TypeIntersection(octosql.TypeSum(descriptor.ArgumentType, octosql.Null), expressions[i].Type)
-				expressions[i] = physical.Expression{
-					ExpressionType: physical.ExpressionTypeTypeAssertion,
-					Type:           assertedExprType,
-					TypeAssertion: &physical.TypeAssertion{
-						Expression: expressions[i],
-						TargetType: descriptor.ArgumentType,
-					},
-				}
-
-				outputType := descriptor.OutputType
-				if octosql.Null.Is(assertedExprType) == octosql.TypeRelationIs {
-					outputType = octosql.TypeSum(descriptor.OutputType, octosql.Null)
-				}
-
-				aggregates[i] = physical.Aggregate{
-					Name:                node.aggregates[i],
-					OutputType:          outputType,
-					AggregateDescriptor: descriptor,
-				}
-				continue aggregateLoop
-			}
+		filter := TypecheckExpression(ctx, env.WithRecordSchema(source.Schema), logicalEnv.WithRecordUniqueVariableNames(mapping), octosql.TypeSum(octosql.Boolean, octosql.Null), node.filters[i])
+		filters[i] = &filter
+		if !aggregates[i].AggregateDescriptor.HasEmptyValue {
+			// No record of a group may pass the filter.
+			aggregates[i].OutputType = octosql.TypeSum(aggregates[i].OutputType, octosql.Null)
 		}
-		panic(fmt.Sprintf("unknown aggregate: %s(%s)", aggname, expressions[i].Type))
 	}
 
 	triggers := make([]physical.Trigger, len(node.triggers))
 	for i := range node.triggers {
 		triggers[i] = node.triggers[i].Typecheck(ctx, env, logicalEnv.WithRecordUniqueVariableNames(mapping), keyEventTimeIndex)
 	}
-	var trigger physical.Trigger
-	if len(triggers) == 0 {
-		trigger = physical.Trigger{
-			TriggerType:        physical.TriggerTypeEndOfStream,
-			EndOfStreamTrigger: &physical.EndOfStreamTrigger{},
-		}
-	} else if len(triggers) == 1 {
-		trigger = triggers[0]
-	} else {
-		trigger = physical.Trigger{
-			TriggerType: physical.TriggerTypeMulti,
-			MultiTrigger: &physical.MultiTrigger{
-				Triggers: triggers,
-			},
-		}
-	}
+	trigger := combineTriggers(triggers)
 
 	schemaFields := make([]physical.SchemaField, len(key)+len(aggregates))
 	outMapping := make(map[string]string)
@@ -218,9 +181,66 @@ aggregateLoop:
 			Source:               source,
 			Aggregates:           aggregates,
 			AggregateExpressions: expressions,
+			AggregateFilters:     filters,
 			Key:                  key,
 			KeyEventTimeIndex:    keyEventTimeIndex,
 			Trigger:              trigger,
 		},
 	}, outMapping
 }
+
+// TypecheckAggregate picks the aggregate overload matching the argument, asserting its type if necessary.
+func TypecheckAggregate(env physical.Environment, aggname string, expression physical.Expression) (physical.Aggregate, physical.Expression) {
+	details := env.Aggregates[aggname]
+	for _, descriptor := range details.Descriptors {
+		if descriptor.TypeFn != nil {
+			if outputType, ok := descriptor.TypeFn(expression.Type); ok {
+				if octosql.Null.Is(expression.Type) == octosql.TypeRelationIs {
+					outputType = octosql.TypeSum(outputType, octosql.Null)
+				}
+
+				return physical.Aggregate{
+					Name:                aggname,
+					OutputType:          outputType,
+					AggregateDescriptor: descriptor,
+				}, expression
+			}
+		} else if expression.Type.Is(octosql.TypeSum(descriptor.ArgumentType, octosql.Null)) == octosql.TypeRelationIs {
+			outputType := descriptor.OutputType
+			if octosql.Null.Is(expression.Type) == octosql.TypeRelationIs {
+				outputType = octosql.TypeSum(descriptor.OutputType, octosql.Null)
+			}
+
+			return physical.Aggregate{
+				Name:                aggname,
+				OutputType:          outputType,
+				AggregateDescriptor: descriptor,
+			}, expression
+		}
+	}
+	for _, descriptor := range details.Descriptors {
+		if expression.Type.Is(octosql.TypeSum(descriptor.ArgumentType, octosql.Null)) == octosql.TypeRelationMaybe {
+			assertedExprType := *octosql.TypeIntersection(octosql.TypeSum(descriptor.ArgumentType, octosql.Null), expression.Type)
+			expression = physical.Expression{
+				ExpressionType: physical.ExpressionTypeTypeAssertion,
+				Type:           assertedExprType,
+				TypeAssertion: &physical.TypeAssertion{
+					Expression: expression,
+					TargetType: descriptor.ArgumentType,
+				},
+			}
+
+			outputType := descriptor.OutputType
+			if octosql.Null.Is(assertedExprType) == octosql.TypeRelationIs {
+				outputType = octosql.TypeSum(descriptor.OutputType, octosql.Null)
+			}
+
+			return physical.Aggregate{
+				Name:                aggname,
+				OutputType:          outputType,
+				AggregateDescriptor: descriptor,
+			}, expression
+		}
+	}
+	panic(fmt.Sprintf("unknown aggregate: %s(%s)", aggname, expression.Type))
+}
@@ -0,0 +1,69 @@
+package logical
+
+import (
+	"context"
+
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+// GroupingSets appends the given key expressions and the index of the grouping set to each record of its source,
+// emitting it once for every grouping set, with the keys which are not part of the set set to null.
+type GroupingSets struct {
+	source       Node
+	key          []Expression
+	keyNames     []string
+	sets         [][]int
+	setIndexName string
+}
+
+func NewGroupingSets(source Node, key []Expression, keyNames []string, sets [][]int, setIndexName string) *GroupingSets {
+	return &GroupingSets{
+		source:       source,
+		key:          key,
+		keyNames:     keyNames,
+		sets:         sets,
+		setIndexName: setIndexName,
+	}
+}
+
+func (node *GroupingSets) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
+	source, mapping := node.source.Typecheck(ctx, env, logicalEnv)
+
+	key := make([]physical.Expression, len(node.key))
+	for i := range node.key {
+		key[i] = node.key[i].Typecheck(ctx, env.WithRecordSchema(source.Schema), logicalEnv.WithRecordUniqueVariableNames(mapping))
+	}
+
+	outMapping := make(map[string]string)
+	for k, v := range mapping {
+		outMapping[k] = v
+	}
+
+	schemaFields := make([]physical.SchemaField, len(source.Schema.Fields), len(source.Schema.Fields)+len(key)+1)
+	copy(schemaFields, source.Schema.Fields)
+	for i := range key {
+		unique := logicalEnv.GetUnique(node.keyNames[i])
+		outMapping[node.keyNames[i]] = unique
+		schemaFields = append(schemaFields, physical.SchemaField{
+			Name: unique,
+			Type: octosql.TypeSum(key[i].Type, octosql.Null),
+		})
+	}
+	unique := logicalEnv.GetUnique(node.setIndexName)
+	outMapping[node.setIndexName] = unique
+	schemaFields = append(schemaFields, physical.SchemaField{
+		Name: unique,
+		Type: octosql.Int,
+	})
+
+	return physical.Node{
+		Schema:   physical.NewSchema(schemaFields, source.Schema.TimeField, physical.WithNoRetractions(source.Schema.NoRetractions)),
+		NodeType: physical.NodeTypeGroupingSets,
+		GroupingSets: &physical.GroupingSets{
+			Source: source,
+			Key:    key,
+			Sets:   node.sets,
+		},
+	}, outMapping
+}
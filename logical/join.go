@@ -2,7 +2,9 @@ package logical
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/cube2222/octosql/octosql"
 	"github.com/cube2222/octosql/physical"
 )
 
@@ -10,6 +12,8 @@ type JoinType string
 
 const (
 	JoinTypeLeft  JoinType = "Left"
+	JoinTypeRight JoinType = "Right"
+	JoinTypeFull  JoinType = "Full"
 	JoinTypeInner JoinType = "Inner"
 )
 
@@ -21,16 +25,27 @@ const (
 	JoinStrategyStream    JoinStrategy = "STREAM"
 )
 
-// TODO: Support left joins.
-
 type Join struct {
 	left, right Node
+	joinType    JoinType
+	// Only used by outer joins, inner join predicates are put into a filter above the join.
+	predicate Expression
 }
 
 func NewJoin(left, right Node) *Join {
 	return &Join{
-		left:  left,
-		right: right,
+		left:     left,
+		right:    right,
+		joinType: JoinTypeInner,
+	}
+}
+
+func NewOuterJoin(left, right Node, joinType JoinType, predicate Expression) *Join {
+	return &Join{
+		left:      left,
+		right:     right,
+		joinType:  joinType,
+		predicate: predicate,
 	}
 }
 
@@ -44,46 +59,126 @@ func (node *Join) Typecheck(ctx context.Context, env physical.Environment, logic
 		rightMapping[k] = v
 	}
 
+	fields := append(left.Schema.Fields[:len(left.Schema.Fields):len(left.Schema.Fields)], right.Schema.Fields[:len(right.Schema.Fields):len(right.Schema.Fields)]...)
+
+	if node.joinType == JoinTypeInner {
+		return physical.Node{
+			Schema: physical.Schema{
+				Fields:        fields,
+				TimeField:     left.Schema.TimeField,
+				NoRetractions: left.Schema.NoRetractions && right.Schema.NoRetractions,
+			},
+			NodeType: physical.NodeTypeStreamJoin,
+			StreamJoin: &physical.StreamJoin{
+				Left:  left,
+				Right: right,
+			},
+		}, rightMapping
+	}
+
+	var leftKey, rightKey, predicates []physical.Expression
+	if node.predicate != nil {
+		predicate := TypecheckExpression(
+			ctx,
+			env.WithRecordSchema(physical.NewSchema(fields, -1)),
+			logicalEnv.WithRecordUniqueVariableNames(rightMapping),
+			octosql.TypeSum(octosql.Boolean, octosql.Null),
+			node.predicate,
+		)
+		leftKey, rightKey, predicates = splitJoinPredicate(left.Schema, right.Schema, predicate)
+	}
+
+	var joinType physical.StreamJoinType
+	outFields := make([]physical.SchemaField, len(fields))
+	copy(outFields, fields)
+	timeField := -1
+	switch node.joinType {
+	case JoinTypeLeft:
+		joinType = physical.StreamJoinTypeLeftOuter
+		nullableFields(outFields[len(left.Schema.Fields):])
+		timeField = left.Schema.TimeField
+	case JoinTypeRight:
+		joinType = physical.StreamJoinTypeRightOuter
+		nullableFields(outFields[:len(left.Schema.Fields)])
+		if right.Schema.TimeField != -1 {
+			timeField = len(left.Schema.Fields) + right.Schema.TimeField
+		}
+	case JoinTypeFull:
+		joinType = physical.StreamJoinTypeFullOuter
+		nullableFields(outFields)
+	default:
+		panic(fmt.Sprintf("invalid join type: %s", node.joinType))
+	}
+
 	return physical.Node{
-		Schema: physical.Schema{
-			Fields:        append(left.Schema.Fields[:len(left.Schema.Fields):len(left.Schema.Fields)], right.Schema.Fields[:len(right.Schema.Fields):len(right.Schema.Fields)]...),
-			TimeField:     left.Schema.TimeField,
-			NoRetractions: left.Schema.NoRetractions && right.Schema.NoRetractions,
-		},
+		// Null-padded records get retracted when a match arrives.
+		Schema:   physical.NewSchema(outFields, timeField),
 		NodeType: physical.NodeTypeStreamJoin,
 		StreamJoin: &physical.StreamJoin{
-			Left:  left,
-			Right: right,
+			Left:       left,
+			Right:      right,
+			LeftKey:    leftKey,
+			RightKey:   rightKey,
+			JoinType:   joinType,
+			Predicates: predicates,
 		},
 	}, rightMapping
 }
 
-// func usesVariablesFromLeftOrRight(left, right physical.Schema, variables []string) (usesLeft bool, usesRight bool) {
-// 	for _, name := range variables {
-// 		var matchedLeft, matchedRight bool
-// 		for _, field := range left.Fields {
-// 			if physical.VariableNameMatchesField(name, field.Name) {
-// 				usesLeft = true
-// 				matchedLeft = true
-// 				break
-// 			}
-// 		}
-// 		for _, field := range right.Fields {
-// 			if physical.VariableNameMatchesField(name, field.Name) {
-// 				usesRight = true
-// 				matchedRight = true
-// 				break
-// 			}
-// 		}
-// 		if matchedLeft && matchedRight {
-// 			panic(fmt.Errorf("ambiguous variable Name in join predicate: %s", name))
-// 		}
-// 	}
-// 	return
-// }
+// splitJoinPredicate extracts the equalities between left and right side expressions, which can be used as join keys.
+func splitJoinPredicate(left, right physical.Schema, predicate physical.Expression) (leftKey, rightKey, rest []physical.Expression) {
+	for _, part := range predicate.SplitByAnd() {
+		if part.ExpressionType != physical.ExpressionTypeFunctionCall || part.FunctionCall.Name != "=" {
+			rest = append(rest, part)
+			continue
+		}
+		firstPart := part.FunctionCall.Arguments[0]
+		secondPart := part.FunctionCall.Arguments[1]
+		firstPartUsesLeft, firstPartUsesRight := usesVariablesFromLeftOrRight(left, right, firstPart.VariablesUsed())
+		secondPartUsesLeft, secondPartUsesRight := usesVariablesFromLeftOrRight(left, right, secondPart.VariablesUsed())
+
+		if firstPartUsesLeft && !firstPartUsesRight && !secondPartUsesLeft && secondPartUsesRight {
+			leftKey = append(leftKey, firstPart)
+			rightKey = append(rightKey, secondPart)
+		} else if !firstPartUsesLeft && firstPartUsesRight && secondPartUsesLeft && !secondPartUsesRight {
+			leftKey = append(leftKey, secondPart)
+			rightKey = append(rightKey, firstPart)
+		} else {
+			rest = append(rest, part)
+		}
+	}
+	return leftKey, rightKey, rest
+}
+
+func usesVariablesFromLeftOrRight(left, right physical.Schema, variables []string) (usesLeft bool, usesRight bool) {
+	for _, name := range variables {
+		for _, field := range left.Fields {
+			if physical.VariableNameMatchesField(name, field.Name) {
+				usesLeft = true
+				break
+			}
+		}
+		for _, field := range right.Fields {
+			if physical.VariableNameMatchesField(name, field.Name) {
+				usesRight = true
+				break
+			}
+		}
+	}
+	return
+}
+
+func nullableFields(fields []physical.SchemaField) {
+	for i := range fields {
+		fields[i].Type = octosql.TypeSum(fields[i].Type, octosql.Null)
+	}
+}
 
 type LateralJoin struct {
 	left, right Node
+	outer       bool
+	// Only used by outer joins, inner join predicates are put into a filter above the join.
+	predicate Expression
 }
 
 func NewLateralJoin(left, right Node) *LateralJoin {
@@ -93,10 +188,26 @@ func NewLateralJoin(left, right Node) *LateralJoin {
 	}
 }
 
+// NewOuterLateralJoin creates a lateral join which emits the left record padded with nulls
+// when the right subquery returns no records for it.
+func NewOuterLateralJoin(left, right Node, predicate Expression) *LateralJoin {
+	return &LateralJoin{
+		left:      left,
+		right:     right,
+		outer:     true,
+		predicate: predicate,
+	}
+}
+
 func (node *LateralJoin) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
 	// TODO: This should currently fail when the joined stream has a time field / is endless.
 	left, leftMapping := node.left.Typecheck(ctx, env, logicalEnv)
-	right, rightMapping := node.right.Typecheck(ctx, env.WithRecordSchema(left.Schema), logicalEnv.WithRecordUniqueVariableNames(leftMapping))
+	rightNode := node.right
+	if node.predicate != nil {
+		// The predicate has to be evaluated inside the joined subquery, so that records not matching it result in null-padding.
+		rightNode = NewFilter(node.predicate, rightNode)
+	}
+	right, rightMapping := rightNode.Typecheck(ctx, env.WithRecordSchema(left.Schema), logicalEnv.WithRecordUniqueVariableNames(leftMapping))
 
 	for k, v := range leftMapping {
 		// Put all mapped variables into one map.
@@ -104,15 +215,23 @@ func (node *LateralJoin) Typecheck(ctx context.Context, env physical.Environment
 		rightMapping[k] = v
 	}
 
+	rightFields := right.Schema.Fields[:len(right.Schema.Fields):len(right.Schema.Fields)]
+	if node.outer {
+		rightFields = make([]physical.SchemaField, len(right.Schema.Fields))
+		copy(rightFields, right.Schema.Fields)
+		nullableFields(rightFields)
+	}
+
 	return physical.Node{
 		Schema: physical.Schema{
-			Fields:    append(left.Schema.Fields[:len(left.Schema.Fields):len(left.Schema.Fields)], right.Schema.Fields[:len(right.Schema.Fields):len(right.Schema.Fields)]...),
+			Fields:    append(left.Schema.Fields[:len(left.Schema.Fields):len(left.Schema.Fields)], rightFields...),
 			TimeField: left.Schema.TimeField,
 		},
 		NodeType: physical.NodeTypeLookupJoin,
 		LookupJoin: &physical.LookupJoin{
 			Source: left,
 			Joined: right,
+			Outer:  node.outer,
 		},
 	}, rightMapping
 }
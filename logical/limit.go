@@ -11,11 +11,14 @@ import (
 type Limit struct {
 	source Node
 	limit  Expression
+	offset Expression
 }
 
-func NewLimit(limit Expression, source Node) *Limit {
+// NewLimit creates a Limit node. Either limit or offset may be nil.
+func NewLimit(limit, offset Expression, source Node) *Limit {
 	return &Limit{
 		limit:  limit,
+		offset: offset,
 		source: source,
 	}
 }
@@ -26,7 +29,15 @@ func (node *Limit) Typecheck(ctx context.Context, env physical.Environment, logi
 		panic(fmt.Sprintf("LIMIT must either be in the top-level statement or operate on a stream on which retractions are not possible"))
 	}
 
-	limit := TypecheckExpression(ctx, env, logicalEnv, octosql.Int, node.limit)
+	var limit, offset *physical.Expression
+	if node.limit != nil {
+		expr := TypecheckExpression(ctx, env, logicalEnv, octosql.Int, node.limit)
+		limit = &expr
+	}
+	if node.offset != nil {
+		expr := TypecheckExpression(ctx, env, logicalEnv, octosql.Int, node.offset)
+		offset = &expr
+	}
 
 	return physical.Node{
 		Schema:   source.Schema,
@@ -34,6 +45,7 @@ func (node *Limit) Typecheck(ctx context.Context, env physical.Environment, logi
 		Limit: &physical.Limit{
 			Source: source,
 			Limit:  limit,
+			Offset: offset,
 		},
 	}, mapping
 }
@@ -13,6 +13,8 @@ type Environment struct {
 	TableValuedFunctions   map[string]TableValuedFunctionDescription
 	UniqueVariableNames    *VariableMapping
 	UniqueNameGenerator    map[string]int
+	// Parameters are the values bound to the :name and $1 placeholders of the query.
+	Parameters map[string]octosql.Value
 }
 
 func (env *Environment) GetUnique(name string) string {
@@ -32,6 +34,7 @@ func (env *Environment) WithRecordUniqueVariableNames(record map[string]string)
 		TableValuedFunctions:   env.TableValuedFunctions,
 		UniqueVariableNames:    env.UniqueVariableNames.WithRecordMapping(record),
 		UniqueNameGenerator:    env.UniqueNameGenerator,
+		Parameters:             env.Parameters,
 	}
 }
 
@@ -92,7 +95,7 @@ func NewDataSource(name, alias string, options map[string]string) *DataSource {
 
 func (ds *DataSource) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
 	if cte, ok := logicalEnv.CommonTableExpressions[ds.name]; ok {
-		return cte.Node, cte.UniqueVariableMapping
+		return cte.Node, requalifyMapping(ds.alias, cte.UniqueVariableMapping)
 	}
 
 	datasource, schema, err := env.Datasources.GetDatasource(ctx, ds.name, ds.options)
@@ -201,6 +204,24 @@ func (c *Constant) Typecheck(ctx context.Context, env physical.Environment, logi
 	}
 }
 
+// Parameter is a placeholder in the query, which gets replaced by the constant value bound to it during typechecking.
+// This way, parameter values can't change the structure of the query.
+type Parameter struct {
+	name string
+}
+
+func NewParameter(name string) *Parameter {
+	return &Parameter{name: name}
+}
+
+func (p *Parameter) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) physical.Expression {
+	value, ok := logicalEnv.Parameters[p.name]
+	if !ok {
+		panic(fmt.Errorf("no value bound to parameter %s", p.name))
+	}
+	return NewConstant(value).Typecheck(ctx, env, logicalEnv)
+}
+
 type Tuple struct {
 	expressions []Expression
 }
@@ -348,6 +369,75 @@ func (c *Coalesce) Typecheck(ctx context.Context, env physical.Environment, logi
 	}
 }
 
+type Case struct {
+	subject    Expression
+	conditions []Expression
+	values     []Expression
+	elseValue  Expression
+}
+
+// NewCase creates a searched CASE expression, elseValue may be nil.
+func NewCase(conditions, values []Expression, elseValue Expression) *Case {
+	return &Case{conditions: conditions, values: values, elseValue: elseValue}
+}
+
+// NewSimpleCase creates a simple CASE expression, which compares the subject with each of the WHEN values.
+// elseValue may be nil.
+func NewSimpleCase(subject Expression, whenValues, values []Expression, elseValue Expression) *Case {
+	return &Case{subject: subject, conditions: whenValues, values: values, elseValue: elseValue}
+}
+
+func (c *Case) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) physical.Expression {
+	if len(c.conditions) == 0 {
+		panic("CASE must be provided at least 1 WHEN clause")
+	}
+
+	if c.subject != nil {
+		// The subject is evaluated anew for each WHEN value it's compared with, so it has to always give the same value.
+		if subject := c.subject.Typecheck(ctx, env, logicalEnv); !subject.IsDeterministic() {
+			panic("CASE subject can't contain non-deterministic function calls, as it's evaluated for each WHEN clause")
+		}
+	}
+
+	conditions := make([]physical.Expression, len(c.conditions))
+	values := make([]physical.Expression, len(c.values))
+	for i := range c.conditions {
+		condition := c.conditions[i]
+		if c.subject != nil {
+			condition = NewFunctionExpression("=", []Expression{c.subject, condition})
+		}
+		conditions[i] = TypecheckExpression(ctx, env, logicalEnv, octosql.TypeSum(octosql.Boolean, octosql.Null), condition)
+		values[i] = c.values[i].Typecheck(ctx, env, logicalEnv)
+	}
+	var elseValue physical.Expression
+	if c.elseValue != nil {
+		elseValue = c.elseValue.Typecheck(ctx, env, logicalEnv)
+	} else {
+		elseValue = physical.Expression{
+			Type:           octosql.Null,
+			ExpressionType: physical.ExpressionTypeConstant,
+			Constant: &physical.Constant{
+				Value: octosql.NewNull(),
+			},
+		}
+	}
+
+	outputType := elseValue.Type
+	for _, expr := range values {
+		outputType = octosql.TypeSum(outputType, expr.Type)
+	}
+
+	return physical.Expression{
+		Type:           outputType,
+		ExpressionType: physical.ExpressionTypeCase,
+		Case: &physical.Case{
+			Conditions: conditions,
+			Values:     values,
+			Else:       elseValue,
+		},
+	}
+}
+
 type Cast struct {
 	arg          Expression
 	targetTypeID octosql.TypeID
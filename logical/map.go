@@ -25,6 +25,12 @@ func (node *Map) Typecheck(ctx context.Context, env physical.Environment, logica
 	source, mapping := node.source.Typecheck(ctx, env, logicalEnv)
 	reverseMapping := ReverseMapping(mapping)
 
+	// Window function values are appended to the fields of their source, but aren't part of star expressions.
+	starFields := source.Schema.Fields
+	for window, ok := node.source.(*Window); ok; window, ok = window.source.(*Window) {
+		starFields = starFields[:len(starFields)-1]
+	}
+
 	var expressions []physical.Expression
 	var aliases []*string
 	var unnests []int
@@ -48,7 +54,7 @@ func (node *Map) Typecheck(ctx context.Context, env physical.Environment, logica
 				aliases = append(aliases, nil)
 			}
 		} else {
-			for _, field := range source.Schema.Fields {
+			for _, field := range starFields {
 				if qualifier := node.starQualifier[i]; qualifier != "" {
 					if !strings.HasPrefix(reverseMapping[field.Name], qualifier+".") {
 						continue
@@ -0,0 +1,120 @@
+package logical
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+// maxRecursiveCTETypecheckPasses bounds the widening of the field types of a recursive common table expression.
+const maxRecursiveCTETypecheckPasses = 8
+
+type RecursiveCTE struct {
+	name      string
+	columns   []string
+	anchor    Node
+	recursive Node
+	distinct  bool
+}
+
+func NewRecursiveCTE(name string, columns []string, anchor, recursive Node, distinct bool) *RecursiveCTE {
+	return &RecursiveCTE{
+		name:      name,
+		columns:   columns,
+		anchor:    anchor,
+		recursive: recursive,
+		distinct:  distinct,
+	}
+}
+
+func (node *RecursiveCTE) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
+	anchor, anchorMapping := node.anchor.Typecheck(ctx, env, logicalEnv)
+
+	names := node.columns
+	if names == nil {
+		anchorReverseMapping := ReverseMapping(anchorMapping)
+		names = make([]string, len(anchor.Schema.Fields))
+		for i := range anchor.Schema.Fields {
+			names[i] = anchorReverseMapping[anchor.Schema.Fields[i].Name]
+		}
+	} else if len(names) != len(anchor.Schema.Fields) {
+		panic(fmt.Sprintf("recursive common table expression %s has %d columns, but its anchor has %d fields", node.name, len(names), len(anchor.Schema.Fields)))
+	}
+
+	cteName := logicalEnv.GetUnique(node.name)
+	outFields := make([]physical.SchemaField, len(anchor.Schema.Fields))
+	outMapping := make(map[string]string)
+	for i := range anchor.Schema.Fields {
+		unique := logicalEnv.GetUnique(names[i])
+		outMapping[names[i]] = unique
+		outFields[i] = physical.SchemaField{
+			Name: unique,
+			Type: anchor.Schema.Fields[i].Type,
+		}
+	}
+
+	// The recursive part may produce values of wider types than the anchor, in which case it has to be typechecked again,
+	// with the wider types used for its self-reference.
+	var recursive physical.Node
+	for pass := 0; ; pass++ {
+		if pass == maxRecursiveCTETypecheckPasses {
+			panic(fmt.Sprintf("couldn't infer the field types of recursive common table expression %s", node.name))
+		}
+
+		reference := physical.Node{
+			Schema:   physical.NewSchema(outFields, -1, physical.WithNoRetractions(true)),
+			NodeType: physical.NodeTypeRecursiveCTEReference,
+			RecursiveCTEReference: &physical.RecursiveCTEReference{
+				Name: cteName,
+			},
+		}
+		newCTEs := make(map[string]CommonTableExpression)
+		for k, v := range logicalEnv.CommonTableExpressions {
+			newCTEs[k] = v
+		}
+		newCTEs[node.name] = CommonTableExpression{
+			Node:                  reference,
+			UniqueVariableMapping: outMapping,
+		}
+
+		recursive, _ = node.recursive.Typecheck(ctx, env, Environment{
+			CommonTableExpressions: newCTEs,
+			TableValuedFunctions:   logicalEnv.TableValuedFunctions,
+			UniqueVariableNames:    logicalEnv.UniqueVariableNames,
+			UniqueNameGenerator:    logicalEnv.UniqueNameGenerator,
+			Parameters:             logicalEnv.Parameters,
+		})
+		if len(recursive.Schema.Fields) != len(outFields) {
+			panic(fmt.Sprintf("recursive part of common table expression %s has %d fields, but its anchor has %d", node.name, len(recursive.Schema.Fields), len(outFields)))
+		}
+
+		widened := false
+		newOutFields := make([]physical.SchemaField, len(outFields))
+		for i := range outFields {
+			newOutFields[i] = physical.SchemaField{
+				Name: outFields[i].Name,
+				Type: octosql.TypeSum(outFields[i].Type, recursive.Schema.Fields[i].Type),
+			}
+			if !newOutFields[i].Type.Equals(outFields[i].Type) {
+				widened = true
+			}
+		}
+		outFields = newOutFields
+		if !widened {
+			break
+		}
+	}
+
+	return physical.Node{
+		Schema:   physical.NewSchema(outFields, -1, physical.WithNoRetractions(true)),
+		NodeType: physical.NodeTypeRecursiveCTE,
+		RecursiveCTE: &physical.RecursiveCTE{
+			Name:      cteName,
+			Anchor:    renameUnionInput(anchor, outFields, -1),
+			Recursive: renameUnionInput(recursive, outFields, -1),
+			Distinct:  node.distinct,
+		},
+	}, outMapping
+}
@@ -0,0 +1,41 @@
+package logical
+
+import (
+	"context"
+
+	"github.com/cube2222/octosql/physical"
+)
+
+// SetOperation is an INTERSECT, or an EXCEPT if except is set.
+type SetOperation struct {
+	first, second Node
+	except        bool
+	all           bool
+}
+
+func NewIntersect(first, second Node, all bool) *SetOperation {
+	return &SetOperation{first: first, second: second, all: all}
+}
+
+func NewExcept(first, second Node, all bool) *SetOperation {
+	return &SetOperation{first: first, second: second, except: true, all: all}
+}
+
+func (node *SetOperation) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
+	// The inputs are matched the same way as union inputs are.
+	union, mapping := NewUnionAll(node.first, node.second).Typecheck(ctx, env, logicalEnv)
+
+	// Records of an except may get retracted when a matching record arrives on the second input.
+	noRetractions := union.Schema.NoRetractions && !node.except
+
+	return physical.Node{
+		Schema:   physical.NewSchema(union.Schema.Fields, -1, physical.WithNoRetractions(noRetractions)),
+		NodeType: physical.NodeTypeSetOperation,
+		SetOperation: &physical.SetOperation{
+			First:  union.UnionAll.First,
+			Second: union.UnionAll.Second,
+			Except: node.except,
+			All:    node.all,
+		},
+	}, mapping
+}
@@ -2,7 +2,9 @@ package logical
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/cube2222/octosql/octosql"
 	"github.com/cube2222/octosql/physical"
 )
 
@@ -15,5 +17,78 @@ func NewUnionAll(first, second Node) *UnionAll {
 }
 
 func (node *UnionAll) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
-	panic("implement me")
+	first, firstMapping := node.first.Typecheck(ctx, env, logicalEnv)
+	second, _ := node.second.Typecheck(ctx, env, logicalEnv)
+
+	if len(first.Schema.Fields) != len(second.Schema.Fields) {
+		panic(fmt.Sprintf("union inputs must have the same number of fields, first has %d, second has %d", len(first.Schema.Fields), len(second.Schema.Fields)))
+	}
+
+	// Fields are matched by position, the names are taken from the first input.
+	firstReverseMapping := ReverseMapping(firstMapping)
+	outFields := make([]physical.SchemaField, len(first.Schema.Fields))
+	outMapping := make(map[string]string)
+	for i := range first.Schema.Fields {
+		name := firstReverseMapping[first.Schema.Fields[i].Name]
+		unique := logicalEnv.GetUnique(name)
+		outMapping[name] = unique
+		outFields[i] = physical.SchemaField{
+			Name: unique,
+			Type: octosql.TypeSum(first.Schema.Fields[i].Type, second.Schema.Fields[i].Type),
+		}
+	}
+
+	outTimeField := -1
+	if first.Schema.TimeField != -1 && first.Schema.TimeField == second.Schema.TimeField {
+		outTimeField = first.Schema.TimeField
+	}
+
+	return physical.Node{
+		Schema:   physical.NewSchema(outFields, outTimeField, physical.WithNoRetractions(first.Schema.NoRetractions && second.Schema.NoRetractions)),
+		NodeType: physical.NodeTypeUnionAll,
+		UnionAll: &physical.UnionAll{
+			First:  renameUnionInput(first, outFields, outTimeField),
+			Second: renameUnionInput(second, outFields, outTimeField),
+		},
+	}, outMapping
+}
+
+// renameUnionInput wraps the input in a map, so that both union inputs share the output field names.
+// This way the optimizer sees which input fields are used and removes unused fields from both inputs at once.
+func renameUnionInput(source physical.Node, outFields []physical.SchemaField, outTimeField int) physical.Node {
+	expressions := make([]physical.Expression, len(source.Schema.Fields))
+	fields := make([]physical.SchemaField, len(source.Schema.Fields))
+	for i, field := range source.Schema.Fields {
+		expressions[i] = physical.Expression{
+			Type:           field.Type,
+			ExpressionType: physical.ExpressionTypeVariable,
+			Variable: &physical.Variable{
+				Name:     field.Name,
+				IsLevel0: true,
+			},
+		}
+		if !field.Type.Equals(outFields[i].Type) {
+			// A single argument coalesce fixes the object layout to the one of the output type.
+			expressions[i] = physical.Expression{
+				Type:           outFields[i].Type,
+				ExpressionType: physical.ExpressionTypeCoalesce,
+				Coalesce: &physical.Coalesce{
+					Arguments: []physical.Expression{expressions[i]},
+				},
+			}
+		}
+		fields[i] = physical.SchemaField{
+			Name: outFields[i].Name,
+			Type: expressions[i].Type,
+		}
+	}
+
+	return physical.Node{
+		Schema:   physical.NewSchema(fields, outTimeField, physical.WithNoRetractions(source.Schema.NoRetractions)),
+		NodeType: physical.NodeTypeMap,
+		Map: &physical.Map{
+			Source:      source,
+			Expressions: expressions,
+		},
+	}
 }
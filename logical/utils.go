@@ -338,6 +338,32 @@ func EqualExpressions(expr1, expr2 Expression) bool {
 			return EqualExpressions(expr1.object, expr2.object)
 		}
 
+	case *Case:
+		if expr2, ok := expr2.(*Case); ok {
+			if len(expr1.conditions) != len(expr2.conditions) {
+				return false
+			}
+			if expr1.subject == nil || expr2.subject == nil {
+				if expr1.subject != nil || expr2.subject != nil {
+					return false
+				}
+			} else if !EqualExpressions(expr1.subject, expr2.subject) {
+				return false
+			}
+			for i := range expr1.conditions {
+				if !EqualExpressions(expr1.conditions[i], expr2.conditions[i]) {
+					return false
+				}
+				if !EqualExpressions(expr1.values[i], expr2.values[i]) {
+					return false
+				}
+			}
+			if expr1.elseValue == nil || expr2.elseValue == nil {
+				return expr1.elseValue == nil && expr2.elseValue == nil
+			}
+			return EqualExpressions(expr1.elseValue, expr2.elseValue)
+		}
+
 	}
 	return false
 }
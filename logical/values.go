@@ -0,0 +1,71 @@
+package logical
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+// Values is an inline table, with its rows listed in a VALUES clause.
+type Values struct {
+	rows    [][]Expression
+	columns []string
+}
+
+func NewValues(rows [][]Expression, columns []string) *Values {
+	return &Values{
+		rows:    rows,
+		columns: columns,
+	}
+}
+
+func (node *Values) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
+	rows := make([][]physical.Expression, len(node.rows))
+	for i := range node.rows {
+		if len(node.rows[i]) != len(node.columns) {
+			panic(fmt.Sprintf("VALUES row with index %d has %d values, expected %d", i, len(node.rows[i]), len(node.columns)))
+		}
+		rows[i] = make([]physical.Expression, len(node.rows[i]))
+		for j := range node.rows[i] {
+			rows[i][j] = node.rows[i][j].Typecheck(ctx, env, logicalEnv)
+		}
+	}
+
+	outFields := make([]physical.SchemaField, len(node.columns))
+	outMapping := make(map[string]string)
+	for j := range node.columns {
+		unique := logicalEnv.GetUnique(node.columns[j])
+		outMapping[node.columns[j]] = unique
+		columnType := rows[0][j].Type
+		for i := 1; i < len(rows); i++ {
+			columnType = octosql.TypeSum(columnType, rows[i][j].Type)
+		}
+		outFields[j] = physical.SchemaField{
+			Name: unique,
+			Type: columnType,
+		}
+
+		for i := range rows {
+			if !rows[i][j].Type.Equals(columnType) {
+				// A single argument coalesce fixes the object layout to the one of the column type.
+				rows[i][j] = physical.Expression{
+					Type:           columnType,
+					ExpressionType: physical.ExpressionTypeCoalesce,
+					Coalesce: &physical.Coalesce{
+						Arguments: []physical.Expression{rows[i][j]},
+					},
+				}
+			}
+		}
+	}
+
+	return physical.Node{
+		Schema:   physical.NewSchema(outFields, -1, physical.WithNoRetractions(true)),
+		NodeType: physical.NodeTypeValues,
+		Values: &physical.Values{
+			Rows: rows,
+		},
+	}, outMapping
+}
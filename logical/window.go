@@ -0,0 +1,227 @@
+package logical
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+type WindowFrameBoundType string
+
+type WindowFrameBound struct {
+	Type   WindowFrameBoundType
+	Offset int
+}
+
+type WindowFrame struct {
+	Rows       bool
+	Start, End WindowFrameBound
+}
+
+// DefaultWindowFrame spans from the start of the partition up to the last peer of the current row.
+var DefaultWindowFrame = WindowFrame{
+	Rows:  false,
+	Start: WindowFrameBound{Type: "unbounded preceding"},
+	End:   WindowFrameBound{Type: "current row"},
+}
+
+func (bound WindowFrameBound) typecheck() physical.WindowFrameBound {
+	var boundType physical.WindowFrameBoundType
+	switch bound.Type {
+	case "unbounded preceding":
+		boundType = physical.WindowFrameBoundTypeUnboundedPreceding
+	case "preceding":
+		boundType = physical.WindowFrameBoundTypePreceding
+	case "current row":
+		boundType = physical.WindowFrameBoundTypeCurrentRow
+	case "following":
+		boundType = physical.WindowFrameBoundTypeFollowing
+	case "unbounded following":
+		boundType = physical.WindowFrameBoundTypeUnboundedFollowing
+	default:
+		panic(fmt.Errorf("invalid window frame bound: %s", bound.Type))
+	}
+	return physical.WindowFrameBound{
+		Type:   boundType,
+		Offset: bound.Offset,
+	}
+}
+
+// Window appends the value of a single window function to each record of its source.
+type Window struct {
+	source      Node
+	function    string
+	arguments   []Expression
+	partitionBy []Expression
+	orderBy     []Expression
+	directions  []OrderDirection
+	frame       WindowFrame
+	name        string
+	triggers    []Trigger
+}
+
+// WindowFieldNamePrefix is the prefix of the names of window function output fields.
+// It's reserved, so that those fields don't collide with the fields of the window source.
+const WindowFieldNamePrefix = "$window_"
+
+func NewWindow(source Node, function string, arguments []Expression, partitionBy []Expression, orderBy []Expression, directions []OrderDirection, frame WindowFrame, name string, triggers []Trigger) *Window {
+	return &Window{
+		source:      source,
+		function:    function,
+		arguments:   arguments,
+		partitionBy: partitionBy,
+		orderBy:     orderBy,
+		directions:  directions,
+		frame:       frame,
+		name:        name,
+		triggers:    triggers,
+	}
+}
+
+func (node *Window) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
+	source, mapping := node.source.Typecheck(ctx, env, logicalEnv)
+	if _, ok := GetUniqueNameMatchingVariable(mapping, node.name); ok {
+		panic(fmt.Errorf("window function output field '%s' collides with a source field", node.name))
+	}
+	recordEnv := env.WithRecordSchema(source.Schema)
+	recordLogicalEnv := logicalEnv.WithRecordUniqueVariableNames(mapping)
+
+	keyEventTimeIndex := -1
+
+	partitionBy := make([]physical.Expression, len(node.partitionBy))
+	for i := range node.partitionBy {
+		partitionBy[i] = node.partitionBy[i].Typecheck(ctx, recordEnv, recordLogicalEnv)
+		if source.Schema.TimeField != -1 &&
+			partitionBy[i].ExpressionType == physical.ExpressionTypeVariable &&
+			physical.VariableNameMatchesField(partitionBy[i].Variable.Name, source.Schema.Fields[source.Schema.TimeField].Name) {
+
+			keyEventTimeIndex = i
+		}
+	}
+
+	orderBy := make([]physical.Expression, len(node.orderBy))
+	for i := range node.orderBy {
+		orderBy[i] = node.orderBy[i].Typecheck(ctx, recordEnv, recordLogicalEnv)
+	}
+
+	frame := physical.WindowFrame{
+		Rows:  node.frame.Rows,
+		Start: node.frame.Start.typecheck(),
+		End:   node.frame.End.typecheck(),
+	}
+	if frame.Start.Type == physical.WindowFrameBoundTypeUnboundedFollowing {
+		panic("window frame can't start with UNBOUNDED FOLLOWING")
+	}
+	if frame.End.Type == physical.WindowFrameBoundTypeUnboundedPreceding {
+		panic("window frame can't end with UNBOUNDED PRECEDING")
+	}
+	if frame.Start.Type > frame.End.Type {
+		panic(fmt.Sprintf("window frame can't start with %s and end with %s", frame.Start.Type, frame.End.Type))
+	}
+	if !frame.Rows {
+		for _, bound := range []physical.WindowFrameBound{frame.Start, frame.End} {
+			if bound.Type == physical.WindowFrameBoundTypePreceding || bound.Type == physical.WindowFrameBoundTypeFollowing {
+				panic("RANGE window frames only support UNBOUNDED and CURRENT ROW bounds, use ROWS for offsets")
+			}
+		}
+	}
+	// The frame may not contain the current row, in which case it may be empty.
+	frameMayBeEmpty := frame.Start.Type == physical.WindowFrameBoundTypeFollowing || frame.End.Type == physical.WindowFrameBoundTypePreceding
+
+	arguments := make([]physical.Expression, len(node.arguments))
+	for i := range node.arguments {
+		arguments[i] = node.arguments[i].Typecheck(ctx, recordEnv, recordLogicalEnv)
+	}
+
+	var outputType octosql.Type
+	var aggregate *physical.Aggregate
+	switch node.function {
+	case "row_number", "rank", "dense_rank":
+		if len(arguments) != 0 {
+			panic(fmt.Sprintf("%s takes no arguments", node.function))
+		}
+		outputType = octosql.Int
+
+	case "lag", "lead":
+		if len(arguments) < 1 || len(arguments) > 3 {
+			panic(fmt.Sprintf("%s takes between 1 and 3 arguments", node.function))
+		}
+		defaultType := octosql.Null
+		if len(arguments) > 1 {
+			arguments[1] = TypecheckExpression(ctx, recordEnv, recordLogicalEnv, octosql.TypeSum(octosql.Int, octosql.Null), node.arguments[1])
+		}
+		if len(arguments) > 2 {
+			defaultType = arguments[2].Type
+		}
+		outputType = octosql.TypeSum(arguments[0].Type, defaultType)
+
+	case "first_value", "last_value":
+		if len(arguments) != 1 {
+			panic(fmt.Sprintf("%s takes exactly 1 argument", node.function))
+		}
+		outputType = arguments[0].Type
+		if frameMayBeEmpty {
+			outputType = octosql.TypeSum(outputType, octosql.Null)
+		}
+
+	default:
+		if _, ok := env.Aggregates[node.function]; !ok {
+			panic(fmt.Sprintf("unknown window function: %s", node.function))
+		}
+		if len(arguments) != 1 {
+			panic(fmt.Sprintf("aggregate window function %s takes exactly 1 argument", node.function))
+		}
+		typecheckedAggregate, argument := TypecheckAggregate(env, node.function, arguments[0])
+		aggregate = &typecheckedAggregate
+		arguments[0] = argument
+		outputType = aggregate.OutputType
+		if frameMayBeEmpty {
+			outputType = octosql.TypeSum(outputType, octosql.Null)
+		}
+	}
+
+	triggers := make([]physical.Trigger, len(node.triggers))
+	for i := range node.triggers {
+		triggers[i] = node.triggers[i].Typecheck(ctx, env, recordLogicalEnv, keyEventTimeIndex)
+	}
+	trigger := combineTriggers(triggers)
+
+	outMapping := make(map[string]string)
+	for k, v := range mapping {
+		outMapping[k] = v
+	}
+	unique := logicalEnv.GetUnique(node.name)
+	outMapping[node.name] = unique
+
+	schemaFields := make([]physical.SchemaField, len(source.Schema.Fields)+1)
+	copy(schemaFields, source.Schema.Fields)
+	schemaFields[len(source.Schema.Fields)] = physical.SchemaField{
+		Name: unique,
+		Type: outputType,
+	}
+
+	// Records get the event time of their partition, so the time field is only kept if it's part of the partition key.
+	timeField := -1
+	if keyEventTimeIndex != -1 {
+		timeField = source.Schema.TimeField
+	}
+
+	return physical.Node{
+		Schema:   physical.NewSchema(schemaFields, timeField, physical.WithNoRetractions(trigger.NoRetractions())),
+		NodeType: physical.NodeTypeWindow,
+		Window: &physical.Window{
+			Source:               source,
+			PartitionBy:          partitionBy,
+			OrderBy:              orderBy,
+			DirectionMultipliers: DirectionsToMultipliers(node.directions),
+			Function:             node.function,
+			Arguments:            arguments,
+			Aggregate:            aggregate,
+			Frame:                frame,
+			KeyEventTimeIndex:    keyEventTimeIndex,
+			Trigger:              trigger,
+		},
+	}, outMapping
+}
@@ -2,21 +2,25 @@ package logical
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/cube2222/octosql/physical"
 )
 
 type With struct {
 	cteNames []string
-	cteNodes []Node
-	source   Node
+	// cteColumns contains the column names of each common table expression, or nil if they're not renamed.
+	cteColumns [][]string
+	cteNodes   []Node
+	source     Node
 }
 
-func NewWith(cteNames []string, cteNodes []Node, source Node) *With {
+func NewWith(cteNames []string, cteColumns [][]string, cteNodes []Node, source Node) *With {
 	return &With{
-		cteNames: cteNames,
-		cteNodes: cteNodes,
-		source:   source,
+		cteNames:   cteNames,
+		cteColumns: cteColumns,
+		cteNodes:   cteNodes,
+		source:     source,
 	}
 }
 
@@ -32,7 +36,17 @@ func (node *With) Typecheck(ctx context.Context, env physical.Environment, logic
 			TableValuedFunctions:   logicalEnv.TableValuedFunctions,
 			UniqueVariableNames:    logicalEnv.UniqueVariableNames,
 			UniqueNameGenerator:    logicalEnv.UniqueNameGenerator,
+			Parameters:             logicalEnv.Parameters,
 		})
+		if columns := node.cteColumns[i]; columns != nil {
+			if len(columns) != len(cte.Schema.Fields) {
+				panic(fmt.Sprintf("common table expression %s has %d columns, but its query has %d fields", node.cteNames[i], len(columns), len(cte.Schema.Fields)))
+			}
+			mapping = make(map[string]string)
+			for j := range columns {
+				mapping[columns[j]] = cte.Schema.Fields[j].Name
+			}
+		}
 		newCTEs[node.cteNames[i]] = CommonTableExpression{
 			Node:                  cte,
 			UniqueVariableMapping: mapping,
@@ -44,5 +58,6 @@ func (node *With) Typecheck(ctx context.Context, env physical.Environment, logic
 		TableValuedFunctions:   logicalEnv.TableValuedFunctions,
 		UniqueVariableNames:    logicalEnv.UniqueVariableNames,
 		UniqueNameGenerator:    logicalEnv.UniqueNameGenerator,
+		Parameters:             logicalEnv.Parameters,
 	})
 }
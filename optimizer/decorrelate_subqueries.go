@@ -0,0 +1,292 @@
+package optimizer
+
+import (
+	"github.com/cube2222/octosql/octosql"
+	. "github.com/cube2222/octosql/physical"
+)
+
+// DecorrelateSubqueries rewrites filter predicates using EXISTS and IN subqueries into semi and anti stream joins,
+// so that the subquery isn't evaluated anew for each record.
+func DecorrelateSubqueries(node Node) (Node, bool) {
+	changed := false
+	t := Transformers{
+		NodeTransformer: func(node Node) Node {
+			if node.NodeType != NodeTypeFilter {
+				return node
+			}
+
+			source := node.Filter.Source
+			filterPredicates := node.Filter.Predicate.SplitByAnd()
+			var stayedAbove []Expression
+			for i := range filterPredicates {
+				if join, ok := decorrelateSubqueryPredicate(source, filterPredicates[i]); ok {
+					source = join
+					continue
+				}
+				stayedAbove = append(stayedAbove, filterPredicates[i])
+			}
+
+			if len(stayedAbove) == len(filterPredicates) {
+				return node
+			}
+			changed = true
+
+			if len(stayedAbove) == 0 {
+				return source
+			}
+			return Node{
+				Schema:   node.Schema,
+				NodeType: NodeTypeFilter,
+				Filter: &Filter{
+					Source: source,
+					Predicate: Expression{
+						Type:           octosql.Boolean,
+						ExpressionType: ExpressionTypeAnd,
+						And: &And{
+							Arguments: stayedAbove,
+						},
+					},
+				},
+			}
+		},
+	}
+	output := t.TransformNode(node)
+
+	if changed {
+		return output, true
+	} else {
+		return node, false
+	}
+}
+
+func decorrelateSubqueryPredicate(source Node, predicate Expression) (Node, bool) {
+	if predicate.ExpressionType != ExpressionTypeFunctionCall {
+		return Node{}, false
+	}
+
+	anti := false
+	if predicate.FunctionCall.Name == "not" {
+		anti = true
+		predicate = predicate.FunctionCall.Arguments[0]
+		if predicate.ExpressionType != ExpressionTypeFunctionCall {
+			return Node{}, false
+		}
+	}
+
+	var query Node
+	var inExpr *Expression
+	switch predicate.FunctionCall.Name {
+	case "exists":
+		if predicate.FunctionCall.Arguments[0].ExpressionType != ExpressionTypeQueryExpression {
+			return Node{}, false
+		}
+		query = predicate.FunctionCall.Arguments[0].QueryExpression.Source
+	case "in", "not in":
+		if predicate.FunctionCall.Name == "not in" {
+			anti = !anti
+		}
+		if predicate.FunctionCall.Arguments[1].ExpressionType != ExpressionTypeQueryExpression {
+			return Node{}, false
+		}
+		query = predicate.FunctionCall.Arguments[1].QueryExpression.Source
+		if len(query.Schema.Fields) != 1 {
+			return Node{}, false
+		}
+		inExpr = &predicate.FunctionCall.Arguments[0]
+	default:
+		return Node{}, false
+	}
+
+	// The subquery has to be a filter, optionally with a map on top of it, over a part which isn't correlated.
+	var projection Expression
+	if inExpr != nil {
+		projection = Expression{
+			Type:           query.Schema.Fields[0].Type,
+			ExpressionType: ExpressionTypeVariable,
+			Variable: &Variable{
+				Name:     query.Schema.Fields[0].Name,
+				IsLevel0: true,
+			},
+		}
+	}
+	if query.NodeType == NodeTypeMap {
+		if inExpr != nil {
+			projection = query.Map.Expressions[0]
+		}
+		query = query.Map.Source
+	}
+	var subqueryPredicates []Expression
+	if query.NodeType == NodeTypeFilter {
+		subqueryPredicates = query.Filter.Predicate.SplitByAnd()
+		query = query.Filter.Source
+	}
+	if nodeUsesOuterVariables(query) {
+		return Node{}, false
+	}
+
+	if inExpr != nil {
+		if expressionUsesOuterVariables(projection) || expressionUsesOuterVariables(*inExpr) {
+			return Node{}, false
+		}
+		// NOT IN evaluates to NULL if any of the compared values is NULL, which an anti join can't express.
+		if anti && (octosql.Null.Is(inExpr.Type) != octosql.TypeRelationIsnt || octosql.Null.Is(projection.Type) != octosql.TypeRelationIsnt) {
+			return Node{}, false
+		}
+	}
+
+	var leftKey, rightKey, joinPredicates, stayedInSubquery []Expression
+	if inExpr != nil {
+		leftKey = append(leftKey, *inExpr)
+		rightKey = append(rightKey, projection)
+	}
+	for _, subqueryPredicate := range subqueryPredicates {
+		if !expressionUsesOuterVariables(subqueryPredicate) {
+			stayedInSubquery = append(stayedInSubquery, subqueryPredicate)
+			continue
+		}
+		if !outerVariablesComeFromSchema(source.Schema, subqueryPredicate) {
+			return Node{}, false
+		}
+
+		if subqueryPredicate.ExpressionType == ExpressionTypeFunctionCall &&
+			subqueryPredicate.FunctionCall.Name == "=" {
+			first, second := subqueryPredicate.FunctionCall.Arguments[0], subqueryPredicate.FunctionCall.Arguments[1]
+			if onlyUsesOuterVariables(first) && !expressionUsesOuterVariables(second) {
+				leftKey = append(leftKey, outerVariablesToLevel0(first))
+				rightKey = append(rightKey, second)
+				continue
+			}
+			if onlyUsesOuterVariables(second) && !expressionUsesOuterVariables(first) {
+				leftKey = append(leftKey, outerVariablesToLevel0(second))
+				rightKey = append(rightKey, first)
+				continue
+			}
+		}
+		joinPredicates = append(joinPredicates, outerVariablesToLevel0(subqueryPredicate))
+	}
+
+	right := query
+	if len(stayedInSubquery) > 0 {
+		right = Node{
+			Schema:   query.Schema,
+			NodeType: NodeTypeFilter,
+			Filter: &Filter{
+				Source: query,
+				Predicate: Expression{
+					Type:           octosql.Boolean,
+					ExpressionType: ExpressionTypeAnd,
+					And: &And{
+						Arguments: stayedInSubquery,
+					},
+				},
+			},
+		}
+	}
+
+	joinType := StreamJoinTypeLeftSemi
+	if anti {
+		joinType = StreamJoinTypeLeftAnti
+	}
+
+	return Node{
+		Schema:   NewSchema(source.Schema.Fields, source.Schema.TimeField, WithNoRetractions(false)),
+		NodeType: NodeTypeStreamJoin,
+		StreamJoin: &StreamJoin{
+			Left:       source,
+			Right:      right,
+			LeftKey:    leftKey,
+			RightKey:   rightKey,
+			JoinType:   joinType,
+			Predicates: joinPredicates,
+		},
+	}, true
+}
+
+func nodeUsesOuterVariables(node Node) bool {
+	used := false
+	usageChecker := Transformers{
+		ExpressionTransformer: func(expr Expression) Expression {
+			if expr.ExpressionType == ExpressionTypeVariable && !expr.Variable.IsLevel0 {
+				used = true
+			}
+			return expr
+		},
+		NodeTransformer: func(node Node) Node {
+			// Lookup joins evaluate the joined side in the context of the source record.
+			if node.NodeType == NodeTypeLookupJoin {
+				used = true
+			}
+			return node
+		},
+	}
+	usageChecker.TransformNode(node)
+	return used
+}
+
+func expressionUsesOuterVariables(expr Expression) bool {
+	used := false
+	usageChecker := Transformers{
+		ExpressionTransformer: func(expr Expression) Expression {
+			if expr.ExpressionType == ExpressionTypeVariable && !expr.Variable.IsLevel0 {
+				used = true
+			}
+			if expr.ExpressionType == ExpressionTypeQueryExpression {
+				used = true
+			}
+			return expr
+		},
+	}
+	usageChecker.TransformExpr(expr)
+	return used
+}
+
+func onlyUsesOuterVariables(expr Expression) bool {
+	onlyOuter := true
+	usageChecker := Transformers{
+		ExpressionTransformer: func(expr Expression) Expression {
+			if expr.ExpressionType == ExpressionTypeVariable && expr.Variable.IsLevel0 {
+				onlyOuter = false
+			}
+			if expr.ExpressionType == ExpressionTypeQueryExpression {
+				onlyOuter = false
+			}
+			return expr
+		},
+	}
+	usageChecker.TransformExpr(expr)
+	return onlyOuter
+}
+
+func outerVariablesComeFromSchema(schema Schema, expr Expression) bool {
+	ok := true
+	usageChecker := Transformers{
+		ExpressionTransformer: func(expr Expression) Expression {
+			if expr.ExpressionType == ExpressionTypeVariable && !expr.Variable.IsLevel0 &&
+				!usesVariablesFromSchema(schema, []string{expr.Variable.Name}) {
+				ok = false
+			}
+			return expr
+		},
+	}
+	usageChecker.TransformExpr(expr)
+	return ok
+}
+
+func outerVariablesToLevel0(expr Expression) Expression {
+	t := Transformers{
+		ExpressionTransformer: func(expr Expression) Expression {
+			if expr.ExpressionType == ExpressionTypeVariable && !expr.Variable.IsLevel0 {
+				return Expression{
+					Type:           expr.Type,
+					ExpressionType: ExpressionTypeVariable,
+					Variable: &Variable{
+						Name:     expr.Variable.Name,
+						IsLevel0: true,
+					},
+				}
+			}
+			return expr
+		},
+	}
+	return t.TransformExpr(expr)
+}
@@ -16,7 +16,15 @@ func PushDownFilterPredicatesToDatasource(node Node) (Node, bool) {
 				return node
 			}
 
-			filterPredicates := node.Filter.Predicate.SplitByAnd()
+			// Datasources may evaluate pushed down predicates any number of times, or keep them in the filter as well.
+			var filterPredicates, nonDeterministicPredicates []Expression
+			for _, predicate := range node.Filter.Predicate.SplitByAnd() {
+				if predicate.IsDeterministic() {
+					filterPredicates = append(filterPredicates, predicate)
+				} else {
+					nonDeterministicPredicates = append(nonDeterministicPredicates, predicate)
+				}
+			}
 			alreadyPushedDown := node.Filter.Source.Datasource.Predicates
 
 			newFilterPredicates, newPushedDownPredicates, curChanged := node.Filter.Source.Datasource.PushDownPredicates(filterPredicates, alreadyPushedDown)
@@ -24,6 +32,7 @@ func PushDownFilterPredicatesToDatasource(node Node) (Node, bool) {
 				return node
 			}
 			changed = true
+			newFilterPredicates = append(newFilterPredicates, nonDeterministicPredicates...)
 
 			out := Node{
 				Schema:   node.Filter.Source.Schema,
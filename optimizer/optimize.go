@@ -5,6 +5,7 @@ import (
 )
 
 var defaultOptimizationRules = []func(Node) (output Node, changed bool){
+	DecorrelateSubqueries,
 	PushDownFilterUnderRequalifier,
 	PushDownFilterPredicatesToDatasource,
 	PushDownFilterPredicatesIntoLookupJoinBranch,
@@ -14,6 +15,7 @@ var defaultOptimizationRules = []func(Node) (output Node, changed bool){
 	RemoveUnusedGroupByNonKeyFields,
 	RemoveUnusedDatasourceFields,
 	MergeFilters,
+	PushLimitIntoOrderBy,
 }
 
 func Optimize(node Node) Node {
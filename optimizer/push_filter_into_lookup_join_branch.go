@@ -15,23 +15,35 @@ func PushDownFilterPredicatesIntoLookupJoinBranch(node Node) (Node, bool) {
 			if node.Filter.Source.NodeType != NodeTypeLookupJoin {
 				return node
 			}
-			changed = true
 
 			sourceSchema := node.Filter.Source.LookupJoin.Source.Schema
 			joinedSchema := node.Filter.Source.LookupJoin.Joined.Schema
 
 			filterPredicates := node.Filter.Predicate.SplitByAnd()
-			var pushedDownSource, pushedDownJoined []Expression
+			var pushedDownSource, pushedDownJoined, stayedAbove []Expression
 
 			for i := range filterPredicates {
+				if !filterPredicates[i].IsDeterministic() {
+					// Filtering a join branch evaluates the predicate a different number of times than filtering the joined records.
+					stayedAbove = append(stayedAbove, filterPredicates[i])
+					continue
+				}
 				variablesUsed := filterPredicates[i].VariablesUsed()
 				if !usesVariablesFromSchema(joinedSchema, variablesUsed) {
 					pushedDownSource = append(pushedDownSource, filterPredicates[i])
+				} else if node.Filter.Source.LookupJoin.Outer {
+					// Filtering the joined stream of an outer join would result in null-padded records, instead of no records.
+					stayedAbove = append(stayedAbove, filterPredicates[i])
 				} else {
 					pushedDownJoined = append(pushedDownJoined, filterPredicates[i])
 				}
 			}
 
+			if len(stayedAbove) == len(filterPredicates) {
+				return node
+			}
+			changed = true
+
 			joinSourceSource := node.Filter.Source.LookupJoin.Source
 			if len(pushedDownSource) > 0 {
 				joinSourceSource = Node{
@@ -76,8 +88,25 @@ func PushDownFilterPredicatesIntoLookupJoinBranch(node Node) (Node, bool) {
 				LookupJoin: &LookupJoin{
 					Source: joinSourceSource,
 					Joined: joinSourceJoined,
+					Outer:  node.Filter.Source.LookupJoin.Outer,
 				},
 			}
+			if len(stayedAbove) > 0 {
+				out = Node{
+					Schema:   out.Schema,
+					NodeType: NodeTypeFilter,
+					Filter: &Filter{
+						Predicate: Expression{
+							Type:           octosql.Boolean,
+							ExpressionType: ExpressionTypeAnd,
+							And: &And{
+								Arguments: stayedAbove,
+							},
+						},
+						Source: out,
+					},
+				}
+			}
 
 			return out
 		},
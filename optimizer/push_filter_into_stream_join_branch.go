@@ -17,24 +17,37 @@ func PushDownFilterPredicatesIntoStreamJoinBranch(node Node) (Node, bool) {
 			}
 			leftSchema := node.Filter.Source.StreamJoin.Left.Schema
 			rightSchema := node.Filter.Source.StreamJoin.Right.Schema
+			// We can't filter the side of an outer join which gets padded with nulls,
+			// as the records from the other side would then be emitted null-padded, instead of being filtered out.
+			joinType := node.Filter.Source.StreamJoin.JoinType
+			canPushLeft := joinType == StreamJoinTypeInner || joinType == StreamJoinTypeLeftOuter || joinType == StreamJoinTypeLeftSemi || joinType == StreamJoinTypeLeftAnti
+			canPushRight := joinType == StreamJoinTypeInner || joinType == StreamJoinTypeRightOuter
 
 			filterPredicates := node.Filter.Predicate.SplitByAnd()
 			var stayedAbove, pushedDownLeft, pushedDownRight []Expression
 
 			for i := range filterPredicates {
+				if !filterPredicates[i].IsDeterministic() {
+					// Filtering a join branch evaluates the predicate once per record of that branch, instead of once per joined record.
+					stayedAbove = append(stayedAbove, filterPredicates[i])
+					continue
+				}
 				variablesUsed := filterPredicates[i].VariablesUsed()
 				// Clarification:
 				// If it doesn't use variables from any join branch,
 				// then it gets pushed down into both.
 				usesLeftBranch := usesVariablesFromSchema(leftSchema, variablesUsed)
 				usesRightBranch := usesVariablesFromSchema(rightSchema, variablesUsed)
-				if !usesLeftBranch {
+				pushedDown := false
+				if !usesLeftBranch && canPushRight {
 					pushedDownRight = append(pushedDownRight, filterPredicates[i])
+					pushedDown = true
 				}
-				if !usesRightBranch {
+				if !usesRightBranch && canPushLeft {
 					pushedDownLeft = append(pushedDownLeft, filterPredicates[i])
+					pushedDown = true
 				}
-				if usesLeftBranch && usesRightBranch {
+				if !pushedDown {
 					stayedAbove = append(stayedAbove, filterPredicates[i])
 				}
 			}
@@ -83,10 +96,12 @@ func PushDownFilterPredicatesIntoStreamJoinBranch(node Node) (Node, bool) {
 				Schema:   node.Filter.Source.Schema,
 				NodeType: NodeTypeStreamJoin,
 				StreamJoin: &StreamJoin{
-					LeftKey:  node.Filter.Source.StreamJoin.LeftKey,
-					RightKey: node.Filter.Source.StreamJoin.RightKey,
-					Left:     joinSourceLeft,
-					Right:    joinSourceRight,
+					LeftKey:    node.Filter.Source.StreamJoin.LeftKey,
+					RightKey:   node.Filter.Source.StreamJoin.RightKey,
+					Left:       joinSourceLeft,
+					Right:      joinSourceRight,
+					JoinType:   node.Filter.Source.StreamJoin.JoinType,
+					Predicates: node.Filter.Source.StreamJoin.Predicates,
 				},
 			}
 			if len(stayedAbove) > 0 {
@@ -15,6 +15,10 @@ func PushDownFilterPredicatesIntoStreamJoinKey(node Node) (Node, bool) {
 			if node.Filter.Source.NodeType != NodeTypeStreamJoin {
 				return node
 			}
+			if node.Filter.Source.StreamJoin.JoinType != StreamJoinTypeInner {
+				// For outer joins, a filter above the join isn't equivalent to a join condition.
+				return node
+			}
 			leftSchema := node.Filter.Source.StreamJoin.Left.Schema
 			rightSchema := node.Filter.Source.StreamJoin.Right.Schema
 
@@ -30,6 +34,11 @@ func PushDownFilterPredicatesIntoStreamJoinKey(node Node) (Node, bool) {
 					stayedAbove = append(stayedAbove, filterPredicates[i])
 					continue
 				}
+				if !filterPredicates[i].IsDeterministic() {
+					// Key expressions are evaluated once per record of each branch, instead of once per joined record.
+					stayedAbove = append(stayedAbove, filterPredicates[i])
+					continue
+				}
 				firstPart := filterPredicates[i].FunctionCall.Arguments[0]
 				secondPart := filterPredicates[i].FunctionCall.Arguments[1]
 				firstPartVariables := firstPart.VariablesUsed()
@@ -0,0 +1,72 @@
+package optimizer
+
+import (
+	"github.com/cube2222/octosql/octosql"
+	. "github.com/cube2222/octosql/physical"
+)
+
+// PushLimitIntoOrderBy bounds the number of records kept by an order by, when it's followed by a constant limit.
+// This is only done if the order by source contains no retractions, as otherwise evicted records might be needed later.
+func PushLimitIntoOrderBy(node Node) (Node, bool) {
+	changed := false
+	t := Transformers{
+		NodeTransformer: func(node Node) Node {
+			if node.NodeType != NodeTypeLimit {
+				return node
+			}
+			if node.Limit.Source.NodeType != NodeTypeOrderBy {
+				return node
+			}
+			orderBy := node.Limit.Source.OrderBy
+			if orderBy.Limit != nil || !orderBy.Source.Schema.NoRetractions {
+				return node
+			}
+			// A NULL limit or offset is treated as no limit or offset by the limit node, so only integers are pushed down.
+			if node.Limit.Limit == nil || node.Limit.Limit.ExpressionType != ExpressionTypeConstant ||
+				node.Limit.Limit.Constant.Value.TypeID != octosql.TypeIDInt {
+				return node
+			}
+			bound := node.Limit.Limit.Constant.Value.Int
+			if node.Limit.Offset != nil {
+				if node.Limit.Offset.ExpressionType != ExpressionTypeConstant ||
+					node.Limit.Offset.Constant.Value.TypeID != octosql.TypeIDInt {
+					return node
+				}
+				bound += node.Limit.Offset.Constant.Value.Int
+			}
+			changed = true
+
+			return Node{
+				Schema:   node.Schema,
+				NodeType: NodeTypeLimit,
+				Limit: &Limit{
+					Source: Node{
+						Schema:   node.Limit.Source.Schema,
+						NodeType: NodeTypeOrderBy,
+						OrderBy: &OrderBy{
+							Source:               orderBy.Source,
+							Key:                  orderBy.Key,
+							DirectionMultipliers: orderBy.DirectionMultipliers,
+							Limit: &Expression{
+								Type:           octosql.Int,
+								ExpressionType: ExpressionTypeConstant,
+								Constant: &Constant{
+									Value: octosql.NewInt(bound),
+								},
+							},
+						},
+					},
+					Limit:  node.Limit.Limit,
+					Offset: node.Limit.Offset,
+				},
+			}
+		},
+	}
+	output := t.TransformNode(node)
+
+	if changed {
+		return output, true
+	} else {
+		return node, false
+	}
+}
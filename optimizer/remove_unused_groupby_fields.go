@@ -65,6 +65,7 @@ func removeGroupByField(field string, node Node) Node {
 			node.Schema.Fields = append(node.Schema.Fields[:index], node.Schema.Fields[index+1:]...)
 			node.GroupBy.AggregateExpressions = append(node.GroupBy.AggregateExpressions[:aggregateIndex], node.GroupBy.AggregateExpressions[aggregateIndex+1:]...)
 			node.GroupBy.Aggregates = append(node.GroupBy.Aggregates[:aggregateIndex], node.GroupBy.Aggregates[aggregateIndex+1:]...)
+			node.GroupBy.AggregateFilters = append(node.GroupBy.AggregateFilters[:aggregateIndex], node.GroupBy.AggregateFilters[aggregateIndex+1:]...)
 			if node.Schema.TimeField > index {
 				node.Schema.TimeField--
 			}
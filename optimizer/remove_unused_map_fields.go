@@ -72,15 +72,22 @@ func isUsed(field string, node Node) bool {
 			return expr
 		},
 		NodeTransformer: func(node Node) Node {
-			if node.NodeType != NodeTypeTableValuedFunction {
-				return node
-			}
-			for _, arg := range node.TableValuedFunction.Arguments {
-				if arg.TableValuedFunctionArgumentType != TableValuedFunctionArgumentTypeDescriptor {
-					continue
+			switch node.NodeType {
+			case NodeTypeTableValuedFunction:
+				for _, arg := range node.TableValuedFunction.Arguments {
+					if arg.TableValuedFunctionArgumentType != TableValuedFunctionArgumentTypeDescriptor {
+						continue
+					}
+					if arg.Descriptor.Descriptor == field {
+						used = true
+					}
 				}
-				if arg.Descriptor.Descriptor == field {
-					used = true
+			case NodeTypeDistinct, NodeTypeSetOperation:
+				// All fields are compared, so removing any of them would change the result.
+				for i := range node.Schema.Fields {
+					if node.Schema.Fields[i].Name == field {
+						used = true
+					}
 				}
 			}
 
@@ -25,18 +25,20 @@ type OutputPrinter struct {
 	keyExprs             []Expression
 	directionMultipliers []int
 	limit                int
+	offset               int
 
 	schema physical.Schema
 	format func(io.Writer) Format
 	live   bool
 }
 
-func NewOutputPrinter(source Node, keyExprs []Expression, directionMultipliers []int, limit int, schema physical.Schema, format func(io.Writer) Format, live bool) *OutputPrinter {
+func NewOutputPrinter(source Node, keyExprs []Expression, directionMultipliers []int, limit, offset int, schema physical.Schema, format func(io.Writer) Format, live bool) *OutputPrinter {
 	return &OutputPrinter{
 		source:               source,
 		keyExprs:             keyExprs,
 		directionMultipliers: directionMultipliers,
 		limit:                limit,
+		offset:               offset,
 		schema:               schema,
 		format:               format,
 		live:                 live,
@@ -133,9 +135,14 @@ func (o *OutputPrinter) Run(execCtx ExecutionContext) error {
 				format.SetSchema(o.schema)
 
 				i := 0
+				skipped := 0
 				recordCounts.Ascend(func(item btree.Item) bool {
 					itemTyped := item.(*outputItem)
 					for j := 0; j < itemTyped.Count; j++ {
+						if skipped < o.offset {
+							skipped++
+							continue
+						}
 						if o.limit > 0 && i == o.limit {
 							return false
 						}
@@ -163,9 +170,14 @@ func (o *OutputPrinter) Run(execCtx ExecutionContext) error {
 	format := o.format(&buf)
 	format.SetSchema(o.schema)
 	i := 0
+	skipped := 0
 	recordCounts.Ascend(func(item btree.Item) bool {
 		itemTyped := item.(*outputItem)
 		for j := 0; j < itemTyped.Count; j++ {
+			if skipped < o.offset {
+				skipped++
+				continue
+			}
 			if o.limit > 0 && i == o.limit {
 				return false
 			}
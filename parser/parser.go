@@ -15,39 +15,9 @@ import (
 	"github.com/cube2222/octosql/parser/sqlparser"
 )
 
-// func ParseUnion(statement *sqlparser.Union) (logical.Node, error) {
-// 	var err error
-// 	var root logical.Node
-//
-// 	if statement.OrderBy != nil {
-// 		return nil, errors.Errorf("order by is currently unsupported, got %+v", statement)
-// 	}
-//
-// 	firstNode, _, err := ParseNode(statement.Left)
-// 	if err != nil {
-// 		return nil, errors.Wrap(err, "couldn't parse first select expression")
-// 	}
-//
-// 	secondNode, _, err := ParseNode(statement.Right)
-// 	if err != nil {
-// 		return nil, errors.Wrap(err, "couldn't parse second select expression")
-// 	}
-// 	switch statement.Type {
-// 	case sqlparser.UnionAllStr:
-// 		root = logical.NewUnionAll(firstNode, secondNode)
-//
-// 	case sqlparser.UnionDistinctStr, sqlparser.UnionStr:
-// 		root = logical.NewUnionDistinct(firstNode, secondNode)
-//
-// 	default:
-// 		return nil, errors.Errorf("unsupported union %+v of type %v", statement, statement.Type)
-// 	}
-//
-// 	return root, nil
-// }
-
 type OutputOptions struct {
 	Limit              int
+	Offset             int
 	OrderByExpressions []logical.Expression
 	OrderByDirections  []logical.OrderDirection
 }
@@ -91,29 +61,42 @@ func ParseSelect(statement *sqlparser.Select, topmost bool) (logical.Node, *Outp
 			}
 		}
 	}
+	for i := range statement.GroupBy {
+		if _, ok := statement.GroupBy[i].(*sqlparser.GroupingSetsExpr); ok {
+			isGroupBy = true
+		}
+	}
 	if isGroupBy {
-		key := make([]logical.Expression, len(statement.GroupBy))
-		for i := range statement.GroupBy {
-			key[i], err = ParseExpression(statement.GroupBy[i])
-			if err != nil {
-				return nil, nil, errors.Wrapf(err, "couldn't parse group key expression with index %v", i)
-			}
+		key, groupingSets, err := ParseGroupByKey(statement.GroupBy)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "couldn't parse group by key")
 		}
 
 		expressions := make([]logical.Expression, len(statement.SelectExprs))
 		isAggregate := make([]bool, len(statement.SelectExprs))
 		aggregates := make([]string, len(statement.SelectExprs))
+		filters := make([]logical.Expression, len(statement.SelectExprs))
 		keyPart := make([]int, len(statement.SelectExprs))
+		// groupingValues contains the values of a grouping() select expression for each grouping set.
+		groupingValues := make([][]int, len(statement.SelectExprs))
 		aliases := make([]string, len(statement.SelectExprs))
 	selectExprLoop:
 		for i := range statement.SelectExprs {
 			inExpr := statement.SelectExprs[i].(*sqlparser.AliasedExpr).Expr
 			aliases[i] = statement.SelectExprs[i].(*sqlparser.AliasedExpr).As.String()
-			agg, expr, err := ParseAggregate(inExpr)
+			if funcExpr, ok := inExpr.(*sqlparser.FuncExpr); ok && funcExpr.Name.Lowered() == "grouping" {
+				groupingValues[i], err = parseGroupingFunction(funcExpr, key, groupingSets)
+				if err != nil {
+					return nil, nil, errors.Wrapf(err, "couldn't parse grouping function with index %d", i)
+				}
+				continue
+			}
+			agg, expr, filter, err := ParseAggregate(inExpr)
 			if err == nil {
 				isAggregate[i] = true
 				aggregates[i] = agg
 				expressions[i] = expr
+				filters[i] = filter
 				continue
 			}
 			expr, exprErr := ParseExpression(inExpr)
@@ -142,6 +125,7 @@ func ParseSelect(statement *sqlparser.Select, topmost bool) (logical.Node, *Outp
 		outputExprs := make([]logical.Expression, len(isAggregate))
 		var nonKeyAggregates []string
 		var aggregateExprs []logical.Expression
+		var aggregateFilters []logical.Expression
 		var aggregateFieldNames []string
 		keyFieldNames := make([]string, len(key))
 		for i := range key {
@@ -157,9 +141,13 @@ func ParseSelect(statement *sqlparser.Select, topmost bool) (logical.Node, *Outp
 			return name
 		}
 		for i, ok := range isAggregate {
+			if groupingValues[i] != nil {
+				continue
+			}
 			if ok {
 				nonKeyAggregates = append(nonKeyAggregates, aggregates[i])
 				aggregateExprs = append(aggregateExprs, expressions[i])
+				aggregateFilters = append(aggregateFilters, filters[i])
 				var name string
 				if aliases[i] != "" {
 					name = getUniqueName(aliases[i])
@@ -184,9 +172,69 @@ func ParseSelect(statement *sqlparser.Select, topmost bool) (logical.Node, *Outp
 			}
 		}
 
-		root = logical.NewGroupBy(root, key, keyFieldNames, aggregateExprs, nonKeyAggregates, aggregateFieldNames, triggers)
-		root = logical.NewMap(outputExprs, make([]string, len(outputExprs)), make([]string, len(outputExprs)), make([]bool, len(outputExprs)), root)
+		if groupingSets != nil {
+			// Keys which are not part of all grouping sets are computed by the grouping sets node, as they may be null.
+			var partialKey []logical.Expression
+			var partialKeyNames []string
+			partialKeyIndex := make([]int, len(key))
+			for keyIndex := range key {
+				partialKeyIndex[keyIndex] = -1
+				if !isInAllGroupingSets(keyIndex, groupingSets) {
+					partialKeyIndex[keyIndex] = len(partialKey)
+					partialKey = append(partialKey, key[keyIndex])
+					partialKeyNames = append(partialKeyNames, fmt.Sprintf("grouping_key_%d", keyIndex))
+					key[keyIndex] = logical.NewVariable(partialKeyNames[len(partialKeyNames)-1])
+				}
+			}
+			partialSets := make([][]int, len(groupingSets))
+			for setIndex, set := range groupingSets {
+				partialSets[setIndex] = []int{}
+				for _, keyIndex := range set {
+					if partialKeyIndex[keyIndex] != -1 {
+						partialSets[setIndex] = append(partialSets[setIndex], partialKeyIndex[keyIndex])
+					}
+				}
+			}
+			root = logical.NewGroupingSets(root, partialKey, partialKeyNames, partialSets, "grouping_set")
+
+			// The grouping set index is part of the key, so that rows of different grouping sets don't get merged.
+			key = append(key, logical.NewVariable("grouping_set"))
+			keyFieldNames = append(keyFieldNames, getUniqueName("grouping_set"))
+		}
+		outputNames := make([]string, len(outputExprs))
+		for i := range groupingValues {
+			if groupingValues[i] == nil {
+				continue
+			}
+			if aliases[i] != "" {
+				outputNames[i] = getUniqueName(aliases[i])
+			} else {
+				outputNames[i] = getUniqueName("grouping")
+			}
+			if groupingSets == nil {
+				outputExprs[i] = logical.NewConstant(octosql.NewInt(groupingValues[i][0]))
+				continue
+			}
+			conditions := make([]logical.Expression, len(groupingValues[i]))
+			values := make([]logical.Expression, len(groupingValues[i]))
+			for setIndex := range groupingValues[i] {
+				conditions[setIndex] = logical.NewFunctionExpression("=", []logical.Expression{
+					logical.NewVariable(keyFieldNames[len(keyFieldNames)-1]),
+					logical.NewConstant(octosql.NewInt(setIndex)),
+				})
+				values[setIndex] = logical.NewConstant(octosql.NewInt(groupingValues[i][setIndex]))
+			}
+			outputExprs[i] = logical.NewCase(conditions, values, logical.NewConstant(octosql.NewInt(0)))
+		}
+
+		root = logical.NewGroupBy(root, key, keyFieldNames, aggregateExprs, nonKeyAggregates, aggregateFilters, aggregateFieldNames, triggers)
+		root = logical.NewMap(outputExprs, outputNames, make([]string, len(outputExprs)), make([]bool, len(outputExprs)), root)
 	} else {
+		root, err = ParseWindows(statement, root)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "couldn't parse window functions")
+		}
+
 		expressions := make([]logical.Expression, len(statement.SelectExprs))
 		starQualifiers := make([]string, len(statement.SelectExprs))
 		isStar := make([]bool, len(statement.SelectExprs))
@@ -218,6 +266,11 @@ func ParseSelect(statement *sqlparser.Select, topmost bool) (logical.Node, *Outp
 		}
 	}
 
+	// Distinct has to be applied before ordering, so that a limit in a subquery operates on the ordered distinct records.
+	if len(statement.Distinct) > 0 {
+		root = logical.NewDistinct(root)
+	}
+
 	if statement.OrderBy != nil {
 		orderByExpressions, orderByDirections, err := parseOrderByExpressions(statement.OrderBy)
 		if err != nil {
@@ -233,32 +286,122 @@ func ParseSelect(statement *sqlparser.Select, topmost bool) (logical.Node, *Outp
 		}
 	}
 
-	if len(statement.Distinct) > 0 {
-		root = logical.NewDistinct(root)
+	if statement.Limit != nil {
+		if root, err = parseLimit(statement.Limit, root, outputOptions, topmost); err != nil {
+			return nil, nil, err
+		}
 	}
 
-	if statement.Limit != nil {
-		if !topmost {
-			limitExpr, err := ParseExpression(statement.Limit.Rowcount)
-			if err != nil {
-				return nil, nil, errors.Wrap(err, "couldn't parse limit")
-			}
-			root = logical.NewLimit(limitExpr, root)
-			return nil, nil, errors.Errorf("LIMIT in non-topmost expression not currently supported.")
-		} else {
-			l, ok := statement.Limit.Rowcount.(*sqlparser.SQLVal)
-			if !ok {
-				return nil, nil, errors.Errorf("LIMIT parameter must be constant, is: %+v", statement.Limit.Rowcount)
-			}
-			if l.Type != sqlparser.IntVal {
-				return nil, nil, errors.Errorf("LIMIT parameter must be Int constant, is: %+v", l.Type)
+	return root, outputOptions, nil
+}
+
+// parseLimit wraps the node in a limit if this is a subquery. Otherwise, the limit and offset are set in the output options.
+func parseLimit(limit *sqlparser.Limit, root logical.Node, outputOptions *OutputOptions, topmost bool) (logical.Node, error) {
+	if !topmost {
+		var limitExpr, offsetExpr logical.Expression
+		var err error
+		if limit.Rowcount != nil {
+			if limitExpr, err = ParseExpression(limit.Rowcount); err != nil {
+				return nil, errors.Wrap(err, "couldn't parse limit")
 			}
-			i, err := strconv.ParseInt(string(l.Val), 10, 64)
-			if err != nil {
-				return nil, nil, errors.Wrap(err, "LIMIT parameter must be Int constant, couldn't parse")
+		}
+		if limit.Offset != nil {
+			if offsetExpr, err = ParseExpression(limit.Offset); err != nil {
+				return nil, errors.Wrap(err, "couldn't parse offset")
 			}
+		}
+		return logical.NewLimit(limitExpr, offsetExpr, root), nil
+	}
 
-			outputOptions.Limit = int(i)
+	if limit.Rowcount != nil {
+		i, err := parseLimitConstant("LIMIT", limit.Rowcount)
+		if err != nil {
+			return nil, err
+		}
+		outputOptions.Limit = i
+	}
+	if limit.Offset != nil {
+		i, err := parseLimitConstant("OFFSET", limit.Offset)
+		if err != nil {
+			return nil, err
+		}
+		outputOptions.Offset = i
+	}
+	return root, nil
+}
+
+func parseLimitConstant(clause string, expr sqlparser.Expr) (int, error) {
+	l, ok := expr.(*sqlparser.SQLVal)
+	if !ok {
+		return 0, errors.Errorf("%s parameter must be constant, is: %+v", clause, expr)
+	}
+	if l.Type != sqlparser.IntVal {
+		return 0, errors.Errorf("%s parameter must be Int constant, is: %+v", clause, l.Type)
+	}
+	i, err := strconv.ParseInt(string(l.Val), 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "%s parameter must be Int constant, couldn't parse", clause)
+	}
+	return int(i), nil
+}
+
+func ParseUnion(statement *sqlparser.Union, topmost bool) (logical.Node, *OutputOptions, error) {
+	var root logical.Node
+	var outputOptions *OutputOptions
+	if topmost {
+		outputOptions = &OutputOptions{}
+	}
+
+	firstNode, _, err := ParseNode(statement.Left, false)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "couldn't parse first select expression")
+	}
+
+	secondNode, _, err := ParseNode(statement.Right, false)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "couldn't parse second select expression")
+	}
+
+	switch statement.Type {
+	case sqlparser.UnionAllStr:
+		root = logical.NewUnionAll(firstNode, secondNode)
+
+	case sqlparser.UnionDistinctStr, sqlparser.UnionStr:
+		root = logical.NewUnionDistinct(firstNode, secondNode)
+
+	case sqlparser.IntersectAllStr:
+		root = logical.NewIntersect(firstNode, secondNode, true)
+
+	case sqlparser.IntersectDistinctStr, sqlparser.IntersectStr:
+		root = logical.NewIntersect(firstNode, secondNode, false)
+
+	case sqlparser.ExceptAllStr:
+		root = logical.NewExcept(firstNode, secondNode, true)
+
+	case sqlparser.ExceptDistinctStr, sqlparser.ExceptStr:
+		root = logical.NewExcept(firstNode, secondNode, false)
+
+	default:
+		return nil, nil, errors.Errorf("unsupported union %+v of type %v", statement, statement.Type)
+	}
+
+	if statement.OrderBy != nil {
+		orderByExpressions, orderByDirections, err := parseOrderByExpressions(statement.OrderBy)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "couldn't parse keys of order by")
+		}
+
+		if !topmost {
+			root = logical.NewOrderBy(orderByExpressions, orderByDirections, root)
+		} else {
+			outputOptions.OrderByExpressions = orderByExpressions
+			outputOptions.OrderByDirections = orderByDirections
+		}
+	}
+
+	if statement.Limit != nil {
+		if root, err = parseLimit(statement.Limit, root, outputOptions, topmost); err != nil {
+			return nil, nil, err
 		}
 	}
 
@@ -273,16 +416,81 @@ func ParseWith(statement *sqlparser.With, topmost bool) (logical.Node, *OutputOp
 
 	nodes := make([]logical.Node, len(statement.CommonTableExpressions))
 	names := make([]string, len(statement.CommonTableExpressions))
+	columns := make([][]string, len(statement.CommonTableExpressions))
 	for i, cte := range statement.CommonTableExpressions {
+		names[i] = cte.Name.String()
+		if cte.Columns != nil {
+			columns[i] = make([]string, len(cte.Columns))
+			for j := range cte.Columns {
+				columns[i][j] = cte.Columns[j].String()
+			}
+		}
+
+		if statement.Recursive && referencesTable(cte.Select, names[i]) {
+			node, err := ParseRecursiveCTE(names[i], columns[i], cte.Select)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "couldn't parse recursive common table expression %s with index %d", cte.Name, i)
+			}
+			nodes[i] = node
+			// The recursive common table expression already names its fields.
+			columns[i] = nil
+			continue
+		}
+
 		node, _, err := ParseNode(cte.Select, false)
 		if err != nil {
 			return nil, nil, errors.Wrapf(err, "couldn't parse common table expression %s with index %d", cte.Name, i)
 		}
 		nodes[i] = node
-		names[i] = cte.Name.String()
 	}
 
-	return logical.NewWith(names, nodes, source), outputOptions, nil
+	return logical.NewWith(names, columns, nodes, source), outputOptions, nil
+}
+
+// ParseRecursiveCTE parses a common table expression of the form: anchor UNION [ALL] recursive part.
+func ParseRecursiveCTE(name string, columns []string, statement sqlparser.SelectStatement) (logical.Node, error) {
+	union, ok := statement.(*sqlparser.Union)
+	if !ok {
+		return nil, errors.Errorf("recursive common table expression must be a UNION of the anchor and the recursive part")
+	}
+	if union.OrderBy != nil || union.Limit != nil {
+		return nil, errors.Errorf("ORDER BY and LIMIT aren't supported in recursive common table expressions")
+	}
+	if referencesTable(union.Left, name) {
+		return nil, errors.Errorf("the anchor of a recursive common table expression can't reference itself")
+	}
+
+	var distinct bool
+	switch union.Type {
+	case sqlparser.UnionAllStr:
+		distinct = false
+	case sqlparser.UnionDistinctStr, sqlparser.UnionStr:
+		distinct = true
+	default:
+		return nil, errors.Errorf("unsupported union type in recursive common table expression: %v", union.Type)
+	}
+
+	anchor, _, err := ParseNode(union.Left, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't parse anchor")
+	}
+	recursive, _, err := ParseNode(union.Right, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't parse recursive part")
+	}
+
+	return logical.NewRecursiveCTE(name, columns, anchor, recursive, distinct), nil
+}
+
+func referencesTable(statement sqlparser.SQLNode, name string) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if tableName, ok := node.(sqlparser.TableName); ok && tableName.Qualifier.IsEmpty() && tableName.Name.String() == name {
+			found = true
+		}
+		return !found, nil
+	}, statement)
+	return found
 }
 
 func ParseNode(statement sqlparser.SelectStatement, topmost bool) (logical.Node, *OutputOptions, error) {
@@ -290,9 +498,8 @@ func ParseNode(statement sqlparser.SelectStatement, topmost bool) (logical.Node,
 	case *sqlparser.Select:
 		return ParseSelect(statement, topmost)
 
-	// case *sqlparser.Union:
-	// 	plan, err := ParseUnion(statement)
-	// 	return plan, &logical.OutputOptions{}, err
+	case *sqlparser.Union:
+		return ParseUnion(statement, topmost)
 
 	case *sqlparser.ParenSelect:
 		return ParseNode(statement.Select, topmost)
@@ -363,6 +570,36 @@ func ParseAliasedTableExpression(expr *sqlparser.AliasedTableExpr) (logical.Node
 		}
 		return logical.NewRequalifier(expr.As.String(), subQuery), nil
 
+	case *sqlparser.ValuesTable:
+		rows := make([][]logical.Expression, len(subExpr.Rows))
+		for i := range subExpr.Rows {
+			rows[i] = make([]logical.Expression, len(subExpr.Rows[i]))
+			for j := range subExpr.Rows[i] {
+				value, err := ParseExpression(subExpr.Rows[i][j])
+				if err != nil {
+					return nil, errors.Wrapf(err, "couldn't parse value with index %d of VALUES row %d", j, i)
+				}
+				rows[i][j] = value
+			}
+			if len(rows[i]) != len(rows[0]) {
+				return nil, errors.Errorf("all VALUES rows must have the same number of values, row %d has %d, first row has %d", i, len(rows[i]), len(rows[0]))
+			}
+		}
+		columns := make([]string, len(rows[0]))
+		if expr.Columns != nil {
+			if len(expr.Columns) != len(columns) {
+				return nil, errors.Errorf("VALUES has %d columns, but %d column names are given", len(columns), len(expr.Columns))
+			}
+			for i := range expr.Columns {
+				columns[i] = expr.Columns[i].String()
+			}
+		} else {
+			for i := range columns {
+				columns[i] = fmt.Sprintf("column%d", i+1)
+			}
+		}
+		return logical.NewRequalifier(expr.As.String(), logical.NewValues(rows, columns)), nil
+
 	default:
 		return nil, errors.Errorf("invalid aliased table expression %+v of type %v", expr.Expr, reflect.TypeOf(expr.Expr))
 	}
@@ -380,7 +617,7 @@ func ParseJoinTableExpression(expr *sqlparser.JoinTableExpr) (logical.Node, erro
 
 	var source, joined logical.Node
 	switch expr.Join {
-	case sqlparser.LeftJoinStr, sqlparser.JoinStr:
+	case sqlparser.LeftJoinStr, sqlparser.JoinStr, sqlparser.FullJoinStr:
 		source = leftTable
 		joined = rightTable
 	case sqlparser.RightJoinStr:
@@ -390,11 +627,21 @@ func ParseJoinTableExpression(expr *sqlparser.JoinTableExpr) (logical.Node, erro
 		return nil, errors.Errorf("invalid join expression: %v", expr.Join)
 	}
 
+	var predicate logical.Expression
+	if expr.Condition.On != nil {
+		predicate, err = ParseExpression(expr.Condition.On)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't parse ON predicate in join")
+		}
+	}
+
 	var node logical.Node
 	if expr.Strategy == sqlparser.LookupJoinStrategy {
 		switch expr.Join {
 		case sqlparser.LeftJoinStr, sqlparser.RightJoinStr:
-			panic("implement me")
+			return logical.NewOuterLateralJoin(source, joined, predicate), nil
+		case sqlparser.FullJoinStr:
+			return nil, errors.Errorf("full outer join is not supported with the lookup join strategy")
 		case sqlparser.JoinStr:
 			node = logical.NewLateralJoin(source, joined)
 		default:
@@ -402,8 +649,13 @@ func ParseJoinTableExpression(expr *sqlparser.JoinTableExpr) (logical.Node, erro
 		}
 	} else {
 		switch expr.Join {
-		case sqlparser.LeftJoinStr, sqlparser.RightJoinStr:
-			panic("implement me")
+		case sqlparser.LeftJoinStr:
+			// The ON predicate of an outer join is part of the join, a filter above it would remove the null-padded records.
+			return logical.NewOuterJoin(leftTable, rightTable, logical.JoinTypeLeft, predicate), nil
+		case sqlparser.RightJoinStr:
+			return logical.NewOuterJoin(leftTable, rightTable, logical.JoinTypeRight, predicate), nil
+		case sqlparser.FullJoinStr:
+			return logical.NewOuterJoin(leftTable, rightTable, logical.JoinTypeFull, predicate), nil
 		case sqlparser.JoinStr:
 			node = logical.NewJoin(source, joined)
 		default:
@@ -411,11 +663,7 @@ func ParseJoinTableExpression(expr *sqlparser.JoinTableExpr) (logical.Node, erro
 		}
 	}
 
-	if expr.Condition.On != nil {
-		predicate, err := ParseExpression(expr.Condition.On)
-		if err != nil {
-			return nil, errors.Wrap(err, "couldn't parse ON predicate in join")
-		}
+	if predicate != nil {
 		node = logical.NewFilter(predicate, node)
 	}
 
@@ -469,7 +717,8 @@ func ParseTableValuedFunctionArgument(expr sqlparser.TableValuedFunctionArgument
 
 var ErrNotAggregate = errors.New("expression is not aggregate")
 
-func ParseAggregate(expr sqlparser.Expr) (string, logical.Expression, error) {
+// ParseAggregate returns the aggregate name, its argument and its filter predicate, which is nil if there's none.
+func ParseAggregate(expr sqlparser.Expr) (string, logical.Expression, logical.Expression, error) {
 	switch expr := expr.(type) {
 	case *sqlparser.FuncExpr:
 		curAggregate := strings.ToLower(expr.Name.String())
@@ -478,32 +727,370 @@ func ParseAggregate(expr sqlparser.Expr) (string, logical.Expression, error) {
 		}
 		_, ok := aggregates.Aggregates[curAggregate]
 		if !ok {
-			return "", nil, errors.Wrapf(ErrNotAggregate, "aggregate not found: %v", expr.Name)
+			return "", nil, nil, errors.Wrapf(ErrNotAggregate, "aggregate not found: %v", expr.Name)
+		}
+
+		if len(expr.Exprs) == 0 {
+			return "", nil, nil, errors.Errorf("aggregate %s requires an argument", curAggregate)
+		}
+		parsedArgs := make([]logical.Expression, len(expr.Exprs))
+		for i := range expr.Exprs {
+			switch arg := expr.Exprs[i].(type) {
+			case *sqlparser.AliasedExpr:
+				var err error
+				parsedArgs[i], err = ParseExpression(arg.Expr)
+				if err != nil {
+					return "", nil, nil, errors.Wrap(err, "couldn't parse aggregate argument")
+				}
+
+			case *sqlparser.StarExpr:
+				parsedArgs[i] = logical.NewConstant(octosql.NewBoolean(true))
+
+			default:
+				return "", nil, nil, errors.Errorf(
+					"invalid aggregate argument expression type: %v",
+					reflect.TypeOf(expr.Exprs[i]),
+				)
+			}
+		}
+		parsedArg := aggregateArgument(parsedArgs)
+
+		var filter logical.Expression
+		if expr.Filter != nil {
+			var err error
+			filter, err = ParseExpression(expr.Filter)
+			if err != nil {
+				return "", nil, nil, errors.Wrap(err, "couldn't parse aggregate filter")
+			}
+		}
+
+		return curAggregate, parsedArg, filter, nil
+	}
+
+	return "", nil, nil, errors.Wrapf(ErrNotAggregate, "invalid group by select expression type")
+}
+
+// aggregateArgument returns the single argument of an aggregate. Aggregates taking multiple arguments get them as a tuple.
+func aggregateArgument(args []logical.Expression) logical.Expression {
+	if len(args) == 1 {
+		return args[0]
+	}
+	return logical.NewTuple(args)
+}
+
+// ParseGroupByKey returns the deduplicated key expressions and, if ROLLUP, CUBE or GROUPING SETS are used,
+// the grouping sets as lists of key indices.
+func ParseGroupByKey(groupBy sqlparser.GroupBy) ([]logical.Expression, [][]int, error) {
+	var key []logical.Expression
+	keyIndex := func(expr sqlparser.Expr) (int, error) {
+		parsed, err := ParseExpression(expr)
+		if err != nil {
+			return -1, err
+		}
+		for i := range key {
+			if logical.EqualExpressions(parsed, key[i]) {
+				return i, nil
+			}
+		}
+		key = append(key, parsed)
+		return len(key) - 1, nil
+	}
+	keyIndices := func(exprs sqlparser.Exprs) ([]int, error) {
+		out := make([]int, len(exprs))
+		for i := range exprs {
+			index, err := keyIndex(exprs[i])
+			if err != nil {
+				return nil, errors.Wrapf(err, "couldn't parse group key expression with index %d", i)
+			}
+			out[i] = index
+		}
+		return out, nil
+	}
+
+	hasGroupingSets := false
+	sets := [][]int{{}}
+	for i := range groupBy {
+		var elementSets [][]int
+		switch expr := groupBy[i].(type) {
+		case *sqlparser.GroupingSetsExpr:
+			hasGroupingSets = true
+			switch expr.Type {
+			case sqlparser.RollupStr:
+				indices, err := keyIndices(expr.Exprs)
+				if err != nil {
+					return nil, nil, errors.Wrapf(err, "couldn't parse rollup with index %d", i)
+				}
+				for j := len(indices); j >= 0; j-- {
+					elementSets = append(elementSets, indices[:j])
+				}
+			case sqlparser.CubeStr:
+				indices, err := keyIndices(expr.Exprs)
+				if err != nil {
+					return nil, nil, errors.Wrapf(err, "couldn't parse cube with index %d", i)
+				}
+				for mask := (1 << len(indices)) - 1; mask >= 0; mask-- {
+					var set []int
+					for j := range indices {
+						if mask&(1<<(len(indices)-1-j)) != 0 {
+							set = append(set, indices[j])
+						}
+					}
+					elementSets = append(elementSets, set)
+				}
+			case sqlparser.GroupingSetsStr:
+				for j := range expr.Sets {
+					indices, err := keyIndices(expr.Sets[j])
+					if err != nil {
+						return nil, nil, errors.Wrapf(err, "couldn't parse grouping set with index %d", j)
+					}
+					elementSets = append(elementSets, indices)
+				}
+			default:
+				return nil, nil, errors.Errorf("invalid grouping sets type: %s", expr.Type)
+			}
+		default:
+			index, err := keyIndex(expr)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "couldn't parse group key expression with index %d", i)
+			}
+			elementSets = [][]int{{index}}
+		}
+
+		// Multiple group by elements result in the cross product of their grouping sets.
+		product := make([][]int, 0, len(sets)*len(elementSets))
+		for _, set := range sets {
+			for _, elementSet := range elementSets {
+				newSet := append([]int{}, set...)
+			elementSetLoop:
+				for _, index := range elementSet {
+					for _, existing := range newSet {
+						if existing == index {
+							continue elementSetLoop
+						}
+					}
+					newSet = append(newSet, index)
+				}
+				product = append(product, newSet)
+			}
+		}
+		sets = product
+	}
+
+	if !hasGroupingSets {
+		return key, nil, nil
+	}
+	return key, sets, nil
+}
+
+// parseGroupingFunction returns the value of the grouping() call for each grouping set.
+// Each argument contributes a bit, which is set if the argument isn't part of the grouping set.
+func parseGroupingFunction(expr *sqlparser.FuncExpr, key []logical.Expression, groupingSets [][]int) ([]int, error) {
+	if len(expr.Exprs) == 0 {
+		return nil, errors.Errorf("grouping() requires at least one argument")
+	}
+	argKeyIndices := make([]int, len(expr.Exprs))
+	for i := range expr.Exprs {
+		aliasedExpr, ok := expr.Exprs[i].(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, errors.Errorf("invalid grouping argument expression type: %v", reflect.TypeOf(expr.Exprs[i]))
+		}
+		arg, err := ParseExpression(aliasedExpr.Expr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't parse grouping argument with index %d", i)
+		}
+		argKeyIndices[i] = -1
+		for keyIndex := range key {
+			if logical.EqualExpressions(arg, key[keyIndex]) {
+				argKeyIndices[i] = keyIndex
+				break
+			}
+		}
+		if argKeyIndices[i] == -1 {
+			return nil, errors.Errorf("grouping argument with index %d must be part of group by key", i)
+		}
+	}
+
+	if groupingSets == nil {
+		// Without grouping sets, all the keys are part of the single grouping set.
+		return []int{0}, nil
+	}
+
+	values := make([]int, len(groupingSets))
+	for setIndex, set := range groupingSets {
+	argLoop:
+		for _, argKeyIndex := range argKeyIndices {
+			values[setIndex] <<= 1
+			for _, keyIndex := range set {
+				if keyIndex == argKeyIndex {
+					continue argLoop
+				}
+			}
+			values[setIndex] |= 1
+		}
+	}
+	return values, nil
+}
+
+func isInAllGroupingSets(keyIndex int, groupingSets [][]int) bool {
+setLoop:
+	for _, set := range groupingSets {
+		for _, index := range set {
+			if index == keyIndex {
+				continue setLoop
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// ParseWindows puts a window node on top of the source for each window function in the select expressions.
+// The window functions in the select expressions are replaced with references to the window node fields.
+func ParseWindows(statement *sqlparser.Select, source logical.Node) (logical.Node, error) {
+	var triggers []logical.Trigger
+	nameCounter := map[string]int{}
+	windowCount := 0
+	root := source
+
+	for i := range statement.SelectExprs {
+		aliasedExpr, ok := statement.SelectExprs[i].(*sqlparser.AliasedExpr)
+		if !ok {
+			continue
+		}
+
+		var windowExprs []*sqlparser.WindowExpr
+		if err := sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+			switch node := node.(type) {
+			case *sqlparser.WindowExpr:
+				windowExprs = append(windowExprs, node)
+				return false, nil
+			case *sqlparser.Subquery:
+				return false, nil
+			}
+			return true, nil
+		}, aliasedExpr.Expr); err != nil {
+			return nil, errors.Wrapf(err, "couldn't find window functions in select expression with index %d", i)
+		}
+		if len(windowExprs) == 0 {
+			continue
+		}
+
+		if triggers == nil {
+			triggers = make([]logical.Trigger, len(statement.Trigger))
+			for i := range statement.Trigger {
+				var err error
+				triggers[i], err = ParseTrigger(statement.Trigger[i])
+				if err != nil {
+					return nil, errors.Wrapf(err, "couldn't parse trigger with index %d", i)
+				}
+			}
 		}
 
-		var parsedArg logical.Expression
-		switch arg := expr.Exprs[0].(type) {
+		for _, windowExpr := range windowExprs {
+			if windowExpr == aliasedExpr.Expr && aliasedExpr.As.IsEmpty() {
+				// The output column is named after the window function.
+				name := strings.ToLower(windowExpr.Func.Name.String())
+				if count, ok := nameCounter[name]; ok {
+					nameCounter[name] = count + 1
+					name = fmt.Sprintf("%s_%d", name, count)
+				} else {
+					nameCounter[name] = 1
+				}
+				aliasedExpr.As = sqlparser.NewColIdent(name)
+			}
+
+			// The window node field gets a reserved name, so that it can't be confused with a source field.
+			fieldName := fmt.Sprintf("%s%d", logical.WindowFieldNamePrefix, windowCount)
+			windowCount++
+
+			var err error
+			root, err = ParseWindow(windowExpr, fieldName, triggers, root)
+			if err != nil {
+				return nil, errors.Wrapf(err, "couldn't parse window function %s", strings.ToLower(windowExpr.Func.Name.String()))
+			}
+
+			aliasedExpr.Expr = sqlparser.ReplaceExpr(aliasedExpr.Expr, windowExpr, &sqlparser.ColName{Name: sqlparser.NewColIdent(fieldName)})
+		}
+	}
+
+	return root, nil
+}
+
+func ParseWindow(expr *sqlparser.WindowExpr, name string, triggers []logical.Trigger, source logical.Node) (logical.Node, error) {
+	function := strings.ToLower(expr.Func.Name.String())
+	if expr.Func.Distinct {
+		function = fmt.Sprintf("%v_distinct", function)
+	}
+
+	arguments := make([]logical.Expression, len(expr.Func.Exprs))
+	for i := range expr.Func.Exprs {
+		switch arg := expr.Func.Exprs[i].(type) {
 		case *sqlparser.AliasedExpr:
 			var err error
-			parsedArg, err = ParseExpression(arg.Expr)
+			arguments[i], err = ParseFunctionArgument(arg)
 			if err != nil {
-				return "", nil, errors.Wrap(err, "couldn't parse aggregate argument")
+				return nil, errors.Wrapf(err, "couldn't parse argument with index %d", i)
 			}
 
 		case *sqlparser.StarExpr:
-			parsedArg = logical.NewConstant(octosql.NewBoolean(true))
+			if _, ok := aggregates.Aggregates[function]; !ok {
+				return nil, errors.Errorf("star argument is only allowed in aggregate window functions")
+			}
+			arguments[i] = logical.NewConstant(octosql.NewBoolean(true))
 
 		default:
-			return "", nil, errors.Errorf(
-				"invalid aggregate argument expression type: %v",
-				reflect.TypeOf(expr.Exprs[0]),
-			)
+			return nil, errors.Errorf("invalid window function argument expression type: %v", reflect.TypeOf(arg))
 		}
+	}
 
-		return curAggregate, parsedArg, nil
+	if _, ok := aggregates.Aggregates[function]; ok {
+		arguments = []logical.Expression{aggregateArgument(arguments)}
+	}
+
+	partitionBy := make([]logical.Expression, len(expr.PartitionBy))
+	for i := range expr.PartitionBy {
+		var err error
+		partitionBy[i], err = ParseExpression(expr.PartitionBy[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't parse partition by expression with index %d", i)
+		}
+	}
+
+	orderBy, directions, err := parseOrderByExpressions(expr.OrderBy)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't parse window order by")
+	}
+
+	frame := logical.DefaultWindowFrame
+	if expr.Frame != nil {
+		frame.Rows = expr.Frame.Unit == sqlparser.RowsStr
+		frame.Start, err = parseWindowFrameBound(expr.Frame.Start)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't parse window frame start")
+		}
+		frame.End, err = parseWindowFrameBound(expr.Frame.End)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't parse window frame end")
+		}
 	}
 
-	return "", nil, errors.Wrapf(ErrNotAggregate, "invalid group by select expression type")
+	return logical.NewWindow(source, function, arguments, partitionBy, orderBy, directions, frame, name, triggers), nil
+}
+
+func parseWindowFrameBound(bound *sqlparser.FrameBound) (logical.WindowFrameBound, error) {
+	out := logical.WindowFrameBound{Type: logical.WindowFrameBoundType(bound.Type)}
+	if bound.Offset != nil {
+		offset, ok := bound.Offset.(*sqlparser.SQLVal)
+		if !ok || offset.Type != sqlparser.IntVal {
+			return logical.WindowFrameBound{}, errors.Errorf("window frame offset must be Int constant, is: %+v", bound.Offset)
+		}
+		i, err := strconv.ParseInt(string(offset.Val), 10, 64)
+		if err != nil {
+			return logical.WindowFrameBound{}, errors.Wrap(err, "window frame offset must be Int constant, couldn't parse")
+		}
+		out.Offset = int(i)
+	}
+	return out, nil
 }
 
 func ParseTrigger(trigger sqlparser.Trigger) (logical.Trigger, error) {
@@ -564,10 +1151,24 @@ func ParseExpression(expr sqlparser.Expr) (logical.Expression, error) {
 		if err != nil {
 			return nil, errors.Wrap(err, "couldn't parse left child expression")
 		}
+		if expr.Operator == sqlparser.TildaStr {
+			// The binary ~ operator is a regexp match, so the unary one gets a function of its own.
+			return logical.NewFunctionExpression("bit_not", []logical.Expression{arg}), nil
+		}
 
 		return logical.NewFunctionExpression(expr.Operator, []logical.Expression{arg}), nil
 
 	case *sqlparser.BinaryExpr:
+		if expr.Operator == sqlparser.PlusStr || expr.Operator == sqlparser.MinusStr {
+			// Month and year intervals don't have a fixed duration, so they're added using the calendar.
+			if interval, ok := expr.Right.(*sqlparser.IntervalExpr); ok && isCalendarInterval(interval) {
+				return parseCalendarIntervalAddition(expr.Left, interval, expr.Operator == sqlparser.MinusStr)
+			}
+			if interval, ok := expr.Left.(*sqlparser.IntervalExpr); ok && isCalendarInterval(interval) && expr.Operator == sqlparser.PlusStr {
+				return parseCalendarIntervalAddition(expr.Right, interval, false)
+			}
+		}
+
 		left, err := ParseExpression(expr.Left)
 		if err != nil {
 			return nil, errors.Wrap(err, "couldn't parse left child expression")
@@ -582,6 +1183,13 @@ func ParseExpression(expr sqlparser.Expr) (logical.Expression, error) {
 
 	case *sqlparser.FuncExpr:
 		functionName := strings.ToLower(expr.Name.String())
+		if expr.Filter != nil {
+			return nil, errors.Errorf("FILTER is only supported for aggregates in select expressions, used with %s", functionName)
+		}
+
+		if functionName == "struct" {
+			return parseStructConstructor(expr)
+		}
 
 		arguments := make([]logical.Expression, 0)
 		var logicArg logical.Expression
@@ -628,6 +1236,13 @@ func ParseExpression(expr sqlparser.Expr) (logical.Expression, error) {
 		}
 		return logical.NewQueryExpression(subquery), nil
 
+	case *sqlparser.ExistsExpr:
+		subquery, err := ParseExpression(expr.Subquery)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't parse exists subquery")
+		}
+		return logical.NewFunctionExpression("exists", []logical.Expression{subquery}), nil
+
 	case *sqlparser.SQLVal:
 		var value octosql.Value
 		var err error
@@ -642,6 +1257,9 @@ func ParseExpression(expr sqlparser.Expr) (logical.Expression, error) {
 			value = octosql.NewFloat(val)
 		case sqlparser.StrVal:
 			value = octosql.NewString(string(expr.Val))
+		case sqlparser.ValArg:
+			// Both :name and $1 parameters are bound by the name following the prefix.
+			return logical.NewParameter(string(expr.Val[1:])), nil
 		default:
 			err = errors.Errorf("constant value type unsupported")
 		}
@@ -672,16 +1290,12 @@ func ParseExpression(expr sqlparser.Expr) (logical.Expression, error) {
 		return logical.NewTuple(expressions), nil
 
 	case *sqlparser.IntervalExpr:
-		c, ok := expr.Expr.(*sqlparser.SQLVal)
-		if !ok {
-			return nil, errors.Errorf("interval expression parameter must be constant, is: %+v", expr.Expr)
+		if isCalendarInterval(expr) {
+			return nil, errors.Errorf("%s intervals can only be added to or subtracted from a time", strings.ToLower(expr.Unit))
 		}
-		if c.Type != sqlparser.IntVal {
-			return nil, errors.Errorf("interval expression parameter must be Int constant, is: %+v", c)
-		}
-		i, err := strconv.ParseInt(string(c.Val), 10, 64)
+		i, err := parseIntervalCount(expr)
 		if err != nil {
-			return nil, errors.Wrap(err, "interval expression parameter must be Int constant, couldn't parse")
+			return nil, err
 		}
 
 		var unit time.Duration
@@ -701,11 +1315,18 @@ func ParseExpression(expr sqlparser.Expr) (logical.Expression, error) {
 		case "day":
 			unit = time.Hour * 24
 		default:
-			return nil, errors.Errorf("invalid interval expression unit: %s, must be one of: nanosecond, microsecond, millisecond, second, minute, hour, day", expr.Unit)
+			return nil, errors.Errorf("invalid interval expression unit: %s, must be one of: nanosecond, microsecond, millisecond, second, minute, hour, day, month, year", expr.Unit)
 		}
 
 		return logical.NewConstant(octosql.NewDuration(time.Duration(i) * unit)), nil
 
+	case *sqlparser.TypedLiteral:
+		value, err := parseTypedLiteral(expr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't parse %s literal '%s'", expr.Type, expr.Val)
+		}
+		return logical.NewConstant(value), nil
+
 	case *sqlparser.AndExpr:
 		return ParseInfixOperator(expr.Left, expr.Right, "AND")
 	case *sqlparser.OrExpr:
@@ -720,6 +1341,25 @@ func ParseExpression(expr sqlparser.Expr) (logical.Expression, error) {
 		return ParseInfixComparison(expr.Left, expr.Right, expr.Operator)
 	case *sqlparser.ParenExpr:
 		return ParseExpression(expr.Expr)
+	case *sqlparser.RangeCond:
+		left, err := ParseExpression(expr.Left)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't parse left hand side of between expression")
+		}
+		from, err := ParseExpression(expr.From)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't parse lower bound of between expression")
+		}
+		to, err := ParseExpression(expr.To)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't parse upper bound of between expression")
+		}
+
+		out := logical.Expression(logical.NewFunctionExpression("between", []logical.Expression{left, from, to}))
+		if expr.Operator == sqlparser.NotBetweenStr {
+			out = logical.NewFunctionExpression("not", []logical.Expression{out})
+		}
+		return out, nil
 	case *sqlparser.IsExpr:
 		arg, err := ParseExpression(expr.Expr)
 		if err != nil {
@@ -759,6 +1399,43 @@ func ParseExpression(expr sqlparser.Expr) (logical.Expression, error) {
 			out = logical.NewObjectFieldAccess(out, parts[i])
 		}
 		return out, nil
+	case *sqlparser.WindowExpr:
+		return nil, errors.Errorf("window functions are only supported in select expressions without aggregates")
+
+	case *sqlparser.CaseExpr:
+		var subject logical.Expression
+		if expr.Expr != nil {
+			var err error
+			subject, err = ParseExpression(expr.Expr)
+			if err != nil {
+				return nil, errors.Wrap(err, "couldn't parse CASE subject expression")
+			}
+		}
+		conditions := make([]logical.Expression, len(expr.Whens))
+		values := make([]logical.Expression, len(expr.Whens))
+		for i, when := range expr.Whens {
+			condition, err := ParseExpression(when.Cond)
+			if err != nil {
+				return nil, errors.Wrapf(err, "couldn't parse WHEN condition with index %d", i)
+			}
+			conditions[i] = condition
+			values[i], err = ParseExpression(when.Val)
+			if err != nil {
+				return nil, errors.Wrapf(err, "couldn't parse THEN value with index %d", i)
+			}
+		}
+		var elseValue logical.Expression
+		if expr.Else != nil {
+			var err error
+			elseValue, err = ParseExpression(expr.Else)
+			if err != nil {
+				return nil, errors.Wrap(err, "couldn't parse ELSE value")
+			}
+		}
+		if subject != nil {
+			return logical.NewSimpleCase(subject, conditions, values, elseValue), nil
+		}
+		return logical.NewCase(conditions, values, elseValue), nil
 	default:
 		return nil, errors.Errorf("unsupported expression %+v of type %v", expr, reflect.TypeOf(expr))
 	}
@@ -809,6 +1486,118 @@ func isAggregateExpression(expr sqlparser.Expr) bool {
 	return false
 }
 
+// parseStructConstructor parses struct(value AS name, ...) as a call to the object function, which takes pairs of names and values.
+// Without an alias, the name of a column is used as the field name.
+func parseStructConstructor(expr *sqlparser.FuncExpr) (logical.Expression, error) {
+	arguments := make([]logical.Expression, 0, len(expr.Exprs)*2)
+	for i := range expr.Exprs {
+		arg, ok := expr.Exprs[i].(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, errors.Errorf("unsupported struct field %v of type %v", expr.Exprs[i], reflect.TypeOf(expr.Exprs[i]))
+		}
+		name := arg.As.String()
+		if name == "" {
+			colName, ok := arg.Expr.(*sqlparser.ColName)
+			if !ok {
+				return nil, errors.Errorf("struct field with index %d must have a name given with AS", i)
+			}
+			name = colName.Name.String()
+		}
+		value, err := ParseExpression(arg.Expr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't parse struct field %s", name)
+		}
+		arguments = append(arguments, logical.NewConstant(octosql.NewString(name)), value)
+	}
+	return logical.NewFunctionExpression("object", arguments), nil
+}
+
+func isCalendarInterval(expr *sqlparser.IntervalExpr) bool {
+	switch strings.TrimSuffix(strings.ToLower(expr.Unit), "s") {
+	case "month", "year":
+		return true
+	default:
+		return false
+	}
+}
+
+func parseIntervalCount(expr *sqlparser.IntervalExpr) (int, error) {
+	c, ok := expr.Expr.(*sqlparser.SQLVal)
+	if !ok {
+		return 0, errors.Errorf("interval expression parameter must be constant, is: %+v", expr.Expr)
+	}
+	if c.Type != sqlparser.IntVal {
+		return 0, errors.Errorf("interval expression parameter must be Int constant, is: %+v", c)
+	}
+	i, err := strconv.ParseInt(string(c.Val), 10, 64)
+	if err != nil {
+		return 0, errors.Wrap(err, "interval expression parameter must be Int constant, couldn't parse")
+	}
+	return int(i), nil
+}
+
+// parseCalendarIntervalAddition parses the addition of a month or year interval to a time as a call to add_months.
+func parseCalendarIntervalAddition(timeExpr sqlparser.Expr, interval *sqlparser.IntervalExpr, subtract bool) (logical.Expression, error) {
+	arg, err := ParseExpression(timeExpr)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't parse interval addition time expression")
+	}
+	months, err := parseIntervalCount(interval)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSuffix(strings.ToLower(interval.Unit), "s") == "year" {
+		months *= 12
+	}
+	if subtract {
+		months = -months
+	}
+
+	return logical.NewFunctionExpression("add_months", []logical.Expression{arg, logical.NewConstant(octosql.NewInt(months))}), nil
+}
+
+var timestampLiteralLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+var timeLiteralLayouts = []string{
+	"15:04:05",
+	"15:04",
+}
+
+// parseTypedLiteral parses DATE and TIMESTAMP literals as times, in UTC unless a zone is given.
+// TIME literals are parsed as the duration since midnight.
+func parseTypedLiteral(expr *sqlparser.TypedLiteral) (octosql.Value, error) {
+	var layouts []string
+	switch expr.Type {
+	case sqlparser.DateStr:
+		layouts = []string{"2006-01-02"}
+	case sqlparser.TimestampStr:
+		layouts = timestampLiteralLayouts
+	case sqlparser.TimeStr:
+		layouts = timeLiteralLayouts
+	default:
+		return octosql.ZeroValue, errors.Errorf("unsupported literal type: %s", expr.Type)
+	}
+
+	var err error
+	for _, layout := range layouts {
+		var t time.Time
+		if t, err = time.Parse(layout, string(expr.Val)); err == nil {
+			if expr.Type == sqlparser.TimeStr {
+				return octosql.NewDuration(t.Sub(time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC))), nil
+			}
+			return octosql.NewTime(t), nil
+		}
+	}
+	return octosql.ZeroValue, err
+}
+
 func ParseInfixOperator(left, right sqlparser.Expr, operator string) (logical.Expression, error) {
 	leftParsed, err := ParseExpression(left)
 	if err != nil {
@@ -844,6 +1633,9 @@ func ParseInfixComparison(left, right sqlparser.Expr, operator string) (logical.
 	if err != nil {
 		return nil, errors.Wrapf(err, "couldn't parse right hand side of %s comparator %+v", operator, right)
 	}
+	if operator == sqlparser.NullSafeEqualStr {
+		operator = sqlparser.IsNotDistinctFromStr
+	}
 	if operator == sqlparser.NotLikeStr {
 		return logical.NewFunctionExpression(
 			"not",
@@ -451,9 +451,15 @@ type Union struct {
 
 // Union.Type
 const (
-	UnionStr         = "union"
-	UnionAllStr      = "union all"
-	UnionDistinctStr = "union distinct"
+	UnionStr             = "union"
+	UnionAllStr          = "union all"
+	UnionDistinctStr     = "union distinct"
+	IntersectStr         = "intersect"
+	IntersectAllStr      = "intersect all"
+	IntersectDistinctStr = "intersect distinct"
+	ExceptStr            = "except"
+	ExceptAllStr         = "except all"
+	ExceptDistinctStr    = "except distinct"
 )
 
 // Format formats the node.
@@ -474,11 +480,16 @@ func (node *Union) walkSubtree(visit Visit) error {
 }
 
 type With struct {
+	Recursive              bool
 	CommonTableExpressions CommonTableExpressions
 	Select                 SelectStatement
 }
 
 func (node *With) Format(buf *TrackedBuffer) {
+	if node.Recursive {
+		buf.Myprintf("WITH RECURSIVE %v %v", node.CommonTableExpressions, node.Select)
+		return
+	}
 	buf.Myprintf("WITH %v %v", node.CommonTableExpressions, node.Select)
 }
 
@@ -512,12 +523,13 @@ func (node CommonTableExpressions) walkSubtree(visit Visit) error {
 }
 
 type CommonTableExpression struct {
-	Name   TableIdent
-	Select SelectStatement
+	Name    TableIdent
+	Columns Columns
+	Select  SelectStatement
 }
 
 func (node *CommonTableExpression) Format(buf *TrackedBuffer) {
-	buf.Myprintf("%s AS (%v)", node.Name, node.Select)
+	buf.Myprintf("%s%v AS (%v)", node.Name, node.Columns, node.Select)
 }
 
 func (node *CommonTableExpression) walkSubtree(visit Visit) error {
@@ -1918,6 +1930,7 @@ type AliasedTableExpr struct {
 	Expr       SimpleTableExpr
 	Partitions Partitions
 	As         TableIdent
+	Columns    Columns
 	Hints      *IndexHints
 }
 
@@ -1925,7 +1938,7 @@ type AliasedTableExpr struct {
 func (node *AliasedTableExpr) Format(buf *TrackedBuffer) {
 	buf.Myprintf("%v%v", node.Expr, node.Partitions)
 	if !node.As.IsEmpty() {
-		buf.Myprintf(" as %v", node.As)
+		buf.Myprintf(" as %v%v", node.As, node.Columns)
 	}
 	if node.Hints != nil {
 		// Hint node provides the space padding.
@@ -1941,6 +1954,7 @@ func (node *AliasedTableExpr) walkSubtree(visit Visit) error {
 		visit,
 		node.Expr,
 		node.As,
+		node.Columns,
 		node.Hints,
 	)
 }
@@ -1958,8 +1972,26 @@ type SimpleTableExpr interface {
 	SQLNode
 }
 
-func (TableName) iSimpleTableExpr() {}
-func (*Subquery) iSimpleTableExpr() {}
+func (TableName) iSimpleTableExpr()    {}
+func (*Subquery) iSimpleTableExpr()    {}
+func (*ValuesTable) iSimpleTableExpr() {}
+
+// ValuesTable represents an inline table, with its rows listed in a VALUES clause.
+type ValuesTable struct {
+	Rows Values
+}
+
+// Format formats the node.
+func (node *ValuesTable) Format(buf *TrackedBuffer) {
+	buf.Myprintf("(%v)", node.Rows)
+}
+
+func (node *ValuesTable) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Rows)
+}
 
 // TableNames is a list of TableName.
 type TableNames []TableName
@@ -2086,6 +2118,7 @@ const (
 	StraightJoinStr       = "straight_join"
 	LeftJoinStr           = "left join"
 	RightJoinStr          = "right join"
+	FullJoinStr           = "full join"
 	NaturalJoinStr        = "natural join"
 	NaturalLeftJoinStr    = "natural left join"
 	NaturalRightJoinStr   = "natural right join"
@@ -2342,8 +2375,11 @@ func (ListArg) iExpr()            {}
 func (*BinaryExpr) iExpr()        {}
 func (*UnaryExpr) iExpr()         {}
 func (*IntervalExpr) iExpr()      {}
+func (*TypedLiteral) iExpr()      {}
 func (*CollateExpr) iExpr()       {}
 func (*FuncExpr) iExpr()          {}
+func (*WindowExpr) iExpr()        {}
+func (*GroupingSetsExpr) iExpr()  {}
 func (*TimestampFuncExpr) iExpr() {}
 func (*CurTimeFuncExpr) iExpr()   {}
 func (*CaseExpr) iExpr()          {}
@@ -2531,6 +2567,8 @@ const (
 	NotLikeRegexpCaseInsensitiveStr = "!~*"
 	RegexpStr                       = "regexp"
 	NotRegexpStr                    = "not regexp"
+	IsDistinctFromStr               = "is distinct from"
+	IsNotDistinctFromStr            = "is not distinct from"
 	JSONExtractOp                   = "->"
 	JSONUnquoteExtractOp            = "->>"
 )
@@ -2985,6 +3023,7 @@ const (
 	ArrayElement  = "[]"
 	BitAndStr     = "&"
 	BitOrStr      = "|"
+	ConcatStr     = "||"
 	BitXorStr     = "^"
 	PlusStr       = "+"
 	MinusStr      = "-"
@@ -3081,6 +3120,33 @@ func (node *IntervalExpr) replace(from, to Expr) bool {
 	return replaceExprs(from, to, &node.Expr)
 }
 
+// TypedLiteral represents a DATE, TIME or TIMESTAMP literal.
+type TypedLiteral struct {
+	Type string
+	Val  []byte
+}
+
+// TypedLiteral.Type
+const (
+	DateStr      = "date"
+	TimeStr      = "time"
+	TimestampStr = "timestamp"
+)
+
+// Format formats the node.
+func (node *TypedLiteral) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%s ", node.Type)
+	sqltypes.MakeTrusted(sqltypes.VarBinary, node.Val).EncodeSQL(buf)
+}
+
+func (node *TypedLiteral) walkSubtree(visit Visit) error {
+	return nil
+}
+
+func (node *TypedLiteral) replace(from, to Expr) bool {
+	return false
+}
+
 // TimestampFuncExpr represents the function and arguments for TIMESTAMP{ADD,DIFF} functions.
 type TimestampFuncExpr struct {
 	Name  string
@@ -3172,6 +3238,8 @@ type FuncExpr struct {
 	Name      ColIdent
 	Distinct  bool
 	Exprs     SelectExprs
+	// Filter is the optional FILTER (WHERE ...) predicate of an aggregate.
+	Filter Expr
 }
 
 // Format formats the node.
@@ -3187,6 +3255,9 @@ func (node *FuncExpr) Format(buf *TrackedBuffer) {
 	// if they match a reserved word. So, print the
 	// name as is.
 	buf.Myprintf("%s(%s%v)", node.Name.String(), distinct, node.Exprs)
+	if node.Filter != nil {
+		buf.Myprintf(" filter (where %v)", node.Filter)
+	}
 }
 
 func (node *FuncExpr) walkSubtree(visit Visit) error {
@@ -3198,10 +3269,14 @@ func (node *FuncExpr) walkSubtree(visit Visit) error {
 		node.Qualifier,
 		node.Name,
 		node.Exprs,
+		node.Filter,
 	)
 }
 
 func (node *FuncExpr) replace(from, to Expr) bool {
+	if node.Filter != nil && replaceExprs(from, to, &node.Filter) {
+		return true
+	}
 	for _, sel := range node.Exprs {
 		aliased, ok := sel.(*AliasedExpr)
 		if !ok {
@@ -3239,6 +3314,166 @@ func (node *FuncExpr) IsAggregate() bool {
 	return Aggregates[node.Name.Lowered()]
 }
 
+// WindowExpr represents a function call with an OVER clause.
+type WindowExpr struct {
+	Func        *FuncExpr
+	PartitionBy Exprs
+	OrderBy     OrderBy
+	Frame       *WindowFrame
+}
+
+// Format formats the node.
+func (node *WindowExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v over (", node.Func)
+	if len(node.PartitionBy) > 0 {
+		buf.Myprintf("partition by %v", node.PartitionBy)
+	}
+	buf.Myprintf("%v%v)", node.OrderBy, node.Frame)
+}
+
+func (node *WindowExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Func,
+		node.PartitionBy,
+		node.OrderBy,
+	)
+}
+
+func (node *WindowExpr) replace(from, to Expr) bool {
+	if node.Func.replace(from, to) {
+		return true
+	}
+	for i := range node.PartitionBy {
+		if replaceExprs(from, to, &node.PartitionBy[i]) {
+			return true
+		}
+	}
+	for _, order := range node.OrderBy {
+		if replaceExprs(from, to, &order.Expr) {
+			return true
+		}
+	}
+	return false
+}
+
+// WindowFrame represents the frame clause of a window specification.
+type WindowFrame struct {
+	Unit       string
+	Start, End *FrameBound
+}
+
+// WindowFrame.Unit
+const (
+	RowsStr  = "rows"
+	RangeStr = "range"
+)
+
+// Format formats the node.
+func (node *WindowFrame) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.Myprintf(" %s between %v and %v", node.Unit, node.Start, node.End)
+}
+
+func (node *WindowFrame) walkSubtree(visit Visit) error {
+	return nil
+}
+
+// FrameBound represents a single bound of a window frame.
+type FrameBound struct {
+	Type   string
+	Offset Expr
+}
+
+// FrameBound.Type
+const (
+	UnboundedPrecedingStr = "unbounded preceding"
+	PrecedingStr          = "preceding"
+	CurrentRowStr         = "current row"
+	FollowingStr          = "following"
+	UnboundedFollowingStr = "unbounded following"
+)
+
+// Format formats the node.
+func (node *FrameBound) Format(buf *TrackedBuffer) {
+	if node.Offset != nil {
+		buf.Myprintf("%v ", node.Offset)
+	}
+	buf.Myprintf("%s", node.Type)
+}
+
+func (node *FrameBound) walkSubtree(visit Visit) error {
+	return nil
+}
+
+// GroupingSetsExpr represents a ROLLUP, CUBE or GROUPING SETS element of a GROUP BY clause.
+type GroupingSetsExpr struct {
+	Type string
+	// Exprs holds the arguments of ROLLUP and CUBE.
+	Exprs Exprs
+	// Sets holds the sets of GROUPING SETS.
+	Sets []Exprs
+}
+
+// GroupingSetsExpr.Type
+const (
+	RollupStr       = "rollup"
+	CubeStr         = "cube"
+	GroupingSetsStr = "grouping sets"
+)
+
+// Format formats the node.
+func (node *GroupingSetsExpr) Format(buf *TrackedBuffer) {
+	if node.Type != GroupingSetsStr {
+		buf.Myprintf("%s(%v)", node.Type, node.Exprs)
+		return
+	}
+	buf.Myprintf("%s(", node.Type)
+	for i, set := range node.Sets {
+		if i > 0 {
+			buf.Myprintf(", ")
+		}
+		buf.Myprintf("(%v)", set)
+	}
+	buf.Myprintf(")")
+}
+
+func (node *GroupingSetsExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	if err := Walk(visit, node.Exprs); err != nil {
+		return err
+	}
+	for _, set := range node.Sets {
+		if err := Walk(visit, set); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (node *GroupingSetsExpr) replace(from, to Expr) bool {
+	for i := range node.Exprs {
+		if replaceExprs(from, to, &node.Exprs[i]) {
+			return true
+		}
+	}
+	for _, set := range node.Sets {
+		for i := range set {
+			if replaceExprs(from, to, &set[i]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // GroupConcatExpr represents a call to GROUP_CONCAT
 type GroupConcatExpr struct {
 	Distinct  string
@@ -3680,6 +3915,10 @@ func (node *Limit) Format(buf *TrackedBuffer) {
 	if node == nil {
 		return
 	}
+	if node.Rowcount == nil {
+		buf.Myprintf(" offset %v", node.Offset)
+		return
+	}
 	buf.Myprintf(" limit ")
 	if node.Offset != nil {
 		buf.Myprintf("%v, ", node.Offset)
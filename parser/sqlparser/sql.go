@@ -70,6 +70,7 @@ type yySymType struct {
 	indexHints                       *IndexHints
 	expr                             Expr
 	exprs                            Exprs
+	exprsList                        []Exprs
 	boolVal                          BoolVal
 	sqlVal                           *SQLVal
 	colTuple                         ColTuple
@@ -79,6 +80,8 @@ type yySymType struct {
 	whens                            []*When
 	when                             *When
 	orderBy                          OrderBy
+	windowFrame                      *WindowFrame
+	frameBound                       *FrameBound
 	order                            *Order
 	limit                            *Limit
 	triggers                         []Trigger
@@ -117,265 +120,288 @@ type yySymType struct {
 
 const LEX_ERROR = 57346
 const UNION = 57347
-const SELECT = 57348
-const STREAM = 57349
-const INSERT = 57350
-const UPDATE = 57351
-const DELETE = 57352
-const FROM = 57353
-const WHERE = 57354
-const GROUP = 57355
-const HAVING = 57356
-const ORDER = 57357
-const BY = 57358
-const LIMIT = 57359
-const OFFSET = 57360
-const FOR = 57361
-const WATERMARK = 57362
-const DELAY = 57363
-const COUNTING = 57364
-const AFTER = 57365
-const ALL = 57366
-const DISTINCT = 57367
-const AS = 57368
-const EXISTS = 57369
-const ASC = 57370
-const DESC = 57371
-const INTO = 57372
-const DUPLICATE = 57373
-const KEY = 57374
-const DEFAULT = 57375
-const SET = 57376
-const LOCK = 57377
-const UNLOCK = 57378
-const KEYS = 57379
-const VALUES = 57380
-const LAST_INSERT_ID = 57381
-const NEXT = 57382
-const VALUE = 57383
-const SHARE = 57384
-const MODE = 57385
-const SQL_NO_CACHE = 57386
-const SQL_CACHE = 57387
-const JOIN = 57388
-const STRAIGHT_JOIN = 57389
-const LOOKUP = 57390
-const LEFT = 57391
-const RIGHT = 57392
-const INNER = 57393
-const OUTER = 57394
-const CROSS = 57395
-const NATURAL = 57396
-const USE = 57397
-const FORCE = 57398
-const ON = 57399
-const USING = 57400
-const ID = 57401
-const HEX = 57402
+const EXCEPT = 57348
+const INTERSECT = 57349
+const SELECT = 57350
+const STREAM = 57351
+const INSERT = 57352
+const UPDATE = 57353
+const DELETE = 57354
+const FROM = 57355
+const WHERE = 57356
+const GROUP = 57357
+const HAVING = 57358
+const ORDER = 57359
+const BY = 57360
+const LIMIT = 57361
+const FOR = 57362
+const WATERMARK = 57363
+const DELAY = 57364
+const COUNTING = 57365
+const AFTER = 57366
+const ALL = 57367
+const DISTINCT = 57368
+const AS = 57369
+const EXISTS = 57370
+const ASC = 57371
+const DESC = 57372
+const INTO = 57373
+const DUPLICATE = 57374
+const KEY = 57375
+const DEFAULT = 57376
+const SET = 57377
+const LOCK = 57378
+const UNLOCK = 57379
+const KEYS = 57380
+const VALUES = 57381
+const LAST_INSERT_ID = 57382
+const NEXT = 57383
+const VALUE = 57384
+const SHARE = 57385
+const MODE = 57386
+const SQL_NO_CACHE = 57387
+const SQL_CACHE = 57388
+const JOIN = 57389
+const STRAIGHT_JOIN = 57390
+const LOOKUP = 57391
+const LEFT = 57392
+const RIGHT = 57393
+const INNER = 57394
+const OUTER = 57395
+const CROSS = 57396
+const NATURAL = 57397
+const USE = 57398
+const FORCE = 57399
+const ON = 57400
+const USING = 57401
+const TYPED_LITERAL_KEYWORD = 57402
 const STRING = 57403
-const INTEGRAL = 57404
-const FLOAT = 57405
-const HEXNUM = 57406
-const VALUE_ARG = 57407
-const LIST_ARG = 57408
-const COMMENT = 57409
-const COMMENT_KEYWORD = 57410
-const BIT_LITERAL = 57411
-const LIST_TYPE = 57412
-const OBJECT_TYPE = 57413
-const NULL = 57414
-const TRUE = 57415
-const FALSE = 57416
-const OFF = 57417
-const OR = 57418
-const AND = 57419
-const NOT = 57420
-const BETWEEN = 57421
-const CASE = 57422
-const WHEN = 57423
-const THEN = 57424
-const ELSE = 57425
-const END = 57426
-const OF = 57427
-const LE = 57428
-const GE = 57429
-const NE = 57430
-const NULL_SAFE_EQUAL = 57431
-const IS = 57432
-const LIKE = 57433
-const REGEXP = 57434
-const IN = 57435
-const RIGHTARROW = 57436
-const SHIFT_LEFT = 57437
-const SHIFT_RIGHT = 57438
-const DIV = 57439
-const MOD = 57440
-const NOT_LIKE_REGEXP = 57441
-const LIKE_REGEXP_CASE_INSENSITIVE = 57442
-const NOT_LIKE_REGEXP_CASE_INSENSITIVE = 57443
-const UNARY = 57444
-const COLLATE = 57445
-const BINARY = 57446
-const UNDERSCORE_BINARY = 57447
-const UNDERSCORE_UTF8MB4 = 57448
-const INTERVAL = 57449
-const JSON_EXTRACT_OP = 57450
-const JSON_UNQUOTE_EXTRACT_OP = 57451
-const CREATE = 57452
-const ALTER = 57453
-const DROP = 57454
-const RENAME = 57455
-const ANALYZE = 57456
-const ADD = 57457
-const FLUSH = 57458
-const SCHEMA = 57459
-const TABLE = 57460
-const DESCRIPTOR = 57461
-const INDEX = 57462
-const VIEW = 57463
-const TO = 57464
-const IGNORE = 57465
-const IF = 57466
-const UNIQUE = 57467
-const PRIMARY = 57468
-const COLUMN = 57469
-const SPATIAL = 57470
-const FULLTEXT = 57471
-const KEY_BLOCK_SIZE = 57472
-const ACTION = 57473
-const CASCADE = 57474
-const CONSTRAINT = 57475
-const FOREIGN = 57476
-const NO = 57477
-const REFERENCES = 57478
-const RESTRICT = 57479
-const SHOW = 57480
-const DESCRIBE = 57481
-const EXPLAIN = 57482
-const DATE = 57483
-const ESCAPE = 57484
-const REPAIR = 57485
-const OPTIMIZE = 57486
-const TRUNCATE = 57487
-const MAXVALUE = 57488
-const PARTITION = 57489
-const REORGANIZE = 57490
-const LESS = 57491
-const THAN = 57492
-const PROCEDURE = 57493
-const TRIGGER = 57494
-const VINDEX = 57495
-const VINDEXES = 57496
-const STATUS = 57497
-const VARIABLES = 57498
-const WARNINGS = 57499
-const BEGIN = 57500
-const START = 57501
-const TRANSACTION = 57502
-const COMMIT = 57503
-const ROLLBACK = 57504
-const BIT = 57505
-const TINYINT = 57506
-const SMALLINT = 57507
-const MEDIUMINT = 57508
-const INT = 57509
-const INTEGER = 57510
-const BIGINT = 57511
-const INTNUM = 57512
-const REAL = 57513
-const DOUBLE = 57514
-const FLOAT_TYPE = 57515
-const DECIMAL = 57516
-const NUMERIC = 57517
-const TIME = 57518
-const TIMESTAMP = 57519
-const DATETIME = 57520
-const YEAR = 57521
-const CHAR = 57522
-const VARCHAR = 57523
-const BOOL = 57524
-const CHARACTER = 57525
-const VARBINARY = 57526
-const NCHAR = 57527
-const TEXT = 57528
-const TINYTEXT = 57529
-const MEDIUMTEXT = 57530
-const LONGTEXT = 57531
-const BLOB = 57532
-const TINYBLOB = 57533
-const MEDIUMBLOB = 57534
-const LONGBLOB = 57535
-const JSON = 57536
-const ENUM = 57537
-const GEOMETRY = 57538
-const POINT = 57539
-const LINESTRING = 57540
-const POLYGON = 57541
-const GEOMETRYCOLLECTION = 57542
-const MULTIPOINT = 57543
-const MULTILINESTRING = 57544
-const MULTIPOLYGON = 57545
-const NULLX = 57546
-const AUTO_INCREMENT = 57547
-const APPROXNUM = 57548
-const SIGNED = 57549
-const UNSIGNED = 57550
-const ZEROFILL = 57551
-const COLLATION = 57552
-const DATABASES = 57553
-const SCHEMAS = 57554
-const TABLES = 57555
-const VITESS_KEYSPACES = 57556
-const VITESS_SHARDS = 57557
-const VITESS_TABLETS = 57558
-const VSCHEMA = 57559
-const VSCHEMA_TABLES = 57560
-const VITESS_TARGET = 57561
-const FULL = 57562
-const PROCESSLIST = 57563
-const COLUMNS = 57564
-const FIELDS = 57565
-const ENGINES = 57566
-const PLUGINS = 57567
-const NAMES = 57568
-const CHARSET = 57569
-const GLOBAL = 57570
-const SESSION = 57571
-const ISOLATION = 57572
-const LEVEL = 57573
-const READ = 57574
-const WRITE = 57575
-const ONLY = 57576
-const REPEATABLE = 57577
-const COMMITTED = 57578
-const UNCOMMITTED = 57579
-const SERIALIZABLE = 57580
-const CURRENT_TIMESTAMP = 57581
-const DATABASE = 57582
-const CURRENT_DATE = 57583
-const CURRENT_TIME = 57584
-const LOCALTIME = 57585
-const LOCALTIMESTAMP = 57586
-const UTC_DATE = 57587
-const UTC_TIME = 57588
-const UTC_TIMESTAMP = 57589
-const REPLACE = 57590
-const CONVERT = 57591
-const CAST = 57592
-const SUBSTR = 57593
-const SUBSTRING = 57594
-const GROUP_CONCAT = 57595
-const SEPARATOR = 57596
-const TIMESTAMPADD = 57597
-const TIMESTAMPDIFF = 57598
-const MATCH = 57599
-const AGAINST = 57600
-const BOOLEAN = 57601
-const LANGUAGE = 57602
-const WITH = 57603
-const QUERY = 57604
-const EXPANSION = 57605
-const UNUSED = 57606
+const FUNCTION_KEYWORD = 57404
+const OFFSET = 57405
+const NO_ALIAS = 57406
+const NO_FILTER = 57407
+const FILTER = 57408
+const ID = 57409
+const HEX = 57410
+const INTEGRAL = 57411
+const FLOAT = 57412
+const HEXNUM = 57413
+const VALUE_ARG = 57414
+const LIST_ARG = 57415
+const COMMENT = 57416
+const COMMENT_KEYWORD = 57417
+const BIT_LITERAL = 57418
+const LIST_TYPE = 57419
+const OBJECT_TYPE = 57420
+const NULL = 57421
+const TRUE = 57422
+const FALSE = 57423
+const OFF = 57424
+const OR = 57425
+const AND = 57426
+const NOT = 57427
+const BETWEEN = 57428
+const CASE = 57429
+const WHEN = 57430
+const THEN = 57431
+const ELSE = 57432
+const END = 57433
+const OF = 57434
+const LE = 57435
+const GE = 57436
+const NE = 57437
+const NULL_SAFE_EQUAL = 57438
+const IS = 57439
+const LIKE = 57440
+const REGEXP = 57441
+const IN = 57442
+const RIGHTARROW = 57443
+const CONCAT_OP = 57444
+const SHIFT_LEFT = 57445
+const SHIFT_RIGHT = 57446
+const DIV = 57447
+const MOD = 57448
+const NOT_LIKE_REGEXP = 57449
+const LIKE_REGEXP_CASE_INSENSITIVE = 57450
+const NOT_LIKE_REGEXP_CASE_INSENSITIVE = 57451
+const UNARY = 57452
+const COLLATE = 57453
+const AT = 57454
+const BINARY = 57455
+const UNDERSCORE_BINARY = 57456
+const UNDERSCORE_UTF8MB4 = 57457
+const INTERVAL = 57458
+const JSON_EXTRACT_OP = 57459
+const JSON_UNQUOTE_EXTRACT_OP = 57460
+const CREATE = 57461
+const ALTER = 57462
+const DROP = 57463
+const RENAME = 57464
+const ANALYZE = 57465
+const ADD = 57466
+const FLUSH = 57467
+const SCHEMA = 57468
+const TABLE = 57469
+const DESCRIPTOR = 57470
+const INDEX = 57471
+const VIEW = 57472
+const TO = 57473
+const IGNORE = 57474
+const IF = 57475
+const UNIQUE = 57476
+const PRIMARY = 57477
+const COLUMN = 57478
+const SPATIAL = 57479
+const FULLTEXT = 57480
+const KEY_BLOCK_SIZE = 57481
+const ACTION = 57482
+const CASCADE = 57483
+const CONSTRAINT = 57484
+const FOREIGN = 57485
+const NO = 57486
+const REFERENCES = 57487
+const RESTRICT = 57488
+const SHOW = 57489
+const DESCRIBE = 57490
+const EXPLAIN = 57491
+const DATE = 57492
+const ESCAPE = 57493
+const REPAIR = 57494
+const OPTIMIZE = 57495
+const TRUNCATE = 57496
+const MAXVALUE = 57497
+const PARTITION = 57498
+const REORGANIZE = 57499
+const LESS = 57500
+const THAN = 57501
+const PROCEDURE = 57502
+const TRIGGER = 57503
+const OVER = 57504
+const UNBOUNDED = 57505
+const PRECEDING = 57506
+const FOLLOWING = 57507
+const CURRENT = 57508
+const ROW = 57509
+const GROUPING = 57510
+const SETS = 57511
+const ROLLUP = 57512
+const CUBE = 57513
+const RECURSIVE = 57514
+const EXTRACT = 57515
+const ZONE = 57516
+const POSITION = 57517
+const VINDEX = 57518
+const VINDEXES = 57519
+const STATUS = 57520
+const VARIABLES = 57521
+const WARNINGS = 57522
+const BEGIN = 57523
+const START = 57524
+const TRANSACTION = 57525
+const COMMIT = 57526
+const ROLLBACK = 57527
+const BIT = 57528
+const TINYINT = 57529
+const SMALLINT = 57530
+const MEDIUMINT = 57531
+const INT = 57532
+const INTEGER = 57533
+const BIGINT = 57534
+const INTNUM = 57535
+const REAL = 57536
+const DOUBLE = 57537
+const FLOAT_TYPE = 57538
+const DECIMAL = 57539
+const NUMERIC = 57540
+const TIME = 57541
+const TIMESTAMP = 57542
+const DATETIME = 57543
+const YEAR = 57544
+const CHAR = 57545
+const VARCHAR = 57546
+const BOOL = 57547
+const CHARACTER = 57548
+const VARBINARY = 57549
+const NCHAR = 57550
+const TEXT = 57551
+const TINYTEXT = 57552
+const MEDIUMTEXT = 57553
+const LONGTEXT = 57554
+const BLOB = 57555
+const TINYBLOB = 57556
+const MEDIUMBLOB = 57557
+const LONGBLOB = 57558
+const JSON = 57559
+const ENUM = 57560
+const GEOMETRY = 57561
+const POINT = 57562
+const LINESTRING = 57563
+const POLYGON = 57564
+const GEOMETRYCOLLECTION = 57565
+const MULTIPOINT = 57566
+const MULTILINESTRING = 57567
+const MULTIPOLYGON = 57568
+const NULLX = 57569
+const AUTO_INCREMENT = 57570
+const APPROXNUM = 57571
+const SIGNED = 57572
+const UNSIGNED = 57573
+const ZEROFILL = 57574
+const COLLATION = 57575
+const DATABASES = 57576
+const SCHEMAS = 57577
+const TABLES = 57578
+const VITESS_KEYSPACES = 57579
+const VITESS_SHARDS = 57580
+const VITESS_TABLETS = 57581
+const VSCHEMA = 57582
+const VSCHEMA_TABLES = 57583
+const VITESS_TARGET = 57584
+const FULL = 57585
+const PROCESSLIST = 57586
+const COLUMNS = 57587
+const FIELDS = 57588
+const ENGINES = 57589
+const PLUGINS = 57590
+const NAMES = 57591
+const CHARSET = 57592
+const GLOBAL = 57593
+const SESSION = 57594
+const ISOLATION = 57595
+const LEVEL = 57596
+const READ = 57597
+const WRITE = 57598
+const ONLY = 57599
+const REPEATABLE = 57600
+const COMMITTED = 57601
+const UNCOMMITTED = 57602
+const SERIALIZABLE = 57603
+const CURRENT_TIMESTAMP = 57604
+const DATABASE = 57605
+const CURRENT_DATE = 57606
+const CURRENT_TIME = 57607
+const LOCALTIME = 57608
+const LOCALTIMESTAMP = 57609
+const UTC_DATE = 57610
+const UTC_TIME = 57611
+const UTC_TIMESTAMP = 57612
+const REPLACE = 57613
+const CONVERT = 57614
+const CAST = 57615
+const SUBSTR = 57616
+const SUBSTRING = 57617
+const GROUP_CONCAT = 57618
+const SEPARATOR = 57619
+const TIMESTAMPADD = 57620
+const TIMESTAMPDIFF = 57621
+const MATCH = 57622
+const AGAINST = 57623
+const BOOLEAN = 57624
+const LANGUAGE = 57625
+const WITH = 57626
+const QUERY = 57627
+const EXPANSION = 57628
+const UNUSED = 57629
 
 var yyToknames = [...]string{
 	"$end",
@@ -383,6 +409,8 @@ var yyToknames = [...]string{
 	"$unk",
 	"LEX_ERROR",
 	"UNION",
+	"EXCEPT",
+	"INTERSECT",
 	"SELECT",
 	"STREAM",
 	"INSERT",
@@ -395,7 +423,6 @@ var yyToknames = [...]string{
 	"ORDER",
 	"BY",
 	"LIMIT",
-	"OFFSET",
 	"FOR",
 	"WATERMARK",
 	"DELAY",
@@ -436,12 +463,18 @@ var yyToknames = [...]string{
 	"FORCE",
 	"ON",
 	"USING",
+	"TYPED_LITERAL_KEYWORD",
+	"STRING",
+	"FUNCTION_KEYWORD",
 	"'('",
+	"OFFSET",
+	"NO_ALIAS",
+	"NO_FILTER",
+	"FILTER",
 	"','",
 	"')'",
 	"ID",
 	"HEX",
-	"STRING",
 	"INTEGRAL",
 	"FLOAT",
 	"HEXNUM",
@@ -479,6 +512,7 @@ var yyToknames = [...]string{
 	"REGEXP",
 	"IN",
 	"RIGHTARROW",
+	"CONCAT_OP",
 	"'|'",
 	"'&'",
 	"SHIFT_LEFT",
@@ -497,6 +531,7 @@ var yyToknames = [...]string{
 	"NOT_LIKE_REGEXP_CASE_INSENSITIVE",
 	"UNARY",
 	"COLLATE",
+	"AT",
 	"BINARY",
 	"UNDERSCORE_BINARY",
 	"UNDERSCORE_UTF8MB4",
@@ -547,6 +582,20 @@ var yyToknames = [...]string{
 	"THAN",
 	"PROCEDURE",
 	"TRIGGER",
+	"OVER",
+	"UNBOUNDED",
+	"PRECEDING",
+	"FOLLOWING",
+	"CURRENT",
+	"ROW",
+	"GROUPING",
+	"SETS",
+	"ROLLUP",
+	"CUBE",
+	"RECURSIVE",
+	"EXTRACT",
+	"ZONE",
+	"POSITION",
 	"VINDEX",
 	"VINDEXES",
 	"STATUS",
@@ -671,1508 +720,1803 @@ const yyErrCode = 2
 const yyInitialStackSize = 16
 
 //line yacctab:1
-var yyExca = [...]int{
+var yyExca = [...]int16{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 38,
-	172, 303,
-	173, 303,
-	-2, 293,
-	-1, 282,
-	124, 659,
-	-2, 655,
-	-1, 283,
-	124, 660,
-	-2, 656,
-	-1, 351,
-	90, 840,
-	-2, 68,
-	-1, 352,
-	90, 795,
-	-2, 69,
-	-1, 357,
-	90, 771,
-	-2, 621,
-	-1, 359,
-	90, 816,
-	-2, 623,
-	-1, 633,
-	46, 387,
-	51, 387,
-	53, 387,
-	-2, 349,
-	-1, 637,
-	1, 355,
-	7, 355,
-	12, 355,
-	13, 355,
-	14, 355,
-	15, 355,
-	17, 355,
-	19, 355,
-	34, 355,
-	35, 355,
-	46, 355,
-	47, 355,
-	48, 355,
-	49, 355,
-	50, 355,
-	51, 355,
-	53, 355,
-	54, 355,
-	57, 355,
-	58, 355,
-	60, 355,
-	61, 355,
-	169, 355,
-	282, 355,
-	-2, 382,
-	-1, 641,
-	58, 49,
-	60, 49,
-	-2, 53,
-	-1, 786,
-	124, 662,
-	-2, 658,
-	-1, 1022,
-	5, 35,
-	-2, 456,
-	-1, 1058,
-	46, 387,
-	51, 387,
-	53, 387,
-	-2, 350,
-	-1, 1285,
-	5, 35,
-	-2, 596,
-	-1, 1427,
-	5, 35,
-	-2, 599,
+	-1, 22,
+	5, 42,
+	6, 42,
+	7, 42,
+	-2, 625,
+	-1, 39,
+	195, 309,
+	196, 309,
+	-2, 299,
+	-1, 286,
+	5, 39,
+	6, 39,
+	-2, 625,
+	-1, 293,
+	5, 41,
+	6, 41,
+	7, 41,
+	-2, 625,
+	-1, 308,
+	133, 714,
+	-2, 710,
+	-1, 309,
+	133, 715,
+	-2, 711,
+	-1, 383,
+	97, 910,
+	-2, 74,
+	-1, 384,
+	97, 860,
+	-2, 75,
+	-1, 389,
+	97, 831,
+	-2, 676,
+	-1, 391,
+	97, 882,
+	-2, 678,
+	-1, 682,
+	47, 403,
+	50, 403,
+	51, 403,
+	52, 403,
+	54, 403,
+	260, 403,
+	-2, 362,
+	-1, 690,
+	59, 55,
+	68, 55,
+	-2, 59,
+	-1, 841,
+	133, 717,
+	-2, 713,
+	-1, 1087,
+	5, 43,
+	6, 43,
+	7, 43,
+	-2, 476,
+	-1, 1123,
+	47, 403,
+	50, 403,
+	51, 403,
+	52, 403,
+	54, 403,
+	260, 403,
+	-2, 363,
+	-1, 1371,
+	5, 43,
+	6, 43,
+	7, 43,
+	-2, 651,
+	-1, 1541,
+	5, 43,
+	6, 43,
+	7, 43,
+	-2, 654,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 14067
+const yyLast = 17013
 
-var yyAct = [...]int{
-	283, 1477, 1467, 1439, 906, 1055, 1358, 1256, 287, 1078,
-	593, 1151, 1413, 300, 1193, 1230, 1326, 1192, 258, 313,
-	1209, 1076, 1189, 633, 66, 881, 876, 289, 62, 935,
-	1056, 985, 1084, 208, 878, 915, 58, 66, 592, 3,
-	66, 1199, 819, 1105, 750, 905, 830, 249, 737, 356,
-	815, 1313, 902, 1013, 827, 634, 1131, 919, 1122, 868,
-	654, 848, 788, 517, 458, 350, 345, 523, 532, 949,
-	653, 861, 540, 270, 342, 347, 643, 607, 945, 57,
-	1470, 1445, 1465, 1425, 1461, 1257, 1444, 488, 1424, 1181,
-	25, 257, 570, 250, 251, 252, 253, 1277, 463, 256,
-	608, 490, 61, 1224, 548, 1093, 555, 511, 1092, 897,
-	898, 1094, 929, 572, 573, 574, 575, 576, 577, 578,
-	570, 549, 554, 547, 896, 557, 556, 566, 567, 559,
-	560, 561, 562, 563, 564, 565, 558, 550, 552, 551,
-	553, 255, 568, 55, 1225, 1226, 570, 655, 571, 656,
-	570, 1386, 254, 557, 556, 566, 567, 559, 560, 561,
-	562, 563, 564, 565, 558, 210, 510, 212, 1113, 928,
-	568, 1316, 464, 25, 492, 476, 571, 494, 936, 557,
-	556, 566, 567, 559, 560, 561, 562, 563, 564, 565,
-	558, 248, 500, 501, 66, 208, 568, 570, 829, 66,
-	568, 66, 571, 22, 25, 1342, 571, 491, 493, 218,
-	214, 66, 215, 216, 66, 280, 1154, 1153, 640, 724,
-	66, 209, 1419, 66, 726, 208, 55, 208, 208, 570,
-	208, 208, 1050, 208, 1463, 208, 1051, 274, 1457, 1481,
-	1414, 558, 507, 1150, 208, 1406, 862, 568, 570, 920,
-	508, 505, 506, 571, 1485, 220, 1367, 55, 477, 725,
-	465, 211, 212, 66, 566, 567, 559, 560, 561, 562,
-	563, 564, 565, 558, 1155, 516, 730, 208, 717, 568,
-	1359, 853, 353, 570, 1219, 571, 922, 561, 562, 563,
-	564, 565, 558, 1361, 1218, 1217, 489, 903, 568, 461,
-	525, 528, 513, 514, 571, 529, 569, 727, 468, 222,
-	1423, 213, 581, 1079, 1081, 1393, 557, 556, 566, 567,
-	559, 560, 561, 562, 563, 564, 565, 558, 979, 1387,
-	1288, 978, 217, 568, 569, 1161, 1280, 1089, 892, 571,
-	66, 66, 66, 188, 1147, 570, 1216, 1041, 751, 208,
-	1149, 473, 1007, 759, 1479, 208, 649, 1480, 544, 1478,
-	569, 1016, 23, 483, 569, 526, 1368, 1366, 637, 1360,
-	190, 191, 192, 193, 194, 632, 466, 467, 557, 556,
-	566, 567, 559, 560, 561, 562, 563, 564, 565, 558,
-	265, 921, 479, 480, 481, 568, 1242, 756, 1080, 987,
-	539, 571, 1404, 459, 610, 612, 614, 616, 618, 620,
-	621, 569, 344, 339, 340, 1376, 642, 460, 1203, 462,
-	647, 470, 657, 471, 651, 197, 472, 611, 613, 469,
-	617, 619, 475, 622, 1459, 922, 353, 752, 482, 457,
-	1183, 484, 537, 569, 325, 23, 331, 332, 329, 330,
-	328, 327, 326, 849, 1243, 1148, 795, 1146, 66, 539,
-	333, 334, 569, 208, 198, 1106, 762, 763, 66, 66,
-	208, 793, 794, 792, 66, 719, 23, 66, 1279, 1111,
-	66, 538, 537, 1409, 66, 986, 208, 570, 1185, 1451,
-	208, 208, 208, 66, 208, 208, 1274, 569, 849, 539,
-	1038, 208, 208, 1004, 1005, 1006, 583, 584, 585, 586,
-	587, 588, 589, 590, 1486, 534, 1431, 538, 537, 1322,
-	557, 556, 566, 567, 559, 560, 561, 562, 563, 564,
-	565, 558, 208, 739, 1433, 539, 66, 568, 1405, 515,
-	921, 1321, 208, 571, 538, 537, 570, 765, 778, 780,
-	781, 731, 1126, 1337, 779, 55, 1487, 1452, 631, 569,
-	641, 758, 539, 925, 1125, 791, 764, 789, 816, 926,
-	817, 821, 208, 1114, 1319, 790, 1027, 1158, 922, 557,
-	556, 566, 567, 559, 560, 561, 562, 563, 564, 565,
-	558, 208, 1123, 784, 786, 1402, 568, 1026, 1095, 1025,
-	1096, 516, 571, 757, 767, 1364, 1462, 1450, 459, 839,
-	842, 530, 1373, 871, 782, 850, 1435, 516, 538, 537,
-	1364, 1417, 538, 537, 208, 208, 1364, 516, 1372, 538,
-	537, 66, 785, 1259, 834, 1106, 539, 1364, 1394, 66,
-	539, 66, 1364, 1363, 66, 66, 1101, 539, 66, 66,
-	66, 208, 825, 872, 870, 873, 874, 1311, 1310, 1239,
-	875, 1290, 516, 923, 208, 736, 637, 516, 55, 883,
-	735, 637, 846, 1287, 516, 637, 665, 720, 887, 1249,
-	1248, 858, 889, 921, 1245, 1246, 721, 722, 918, 916,
-	718, 917, 728, 1245, 1244, 344, 914, 920, 734, 739,
-	715, 569, 1020, 516, 865, 516, 832, 516, 937, 938,
-	939, 744, 645, 885, 485, 890, 893, 478, 66, 208,
-	886, 208, 644, 894, 276, 208, 208, 66, 66, 910,
-	66, 66, 664, 663, 66, 208, 1190, 864, 353, 1202,
-	1202, 832, 1283, 1375, 1164, 645, 865, 1247, 1273, 1085,
-	66, 907, 66, 66, 774, 66, 1215, 1085, 1097, 646,
-	569, 648, 787, 865, 895, 796, 797, 798, 799, 800,
-	801, 802, 803, 804, 805, 806, 807, 808, 809, 810,
-	811, 812, 813, 814, 951, 818, 931, 932, 933, 934,
-	947, 948, 646, 1020, 644, 835, 836, 865, 570, 841,
-	844, 845, 942, 943, 944, 1202, 1044, 1043, 994, 786,
-	871, 766, 789, 59, 1020, 644, 650, 760, 729, 267,
-	790, 262, 1446, 1328, 857, 854, 859, 860, 995, 997,
-	930, 557, 556, 566, 567, 559, 560, 561, 562, 563,
-	564, 565, 558, 1442, 1441, 1298, 1235, 785, 568, 863,
-	872, 870, 873, 874, 571, 1009, 1100, 875, 1020, 950,
-	1210, 1211, 1152, 888, 1210, 1211, 66, 946, 66, 66,
-	66, 941, 55, 1057, 831, 833, 940, 66, 1440, 1472,
-	66, 208, 953, 1468, 1237, 66, 871, 66, 1208, 1058,
-	1190, 1127, 1064, 637, 754, 637, 637, 637, 733, 1068,
-	1052, 773, 1066, 1213, 1212, 1069, 208, 637, 1067, 1037,
-	1098, 1206, 1060, 1083, 637, 1205, 1455, 1061, 834, 1062,
-	1087, 1086, 1088, 1063, 1443, 1065, 872, 870, 873, 874,
-	271, 272, 1160, 875, 1070, 1071, 954, 873, 874, 991,
-	1448, 1002, 533, 1001, 518, 976, 977, 1118, 980, 981,
-	662, 1110, 982, 1411, 208, 208, 1090, 531, 1410, 1340,
-	519, 1115, 1116, 1107, 1108, 1102, 1281, 1324, 984, 956,
-	732, 1003, 877, 990, 263, 1103, 1104, 268, 269, 533,
-	1453, 570, 259, 208, 520, 524, 1000, 1380, 1117, 260,
-	1119, 1120, 1121, 907, 999, 59, 1379, 1330, 1085, 66,
-	1124, 509, 1474, 1473, 545, 1032, 1130, 1031, 208, 1010,
-	1011, 1012, 569, 1029, 1028, 749, 535, 1143, 559, 560,
-	561, 562, 563, 564, 565, 558, 821, 1019, 821, 1157,
-	1474, 568, 1390, 1317, 755, 1464, 187, 571, 189, 594,
-	312, 56, 1, 1466, 1258, 1035, 996, 1325, 605, 962,
-	1412, 866, 1357, 1229, 208, 208, 1191, 913, 904, 1057,
-	66, 196, 456, 1167, 1168, 195, 1403, 1173, 1174, 912,
-	1176, 1194, 1182, 206, 911, 1365, 1315, 1175, 924, 1112,
-	927, 1236, 1109, 208, 1408, 670, 668, 637, 1201, 669,
-	994, 786, 1196, 667, 672, 1166, 671, 666, 208, 233,
-	208, 208, 348, 658, 1228, 314, 52, 1017, 952, 1018,
-	536, 199, 1145, 1144, 958, 1204, 1022, 1023, 1024, 1223,
-	503, 1221, 504, 1030, 1220, 235, 1033, 1034, 66, 1186,
-	579, 998, 1040, 1091, 354, 1197, 1042, 1232, 1438, 1045,
-	1046, 1047, 1048, 1227, 1418, 66, 761, 1233, 1234, 522,
-	1378, 208, 1329, 1036, 208, 208, 66, 604, 52, 847,
-	1073, 288, 208, 777, 301, 66, 298, 299, 1240, 1241,
-	768, 285, 1049, 546, 286, 278, 636, 629, 869, 1251,
-	867, 1059, 343, 1207, 1294, 907, 1301, 907, 1074, 1263,
-	1075, 1252, 637, 1254, 635, 569, 1264, 1163, 1276, 1265,
-	1385, 772, 27, 186, 273, 19, 18, 17, 20, 16,
-	15, 1057, 14, 474, 1282, 31, 208, 1162, 21, 13,
-	12, 11, 10, 9, 8, 1295, 7, 6, 208, 5,
-	1170, 1171, 1098, 1292, 753, 355, 208, 1300, 1291, 4,
-	1309, 1299, 60, 261, 1177, 1178, 264, 1179, 1180, 1166,
-	24, 208, 2, 0, 0, 0, 0, 0, 208, 1187,
-	1188, 0, 775, 776, 0, 355, 0, 355, 355, 0,
-	355, 355, 0, 355, 1318, 355, 1320, 0, 486, 0,
-	0, 0, 0, 0, 355, 0, 0, 0, 208, 208,
-	0, 208, 266, 0, 0, 0, 0, 0, 1341, 208,
-	66, 1172, 0, 1194, 0, 0, 208, 208, 208, 66,
-	1348, 0, 208, 0, 0, 907, 0, 542, 0, 0,
-	594, 0, 0, 837, 838, 1343, 1238, 1362, 0, 208,
-	487, 1369, 487, 487, 1356, 487, 487, 883, 487, 0,
-	487, 0, 0, 0, 1312, 1327, 1250, 0, 0, 487,
-	1349, 0, 66, 0, 0, 0, 1214, 1353, 1354, 1355,
-	1391, 1396, 1194, 1253, 1401, 208, 1400, 52, 0, 527,
-	0, 1370, 52, 1371, 1262, 1395, 208, 208, 0, 637,
-	1377, 1416, 901, 1392, 0, 1267, 0, 580, 0, 355,
-	582, 1421, 1415, 0, 208, 659, 1426, 0, 0, 1057,
-	0, 0, 0, 0, 0, 0, 0, 66, 0, 0,
-	0, 0, 0, 0, 0, 208, 0, 0, 591, 0,
-	595, 596, 597, 598, 599, 600, 601, 602, 603, 1437,
-	606, 609, 609, 609, 615, 609, 609, 615, 609, 623,
-	624, 625, 626, 627, 628, 1449, 638, 208, 1447, 1266,
-	0, 0, 0, 0, 1458, 0, 1268, 1269, 1270, 0,
-	0, 0, 0, 1327, 907, 0, 0, 0, 0, 1471,
-	0, 0, 0, 0, 0, 0, 1482, 1284, 1285, 1286,
-	0, 1289, 992, 993, 0, 524, 0, 1331, 1332, 1333,
-	1334, 1335, 0, 0, 0, 1338, 1339, 0, 1456, 0,
-	0, 0, 1308, 355, 0, 495, 496, 0, 497, 498,
-	355, 499, 0, 502, 0, 0, 0, 0, 0, 0,
-	0, 0, 512, 0, 0, 0, 355, 0, 0, 0,
-	355, 355, 355, 0, 355, 355, 0, 0, 0, 0,
-	0, 355, 355, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1021, 1336, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 487, 1272,
-	0, 0, 769, 0, 1039, 487, 0, 0, 0, 0,
-	0, 0, 542, 0, 0, 355, 0, 0, 0, 0,
-	0, 487, 0, 0, 0, 487, 487, 487, 0, 487,
-	487, 0, 0, 0, 0, 0, 487, 487, 0, 0,
-	0, 0, 824, 1381, 1382, 1383, 1384, 0, 0, 570,
-	1388, 1389, 0, 0, 0, 1432, 0, 0, 0, 0,
-	1271, 826, 0, 52, 0, 1397, 1398, 1399, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 851, 0, 0,
-	0, 0, 557, 556, 566, 567, 559, 560, 561, 562,
-	563, 564, 565, 558, 855, 856, 0, 1422, 0, 568,
-	0, 0, 0, 0, 1427, 571, 0, 1429, 1430, 0,
-	570, 0, 0, 0, 0, 1475, 0, 0, 52, 0,
-	0, 355, 0, 0, 1434, 687, 0, 0, 0, 0,
-	0, 595, 0, 1138, 355, 0, 0, 0, 0, 0,
-	0, 0, 1159, 557, 556, 566, 567, 559, 560, 561,
-	562, 563, 564, 565, 558, 0, 0, 0, 0, 0,
-	568, 0, 0, 1136, 0, 0, 571, 0, 0, 0,
-	0, 716, 0, 0, 879, 880, 0, 0, 723, 638,
-	0, 570, 0, 638, 0, 0, 0, 1483, 1484, 355,
-	0, 355, 1169, 1184, 740, 974, 975, 0, 741, 742,
-	743, 0, 745, 746, 0, 355, 0, 0, 0, 747,
-	748, 675, 0, 0, 557, 556, 566, 567, 559, 560,
-	561, 562, 563, 564, 565, 558, 0, 0, 0, 0,
-	355, 568, 0, 0, 0, 0, 0, 571, 1137, 0,
-	1222, 0, 0, 1142, 1139, 1132, 1140, 1135, 0, 688,
-	0, 1133, 1134, 0, 487, 0, 487, 0, 0, 0,
-	0, 0, 0, 569, 0, 1141, 0, 0, 0, 0,
-	487, 701, 704, 705, 706, 707, 708, 709, 0, 710,
-	711, 712, 713, 714, 689, 690, 691, 692, 673, 674,
-	702, 0, 676, 0, 677, 678, 679, 680, 681, 682,
-	683, 684, 685, 686, 693, 694, 695, 696, 697, 698,
-	699, 700, 0, 25, 26, 53, 28, 29, 0, 0,
-	0, 1008, 0, 0, 569, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 851, 44, 521, 0, 0,
-	1278, 30, 49, 50, 570, 0, 0, 0, 0, 0,
-	594, 1077, 0, 0, 0, 0, 0, 0, 1293, 0,
-	0, 63, 39, 1296, 0, 1297, 55, 703, 0, 0,
-	0, 1302, 0, 0, 221, 0, 355, 247, 556, 566,
-	567, 559, 560, 561, 562, 563, 564, 565, 558, 0,
-	0, 0, 0, 0, 568, 569, 0, 1053, 1054, 0,
-	571, 638, 0, 638, 638, 638, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 879, 0, 0, 1082, 0,
-	0, 0, 638, 0, 1128, 355, 0, 955, 0, 957,
-	0, 0, 0, 0, 32, 33, 35, 34, 37, 0,
-	51, 0, 0, 983, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 355, 0, 0, 0, 0, 0, 0,
-	570, 968, 38, 45, 46, 0, 0, 47, 48, 36,
-	0, 0, 0, 0, 0, 0, 0, 0, 355, 967,
-	0, 0, 40, 41, 0, 42, 43, 0, 0, 0,
-	487, 0, 0, 557, 556, 566, 567, 559, 560, 561,
-	562, 563, 564, 565, 558, 0, 0, 0, 972, 0,
-	568, 0, 355, 0, 0, 0, 571, 966, 487, 0,
-	0, 851, 0, 0, 1198, 1200, 0, 0, 277, 0,
-	0, 346, 0, 0, 1420, 594, 221, 0, 221, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
-	1014, 221, 0, 1200, 0, 0, 0, 221, 569, 0,
-	221, 0, 0, 0, 0, 0, 0, 0, 355, 0,
-	355, 1231, 54, 0, 963, 960, 961, 0, 959, 0,
-	0, 0, 0, 0, 0, 23, 0, 1195, 0, 52,
-	0, 0, 0, 0, 0, 638, 0, 1454, 0, 0,
-	63, 570, 0, 0, 0, 0, 0, 0, 1460, 0,
-	970, 973, 1015, 0, 0, 0, 0, 0, 0, 0,
-	0, 1255, 0, 0, 1260, 1261, 0, 0, 0, 0,
-	0, 0, 355, 0, 557, 556, 566, 567, 559, 560,
-	561, 562, 563, 564, 565, 558, 965, 0, 0, 0,
-	0, 568, 0, 0, 0, 0, 0, 571, 0, 0,
-	0, 0, 0, 1129, 0, 0, 0, 0, 964, 0,
-	0, 0, 0, 851, 569, 0, 0, 221, 221, 221,
-	0, 0, 0, 0, 0, 0, 1077, 0, 0, 0,
-	0, 1156, 0, 0, 0, 0, 570, 0, 355, 0,
-	638, 0, 0, 0, 0, 0, 1314, 0, 0, 0,
-	0, 0, 969, 0, 0, 0, 0, 1275, 0, 0,
-	0, 355, 0, 0, 0, 0, 0, 971, 355, 557,
-	556, 566, 567, 559, 560, 561, 562, 563, 564, 565,
-	558, 0, 0, 0, 0, 0, 568, 0, 0, 0,
-	0, 0, 571, 1305, 1306, 1307, 0, 0, 1345, 1346,
-	0, 1347, 0, 0, 0, 0, 0, 0, 0, 1314,
-	0, 0, 0, 0, 0, 0, 1314, 1314, 1314, 0,
-	0, 0, 1231, 0, 0, 0, 487, 0, 0, 0,
-	0, 0, 0, 0, 0, 221, 0, 0, 0, 1314,
-	0, 0, 0, 0, 0, 221, 221, 0, 0, 0,
-	0, 221, 0, 0, 221, 569, 230, 221, 0, 1195,
-	0, 738, 1344, 851, 0, 0, 0, 0, 0, 0,
-	221, 0, 0, 0, 0, 1407, 0, 0, 0, 1351,
-	1352, 243, 0, 0, 0, 0, 355, 355, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1374, 851, 0, 0, 1428, 0, 0, 0, 0, 0,
-	0, 0, 0, 221, 0, 0, 0, 0, 1195, 0,
-	52, 0, 738, 0, 0, 1436, 0, 638, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 223,
-	0, 0, 0, 0, 0, 0, 225, 0, 0, 0,
-	569, 0, 0, 0, 234, 0, 229, 1314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 277,
-	0, 0, 0, 0, 277, 277, 0, 0, 277, 277,
-	277, 0, 0, 0, 852, 0, 0, 232, 0, 0,
-	0, 0, 0, 242, 0, 0, 0, 0, 0, 1323,
-	0, 0, 0, 277, 277, 277, 277, 0, 221, 0,
-	0, 0, 0, 0, 0, 0, 221, 0, 63, 0,
-	0, 221, 221, 0, 0, 221, 891, 738, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1469,
-	0, 0, 0, 0, 0, 0, 236, 226, 227, 0,
-	237, 238, 239, 241, 0, 240, 246, 0, 0, 0,
-	228, 231, 0, 224, 245, 244, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 221, 0, 0, 0, 0,
-	0, 0, 0, 0, 221, 221, 0, 221, 221, 0,
-	0, 221, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 221, 0, 988,
-	989, 0, 221, 0, 0, 0, 0, 738, 129, 0,
-	182, 89, 85, 67, 0, 0, 541, 0, 0, 0,
-	277, 91, 0, 0, 0, 0, 0, 109, 0, 111,
-	0, 0, 150, 120, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 207, 0, 543, 0, 0, 0, 0, 0,
-	0, 82, 0, 0, 0, 0, 0, 0, 0, 538,
-	537, 0, 0, 0, 0, 0, 277, 0, 93, 128,
-	0, 0, 0, 0, 0, 0, 0, 539, 0, 0,
-	0, 0, 0, 0, 277, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 852, 221, 0, 221, 221, 221, 0, 0,
-	0, 0, 0, 98, 1072, 0, 0, 221, 172, 0,
-	0, 0, 63, 136, 221, 153, 100, 108, 69, 76,
-	0, 99, 126, 141, 145, 0, 0, 0, 86, 0,
-	143, 131, 165, 0, 132, 142, 112, 158, 137, 0,
-	173, 174, 155, 171, 181, 70, 154, 164, 83, 146,
-	72, 162, 152, 118, 104, 105, 71, 0, 140, 90,
-	96, 88, 127, 159, 160, 87, 184, 77, 170, 74,
-	78, 169, 125, 157, 163, 119, 116, 73, 161, 117,
-	115, 107, 94, 101, 134, 114, 135, 102, 122, 121,
-	123, 0, 0, 0, 151, 167, 185, 80, 0, 147,
-	156, 175, 176, 177, 178, 179, 180, 0, 0, 81,
-	97, 92, 133, 124, 79, 103, 148, 106, 113, 139,
-	183, 130, 144, 84, 166, 149, 221, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 277, 0, 0, 0,
-	0, 0, 0, 0, 0, 68, 75, 110, 277, 138,
-	95, 168, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 738,
-	0, 0, 0, 0, 0, 0, 0, 0, 852, 0,
-	0, 0, 0, 0, 0, 0, 0, 221, 0, 129,
-	0, 182, 89, 85, 67, 0, 0, 0, 0, 0,
-	0, 0, 91, 0, 0, 0, 0, 0, 109, 0,
-	111, 0, 0, 150, 120, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 207, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 0, 0, 0, 0, 0, 0, 0,
-	201, 0, 0, 0, 0, 221, 0, 0, 0, 93,
-	128, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 221, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 221, 0, 0, 0, 0, 0, 0,
-	0, 0, 221, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 98, 203, 204, 0, 0, 200,
-	0, 0, 0, 205, 136, 0, 153, 100, 108, 69,
-	76, 0, 99, 126, 141, 145, 0, 0, 0, 86,
-	852, 143, 131, 165, 0, 132, 142, 112, 158, 137,
-	0, 173, 174, 155, 171, 181, 70, 154, 164, 83,
-	146, 72, 162, 152, 118, 104, 105, 71, 0, 140,
-	90, 96, 88, 127, 159, 160, 87, 184, 77, 170,
-	74, 78, 169, 125, 157, 163, 119, 116, 73, 161,
-	117, 115, 107, 94, 101, 134, 114, 135, 102, 122,
-	121, 123, 0, 0, 0, 151, 167, 185, 80, 0,
-	147, 156, 175, 176, 177, 178, 179, 180, 0, 0,
-	81, 97, 92, 133, 124, 79, 103, 148, 106, 113,
-	139, 183, 130, 144, 84, 166, 149, 1350, 202, 0,
-	0, 0, 0, 0, 0, 0, 63, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 68, 75, 110, 0,
-	138, 95, 168, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 221,
-	852, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 852, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 443, 431, 221, 402, 446, 381, 394, 454,
-	395, 396, 424, 367, 410, 129, 392, 182, 89, 85,
-	67, 0, 384, 362, 389, 363, 382, 404, 91, 407,
-	380, 433, 413, 445, 109, 452, 111, 418, 0, 150,
-	120, 0, 0, 406, 435, 0, 408, 429, 401, 425,
-	372, 417, 447, 393, 422, 448, 0, 0, 0, 207,
-	0, 908, 909, 0, 0, 0, 0, 0, 82, 0,
-	0, 0, 420, 442, 391, 421, 423, 361, 419, 0,
-	365, 368, 453, 437, 387, 93, 128, 1099, 0, 0,
-	0, 0, 0, 0, 405, 409, 426, 399, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 385, 0, 416,
-	0, 0, 0, 0, 0, 0, 369, 366, 0, 0,
-	403, 0, 0, 0, 371, 0, 386, 427, 0, 360,
-	98, 430, 436, 0, 400, 172, 440, 398, 397, 444,
-	136, 0, 153, 100, 108, 69, 76, 0, 99, 126,
-	141, 145, 434, 383, 390, 86, 388, 143, 131, 165,
-	415, 132, 142, 112, 158, 137, 441, 173, 174, 155,
-	171, 181, 70, 154, 164, 83, 146, 72, 162, 152,
-	118, 104, 105, 71, 0, 140, 90, 96, 88, 127,
-	159, 160, 87, 184, 77, 170, 74, 78, 169, 125,
-	157, 163, 119, 116, 73, 161, 117, 115, 107, 94,
-	101, 134, 114, 135, 102, 122, 121, 123, 0, 364,
-	0, 151, 167, 185, 80, 379, 147, 156, 175, 176,
-	177, 178, 179, 180, 0, 0, 81, 97, 92, 133,
-	124, 79, 103, 148, 106, 113, 139, 183, 130, 144,
-	84, 166, 149, 375, 378, 373, 374, 411, 412, 449,
-	450, 451, 428, 370, 0, 376, 377, 0, 432, 438,
-	439, 414, 68, 75, 110, 455, 138, 95, 168, 443,
-	431, 0, 402, 446, 381, 394, 454, 395, 396, 424,
-	367, 410, 129, 392, 182, 89, 85, 67, 0, 384,
-	362, 389, 363, 382, 404, 91, 407, 380, 433, 413,
-	445, 109, 452, 111, 418, 0, 150, 120, 0, 0,
-	406, 435, 0, 408, 429, 401, 425, 372, 417, 447,
-	393, 422, 448, 0, 0, 0, 207, 0, 908, 909,
-	0, 0, 0, 0, 0, 82, 0, 0, 0, 420,
-	442, 391, 421, 423, 361, 419, 0, 365, 368, 453,
-	437, 387, 93, 128, 0, 0, 0, 0, 0, 0,
-	0, 405, 409, 426, 399, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 385, 0, 416, 0, 0, 0,
-	0, 0, 0, 369, 366, 0, 0, 403, 0, 0,
-	0, 371, 0, 386, 427, 0, 360, 98, 430, 436,
-	0, 400, 172, 440, 398, 397, 444, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 434,
-	383, 390, 86, 388, 143, 131, 165, 415, 132, 142,
-	112, 158, 137, 441, 173, 174, 155, 171, 181, 70,
-	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
-	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
-	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 364, 0, 151, 167,
-	185, 80, 379, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
-	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	375, 378, 373, 374, 411, 412, 449, 450, 451, 428,
-	370, 0, 376, 377, 0, 432, 438, 439, 414, 68,
-	75, 110, 455, 138, 95, 168, 443, 431, 0, 402,
-	446, 381, 394, 454, 395, 396, 424, 367, 410, 129,
-	392, 182, 89, 85, 67, 0, 384, 362, 389, 363,
-	382, 404, 91, 407, 380, 433, 413, 445, 109, 452,
-	111, 418, 0, 150, 120, 0, 0, 406, 435, 0,
-	408, 429, 401, 425, 372, 417, 447, 393, 422, 448,
-	55, 0, 0, 207, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 0, 0, 0, 420, 442, 391, 421,
-	423, 361, 419, 0, 365, 368, 453, 437, 387, 93,
-	128, 0, 0, 0, 0, 0, 0, 0, 405, 409,
-	426, 399, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 385, 0, 416, 0, 0, 0, 0, 0, 0,
-	369, 366, 0, 0, 403, 0, 0, 0, 371, 0,
-	386, 427, 0, 360, 98, 430, 436, 0, 400, 172,
-	440, 398, 397, 444, 136, 0, 153, 100, 108, 69,
-	76, 0, 99, 126, 141, 145, 434, 383, 390, 86,
-	388, 143, 131, 165, 415, 132, 142, 112, 158, 137,
-	441, 173, 174, 155, 171, 181, 70, 154, 164, 83,
-	146, 72, 162, 152, 118, 104, 105, 71, 0, 140,
-	90, 96, 88, 127, 159, 160, 87, 184, 77, 170,
-	74, 78, 169, 125, 157, 163, 119, 116, 73, 161,
-	117, 115, 107, 94, 101, 134, 114, 135, 102, 122,
-	121, 123, 0, 364, 0, 151, 167, 185, 80, 379,
-	147, 156, 175, 176, 177, 178, 179, 180, 0, 0,
-	81, 97, 92, 133, 124, 79, 103, 148, 106, 113,
-	139, 183, 130, 144, 84, 166, 149, 375, 378, 373,
-	374, 411, 412, 449, 450, 451, 428, 370, 0, 376,
-	377, 0, 432, 438, 439, 414, 68, 75, 110, 455,
-	138, 95, 168, 443, 431, 0, 402, 446, 381, 394,
-	454, 395, 396, 424, 367, 410, 129, 392, 182, 89,
-	85, 67, 0, 384, 362, 389, 363, 382, 404, 91,
-	407, 380, 433, 413, 445, 109, 452, 111, 418, 0,
-	150, 120, 0, 0, 406, 435, 0, 408, 429, 401,
-	425, 372, 417, 447, 393, 422, 448, 0, 0, 0,
-	207, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	0, 0, 0, 420, 442, 391, 421, 423, 361, 419,
-	0, 365, 368, 453, 437, 387, 93, 128, 0, 0,
-	0, 0, 0, 0, 0, 405, 409, 426, 399, 0,
-	0, 0, 0, 0, 0, 0, 1165, 0, 385, 0,
-	416, 0, 0, 0, 0, 0, 0, 369, 366, 0,
-	0, 403, 0, 0, 0, 371, 0, 386, 427, 0,
-	360, 98, 430, 436, 0, 400, 172, 440, 398, 397,
-	444, 136, 0, 153, 100, 108, 69, 76, 0, 99,
-	126, 141, 145, 434, 383, 390, 86, 388, 143, 131,
-	165, 415, 132, 142, 112, 158, 137, 441, 173, 174,
-	155, 171, 181, 70, 154, 164, 83, 146, 72, 162,
-	152, 118, 104, 105, 71, 0, 140, 90, 96, 88,
-	127, 159, 160, 87, 184, 77, 170, 74, 78, 169,
-	125, 157, 163, 119, 116, 73, 161, 117, 115, 107,
-	94, 101, 134, 114, 135, 102, 122, 121, 123, 0,
-	364, 0, 151, 167, 185, 80, 379, 147, 156, 175,
-	176, 177, 178, 179, 180, 0, 0, 81, 97, 92,
-	133, 124, 79, 103, 148, 106, 113, 139, 183, 130,
-	144, 84, 166, 149, 375, 378, 373, 374, 411, 412,
-	449, 450, 451, 428, 370, 0, 376, 377, 0, 432,
-	438, 439, 414, 68, 75, 110, 455, 138, 95, 168,
-	443, 431, 0, 402, 446, 381, 394, 454, 395, 396,
-	424, 367, 410, 129, 392, 182, 89, 85, 67, 0,
-	384, 362, 389, 363, 382, 404, 91, 407, 380, 433,
-	413, 445, 109, 452, 111, 418, 0, 150, 120, 0,
-	0, 406, 435, 0, 408, 429, 401, 425, 372, 417,
-	447, 393, 422, 448, 0, 0, 0, 65, 0, 0,
-	0, 0, 0, 0, 0, 0, 82, 0, 0, 0,
-	420, 442, 391, 421, 423, 361, 419, 0, 365, 368,
-	453, 437, 387, 93, 128, 0, 0, 0, 0, 0,
-	0, 0, 405, 409, 426, 399, 0, 0, 0, 0,
-	0, 0, 0, 892, 0, 385, 0, 416, 0, 0,
-	0, 0, 0, 0, 369, 366, 0, 0, 403, 0,
-	0, 0, 371, 0, 386, 427, 0, 360, 98, 430,
-	436, 0, 400, 172, 440, 398, 397, 444, 136, 0,
-	153, 100, 108, 69, 76, 0, 99, 126, 141, 145,
-	434, 383, 390, 86, 388, 143, 131, 165, 415, 132,
-	142, 112, 158, 137, 441, 173, 174, 155, 171, 181,
-	70, 154, 164, 83, 146, 72, 162, 152, 118, 104,
-	105, 71, 0, 140, 90, 96, 88, 127, 159, 160,
-	87, 184, 77, 170, 74, 78, 169, 125, 157, 163,
-	119, 116, 73, 161, 117, 115, 107, 94, 101, 134,
-	114, 135, 102, 122, 121, 123, 0, 364, 0, 151,
-	167, 185, 80, 379, 147, 156, 175, 176, 177, 178,
-	179, 180, 0, 0, 81, 97, 92, 133, 124, 79,
-	103, 148, 106, 113, 139, 183, 130, 144, 84, 166,
-	149, 375, 378, 373, 374, 411, 412, 449, 450, 451,
-	428, 370, 0, 376, 377, 0, 432, 438, 439, 414,
-	68, 75, 110, 455, 138, 95, 168, 443, 431, 0,
-	402, 446, 381, 394, 454, 395, 396, 424, 367, 410,
-	129, 392, 182, 89, 85, 67, 0, 384, 362, 389,
-	363, 382, 404, 91, 407, 380, 433, 413, 445, 109,
-	452, 111, 418, 0, 150, 120, 0, 0, 406, 435,
-	0, 408, 429, 401, 425, 372, 417, 447, 393, 422,
-	448, 0, 0, 0, 282, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 0, 0, 0, 420, 442, 391,
-	421, 423, 361, 419, 0, 365, 368, 453, 437, 387,
-	93, 128, 0, 0, 0, 0, 0, 0, 0, 405,
-	409, 426, 399, 0, 0, 0, 0, 0, 0, 0,
-	783, 0, 385, 0, 416, 0, 0, 0, 0, 0,
-	0, 369, 366, 0, 0, 403, 0, 0, 0, 371,
-	0, 386, 427, 0, 360, 98, 430, 436, 0, 400,
-	172, 440, 398, 397, 444, 136, 0, 153, 100, 108,
-	69, 76, 0, 99, 126, 141, 145, 434, 383, 390,
-	86, 388, 143, 131, 165, 415, 132, 142, 112, 158,
-	137, 441, 173, 174, 155, 171, 181, 70, 154, 164,
-	83, 146, 72, 162, 152, 118, 104, 105, 71, 0,
-	140, 90, 96, 88, 127, 159, 160, 87, 184, 77,
-	170, 74, 78, 169, 125, 157, 163, 119, 116, 73,
-	161, 117, 115, 107, 94, 101, 134, 114, 135, 102,
-	122, 121, 123, 0, 364, 0, 151, 167, 185, 80,
-	379, 147, 156, 175, 176, 177, 178, 179, 180, 0,
-	0, 81, 97, 92, 133, 124, 79, 103, 148, 106,
-	113, 139, 183, 130, 144, 84, 166, 149, 375, 378,
-	373, 374, 411, 412, 449, 450, 451, 428, 370, 0,
-	376, 377, 0, 432, 438, 439, 414, 68, 75, 110,
-	455, 138, 95, 168, 443, 431, 0, 402, 446, 381,
-	394, 454, 395, 396, 424, 367, 410, 129, 392, 182,
-	89, 85, 67, 0, 384, 362, 389, 363, 382, 404,
-	91, 407, 380, 433, 413, 445, 109, 452, 111, 418,
-	0, 150, 120, 0, 0, 406, 435, 0, 408, 429,
-	401, 425, 372, 417, 447, 393, 422, 448, 0, 0,
-	0, 207, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 0, 0, 0, 420, 442, 391, 421, 423, 361,
-	419, 0, 365, 368, 453, 437, 387, 93, 128, 0,
-	0, 0, 0, 0, 0, 0, 405, 409, 426, 399,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 385,
-	0, 416, 0, 0, 0, 0, 0, 0, 369, 366,
-	0, 0, 403, 0, 0, 0, 371, 0, 386, 427,
-	0, 360, 98, 430, 436, 0, 400, 172, 440, 398,
-	397, 444, 136, 0, 153, 100, 108, 69, 76, 0,
-	99, 126, 141, 145, 434, 383, 390, 86, 388, 143,
-	131, 165, 415, 132, 142, 112, 158, 137, 441, 173,
-	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
-	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
-	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
-	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
-	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
-	0, 364, 0, 151, 167, 185, 80, 379, 147, 156,
-	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
-	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
-	130, 144, 84, 166, 149, 375, 378, 373, 374, 411,
-	412, 449, 450, 451, 428, 370, 0, 376, 377, 0,
-	432, 438, 439, 414, 68, 75, 110, 455, 138, 95,
-	168, 443, 431, 0, 402, 446, 381, 394, 454, 395,
-	396, 424, 367, 410, 129, 392, 182, 89, 85, 67,
-	0, 384, 362, 389, 363, 382, 404, 91, 407, 380,
-	433, 413, 445, 109, 452, 111, 418, 0, 150, 120,
-	0, 0, 406, 435, 0, 408, 429, 401, 425, 372,
-	417, 447, 393, 422, 448, 0, 0, 0, 282, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 0, 0,
-	0, 420, 442, 391, 421, 423, 361, 419, 0, 365,
-	368, 453, 437, 387, 93, 128, 0, 0, 0, 0,
-	0, 0, 0, 405, 409, 426, 399, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 385, 0, 416, 0,
-	0, 0, 0, 0, 0, 369, 366, 0, 0, 403,
-	0, 0, 0, 371, 0, 386, 427, 0, 360, 98,
-	430, 436, 0, 400, 172, 440, 398, 397, 444, 136,
-	0, 153, 100, 108, 69, 76, 0, 99, 126, 141,
-	145, 434, 383, 390, 86, 388, 143, 131, 165, 415,
-	132, 142, 112, 158, 137, 441, 173, 174, 155, 171,
-	181, 70, 154, 164, 83, 146, 72, 162, 152, 118,
-	104, 105, 71, 0, 140, 90, 96, 88, 127, 159,
-	160, 87, 184, 77, 170, 74, 78, 169, 125, 157,
-	163, 119, 116, 73, 161, 117, 115, 107, 94, 101,
-	134, 114, 135, 102, 122, 121, 123, 0, 364, 0,
-	151, 167, 185, 80, 379, 147, 156, 175, 176, 177,
-	178, 179, 180, 0, 0, 81, 97, 92, 133, 124,
-	79, 103, 148, 106, 113, 139, 183, 130, 144, 84,
-	166, 149, 375, 378, 373, 374, 411, 412, 449, 450,
-	451, 428, 370, 0, 376, 377, 0, 432, 438, 439,
-	414, 68, 75, 110, 455, 138, 95, 168, 443, 431,
-	0, 402, 446, 381, 394, 454, 395, 396, 424, 367,
-	410, 129, 392, 182, 89, 85, 67, 0, 384, 362,
-	389, 363, 382, 404, 91, 407, 380, 433, 413, 445,
-	109, 452, 111, 418, 0, 150, 120, 0, 0, 406,
-	435, 0, 408, 429, 401, 425, 372, 417, 447, 393,
-	422, 448, 0, 0, 0, 207, 0, 0, 0, 0,
-	0, 0, 0, 0, 82, 0, 0, 0, 420, 442,
-	391, 421, 423, 361, 419, 0, 365, 368, 453, 437,
-	387, 93, 128, 0, 0, 0, 0, 0, 0, 0,
-	405, 409, 426, 399, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 385, 0, 416, 0, 0, 0, 0,
-	0, 0, 369, 366, 0, 0, 403, 0, 0, 0,
-	371, 0, 386, 427, 0, 360, 98, 430, 436, 0,
-	400, 172, 440, 398, 397, 444, 136, 0, 153, 100,
-	108, 69, 76, 0, 99, 126, 141, 145, 434, 383,
-	390, 86, 388, 143, 131, 165, 415, 132, 142, 112,
-	158, 137, 441, 173, 174, 155, 171, 181, 70, 154,
-	164, 83, 146, 72, 162, 152, 118, 104, 105, 71,
-	0, 140, 90, 96, 88, 127, 159, 160, 87, 184,
-	77, 170, 74, 358, 169, 125, 157, 163, 119, 116,
-	73, 161, 117, 115, 107, 94, 101, 134, 114, 135,
-	102, 122, 121, 123, 0, 364, 0, 151, 167, 185,
-	80, 379, 147, 156, 175, 176, 177, 178, 179, 180,
-	0, 0, 81, 97, 92, 133, 359, 357, 103, 148,
-	106, 113, 139, 183, 130, 144, 84, 166, 149, 375,
-	378, 373, 374, 411, 412, 449, 450, 451, 428, 370,
-	0, 376, 377, 0, 432, 438, 439, 414, 68, 75,
-	110, 455, 138, 95, 168, 443, 431, 0, 402, 446,
-	381, 394, 454, 395, 396, 424, 367, 410, 129, 392,
-	182, 89, 85, 67, 0, 384, 362, 389, 363, 382,
-	404, 91, 407, 380, 433, 413, 445, 109, 452, 111,
-	418, 0, 150, 120, 0, 0, 406, 435, 0, 408,
-	429, 401, 425, 372, 417, 447, 393, 422, 448, 0,
-	0, 0, 65, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 0, 0, 0, 420, 442, 391, 421, 423,
-	361, 419, 0, 365, 368, 453, 437, 387, 93, 128,
-	0, 0, 0, 0, 0, 0, 0, 405, 409, 426,
-	399, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	385, 0, 416, 0, 0, 0, 0, 0, 0, 369,
-	366, 0, 0, 403, 0, 0, 0, 371, 0, 386,
-	427, 0, 360, 98, 430, 436, 0, 400, 172, 440,
-	398, 397, 444, 136, 0, 153, 100, 108, 69, 76,
-	0, 99, 126, 141, 145, 434, 383, 390, 86, 388,
-	143, 131, 165, 415, 132, 142, 112, 158, 137, 441,
-	173, 174, 155, 171, 181, 70, 154, 164, 83, 146,
-	72, 162, 152, 118, 104, 105, 71, 0, 140, 90,
-	96, 88, 127, 159, 160, 87, 184, 77, 170, 74,
-	78, 169, 125, 157, 163, 119, 116, 73, 161, 117,
-	115, 107, 94, 101, 134, 114, 135, 102, 122, 121,
-	123, 0, 364, 0, 151, 167, 185, 80, 379, 147,
-	156, 175, 176, 177, 178, 179, 180, 0, 0, 81,
-	97, 92, 133, 124, 79, 103, 148, 106, 113, 139,
-	183, 130, 144, 84, 166, 149, 375, 378, 373, 374,
-	411, 412, 449, 450, 451, 428, 370, 0, 376, 377,
-	0, 432, 438, 439, 414, 68, 75, 110, 455, 138,
-	95, 168, 443, 431, 0, 402, 446, 381, 394, 454,
-	395, 396, 424, 367, 410, 129, 392, 182, 89, 85,
-	67, 0, 384, 362, 389, 363, 382, 404, 91, 407,
-	380, 433, 413, 445, 109, 452, 111, 418, 0, 150,
-	120, 0, 0, 406, 435, 0, 408, 429, 401, 425,
-	372, 417, 447, 393, 422, 448, 0, 0, 0, 207,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 0,
-	0, 0, 420, 442, 391, 421, 423, 361, 419, 0,
-	365, 368, 453, 437, 387, 93, 128, 0, 0, 0,
-	0, 0, 0, 0, 405, 409, 426, 399, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 385, 0, 416,
-	0, 0, 0, 0, 0, 0, 369, 366, 0, 0,
-	403, 0, 0, 0, 371, 0, 386, 427, 0, 360,
-	98, 430, 436, 0, 400, 172, 440, 398, 397, 444,
-	136, 0, 153, 100, 108, 69, 76, 0, 99, 126,
-	141, 145, 434, 383, 390, 86, 388, 143, 131, 165,
-	415, 132, 142, 112, 158, 137, 441, 173, 174, 155,
-	171, 181, 70, 154, 652, 83, 146, 72, 162, 152,
-	118, 104, 105, 71, 0, 140, 90, 96, 88, 127,
-	159, 160, 87, 184, 77, 170, 74, 358, 169, 125,
-	157, 163, 119, 116, 73, 161, 117, 115, 107, 94,
-	101, 134, 114, 135, 102, 122, 121, 123, 0, 364,
-	0, 151, 167, 185, 80, 379, 147, 156, 175, 176,
-	177, 178, 179, 180, 0, 0, 81, 97, 92, 133,
-	359, 357, 103, 148, 106, 113, 139, 183, 130, 144,
-	84, 166, 149, 375, 378, 373, 374, 411, 412, 449,
-	450, 451, 428, 370, 0, 376, 377, 0, 432, 438,
-	439, 414, 68, 75, 110, 455, 138, 95, 168, 443,
-	431, 0, 402, 446, 381, 394, 454, 395, 396, 424,
-	367, 410, 129, 392, 182, 89, 85, 67, 0, 384,
-	362, 389, 363, 382, 404, 91, 407, 380, 433, 413,
-	445, 109, 452, 111, 418, 0, 150, 120, 0, 0,
-	406, 435, 0, 408, 429, 401, 425, 372, 417, 447,
-	393, 422, 448, 0, 0, 0, 207, 0, 0, 0,
-	0, 0, 0, 0, 0, 82, 0, 0, 0, 420,
-	442, 391, 421, 423, 361, 419, 0, 365, 368, 453,
-	437, 387, 93, 128, 0, 0, 0, 0, 0, 0,
-	0, 405, 409, 426, 399, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 385, 0, 416, 0, 0, 0,
-	0, 0, 0, 369, 366, 0, 0, 403, 0, 0,
-	0, 371, 0, 386, 427, 0, 360, 98, 430, 436,
-	0, 400, 172, 440, 398, 397, 444, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 434,
-	383, 390, 86, 388, 143, 131, 165, 415, 132, 142,
-	112, 158, 137, 441, 173, 174, 155, 171, 181, 70,
-	154, 349, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
-	184, 77, 170, 74, 358, 169, 125, 157, 163, 119,
-	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 364, 0, 151, 167,
-	185, 80, 379, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 359, 357, 352,
-	351, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	375, 378, 373, 374, 411, 412, 449, 450, 451, 428,
-	370, 0, 376, 377, 0, 432, 438, 439, 414, 68,
-	75, 110, 455, 138, 95, 168, 129, 0, 182, 89,
-	85, 67, 0, 0, 0, 284, 0, 0, 0, 91,
-	0, 281, 0, 0, 0, 109, 324, 111, 0, 0,
-	150, 120, 0, 0, 0, 0, 0, 315, 316, 0,
-	0, 0, 0, 0, 0, 0, 0, 55, 0, 0,
-	282, 303, 302, 305, 306, 307, 308, 0, 0, 82,
-	304, 0, 0, 309, 310, 311, 0, 0, 0, 279,
-	296, 0, 323, 0, 0, 0, 93, 128, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 293, 294, 0, 0, 0, 0,
-	337, 0, 295, 0, 0, 0, 0, 0, 290, 291,
-	292, 297, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 98, 0, 1303, 1304, 0, 172, 0, 0, 335,
-	0, 136, 0, 153, 100, 108, 69, 76, 0, 99,
-	126, 141, 145, 0, 0, 0, 86, 0, 143, 131,
-	165, 0, 132, 142, 112, 158, 137, 0, 173, 174,
-	155, 171, 181, 70, 154, 164, 83, 146, 72, 162,
-	152, 118, 104, 105, 71, 0, 140, 90, 96, 88,
-	127, 159, 160, 87, 184, 77, 170, 74, 78, 169,
-	125, 157, 163, 119, 116, 73, 161, 117, 115, 107,
-	94, 101, 134, 114, 135, 102, 122, 121, 123, 0,
-	0, 0, 151, 167, 185, 80, 0, 147, 156, 175,
-	176, 177, 178, 179, 180, 0, 0, 81, 97, 92,
-	133, 124, 79, 103, 148, 106, 113, 139, 183, 130,
-	144, 84, 166, 149, 325, 336, 331, 332, 329, 330,
-	328, 327, 326, 338, 317, 318, 319, 320, 322, 0,
-	333, 334, 321, 68, 75, 110, 0, 138, 95, 168,
-	129, 0, 182, 89, 85, 67, 0, 0, 0, 284,
-	0, 0, 0, 91, 0, 281, 0, 0, 0, 109,
-	324, 111, 0, 0, 150, 120, 0, 0, 0, 0,
-	0, 315, 316, 0, 0, 0, 0, 0, 0, 899,
-	0, 55, 0, 0, 282, 303, 302, 305, 306, 307,
-	308, 0, 0, 82, 304, 0, 0, 309, 310, 311,
-	900, 0, 0, 279, 296, 0, 323, 0, 0, 0,
-	93, 128, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 293, 294,
-	0, 0, 0, 0, 337, 0, 295, 0, 0, 0,
-	0, 0, 290, 291, 292, 297, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 98, 0, 0, 0, 0,
-	172, 0, 0, 335, 0, 136, 0, 153, 100, 108,
-	69, 76, 0, 99, 126, 141, 145, 0, 0, 0,
-	86, 0, 143, 131, 165, 0, 132, 142, 112, 158,
-	137, 0, 173, 174, 155, 171, 181, 70, 154, 164,
-	83, 146, 72, 162, 152, 118, 104, 105, 71, 0,
-	140, 90, 96, 88, 127, 159, 160, 87, 184, 77,
-	170, 74, 78, 169, 125, 157, 163, 119, 116, 73,
-	161, 117, 115, 107, 94, 101, 134, 114, 135, 102,
-	122, 121, 123, 0, 0, 0, 151, 167, 185, 80,
-	0, 147, 156, 175, 176, 177, 178, 179, 180, 0,
-	0, 81, 97, 92, 133, 124, 79, 103, 148, 106,
-	113, 139, 183, 130, 144, 84, 166, 149, 325, 336,
-	331, 332, 329, 330, 328, 327, 326, 338, 317, 318,
-	319, 320, 322, 25, 333, 334, 321, 68, 75, 110,
-	0, 138, 95, 168, 0, 129, 0, 182, 89, 85,
-	67, 0, 0, 0, 284, 0, 0, 0, 91, 0,
-	281, 0, 0, 0, 109, 324, 111, 0, 0, 150,
-	120, 0, 0, 0, 0, 0, 315, 316, 0, 0,
-	0, 0, 0, 0, 0, 0, 55, 0, 0, 282,
-	303, 302, 305, 306, 307, 308, 0, 0, 82, 304,
-	0, 0, 309, 310, 311, 0, 0, 0, 279, 296,
-	0, 323, 0, 0, 0, 93, 128, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 293, 294, 0, 0, 0, 0, 337,
-	0, 295, 0, 0, 0, 0, 0, 290, 291, 292,
-	297, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	98, 0, 0, 0, 0, 172, 0, 0, 335, 0,
-	136, 0, 153, 100, 108, 69, 76, 0, 99, 126,
-	141, 145, 0, 0, 0, 86, 0, 143, 131, 165,
-	0, 132, 142, 112, 158, 137, 0, 173, 174, 155,
-	171, 181, 70, 154, 164, 83, 146, 72, 162, 152,
-	118, 104, 105, 71, 0, 140, 90, 96, 88, 127,
-	159, 160, 87, 184, 77, 170, 74, 78, 169, 125,
-	157, 163, 119, 116, 73, 161, 117, 115, 107, 94,
-	101, 134, 114, 135, 102, 122, 121, 123, 0, 0,
-	0, 151, 167, 185, 80, 0, 147, 156, 175, 176,
-	177, 178, 179, 180, 0, 0, 81, 97, 92, 133,
-	124, 79, 103, 148, 106, 113, 139, 183, 130, 144,
-	84, 166, 149, 325, 336, 331, 332, 329, 330, 328,
-	327, 326, 338, 317, 318, 319, 320, 322, 0, 333,
-	334, 321, 68, 75, 110, 23, 138, 95, 168, 129,
-	0, 182, 89, 85, 67, 0, 828, 0, 284, 0,
-	0, 0, 91, 0, 281, 0, 0, 0, 109, 324,
-	111, 0, 0, 150, 120, 0, 0, 0, 0, 0,
-	315, 316, 0, 0, 0, 0, 0, 0, 0, 0,
-	55, 0, 0, 282, 303, 302, 305, 306, 307, 308,
-	0, 0, 82, 304, 0, 0, 309, 310, 311, 0,
-	0, 0, 279, 296, 0, 323, 0, 0, 0, 93,
-	128, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 293, 294, 275,
-	0, 0, 0, 337, 0, 295, 0, 0, 0, 0,
-	0, 290, 291, 292, 297, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 98, 0, 0, 0, 0, 172,
-	0, 0, 335, 0, 136, 0, 153, 100, 108, 69,
-	76, 0, 99, 126, 141, 145, 0, 0, 0, 86,
-	0, 143, 131, 165, 0, 132, 142, 112, 158, 137,
-	0, 173, 174, 155, 171, 181, 70, 154, 164, 83,
-	146, 72, 162, 152, 118, 104, 105, 71, 0, 140,
-	90, 96, 88, 127, 159, 160, 87, 184, 77, 170,
-	74, 78, 169, 125, 157, 163, 119, 116, 73, 161,
-	117, 115, 107, 94, 101, 134, 114, 135, 102, 122,
-	121, 123, 0, 0, 0, 151, 167, 185, 80, 0,
-	147, 156, 175, 176, 177, 178, 179, 180, 0, 0,
-	81, 97, 92, 133, 124, 79, 103, 148, 106, 113,
-	139, 183, 130, 144, 84, 166, 149, 325, 336, 331,
-	332, 329, 330, 328, 327, 326, 338, 317, 318, 319,
-	320, 322, 0, 333, 334, 321, 68, 75, 110, 0,
-	138, 95, 168, 129, 0, 182, 89, 85, 67, 0,
-	0, 0, 284, 0, 0, 0, 91, 0, 281, 0,
-	0, 0, 109, 324, 111, 0, 0, 150, 120, 0,
-	0, 0, 0, 0, 315, 316, 0, 0, 0, 0,
-	0, 0, 0, 0, 55, 0, 516, 282, 303, 302,
-	305, 306, 307, 308, 0, 0, 82, 304, 0, 0,
-	309, 310, 311, 0, 0, 0, 279, 296, 0, 323,
-	0, 0, 0, 93, 128, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 293, 294, 0, 0, 0, 0, 337, 0, 295,
-	0, 0, 0, 0, 0, 290, 291, 292, 297, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 98, 0,
-	0, 0, 0, 172, 0, 0, 335, 0, 136, 0,
-	153, 100, 108, 69, 76, 0, 99, 126, 141, 145,
-	0, 0, 0, 86, 0, 143, 131, 165, 0, 132,
-	142, 112, 158, 137, 0, 173, 174, 155, 171, 181,
-	70, 154, 164, 83, 146, 72, 162, 152, 118, 104,
-	105, 71, 0, 140, 90, 96, 88, 127, 159, 160,
-	87, 184, 77, 170, 74, 78, 169, 125, 157, 163,
-	119, 116, 73, 161, 117, 115, 107, 94, 101, 134,
-	114, 135, 102, 122, 121, 123, 0, 0, 0, 151,
-	167, 185, 80, 0, 147, 156, 175, 176, 177, 178,
-	179, 180, 0, 0, 81, 97, 92, 133, 124, 79,
-	103, 148, 106, 113, 139, 183, 130, 144, 84, 166,
-	149, 325, 336, 331, 332, 329, 330, 328, 327, 326,
-	338, 317, 318, 319, 320, 322, 0, 333, 334, 321,
-	68, 75, 110, 0, 138, 95, 168, 129, 0, 182,
-	89, 85, 67, 0, 0, 0, 284, 0, 0, 0,
-	91, 0, 281, 0, 0, 0, 109, 324, 111, 0,
-	0, 150, 120, 0, 0, 0, 0, 0, 315, 316,
-	0, 0, 0, 0, 0, 0, 0, 0, 55, 0,
-	0, 282, 303, 302, 305, 306, 307, 308, 0, 0,
-	82, 304, 0, 0, 309, 310, 311, 0, 0, 0,
-	279, 296, 0, 323, 0, 0, 0, 93, 128, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 293, 294, 275, 0, 0,
-	0, 337, 0, 295, 0, 0, 0, 0, 0, 290,
-	291, 292, 297, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 98, 0, 0, 0, 0, 172, 0, 0,
-	335, 0, 136, 0, 153, 100, 108, 69, 76, 0,
-	99, 126, 141, 145, 0, 0, 0, 86, 0, 143,
-	131, 165, 0, 132, 142, 112, 158, 137, 0, 173,
-	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
-	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
-	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
-	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
-	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
-	0, 0, 0, 151, 167, 185, 80, 0, 147, 156,
-	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
-	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
-	130, 144, 84, 166, 149, 325, 336, 331, 332, 329,
-	330, 328, 327, 326, 338, 317, 318, 319, 320, 322,
-	0, 333, 334, 321, 68, 75, 110, 0, 138, 95,
-	168, 129, 0, 182, 89, 85, 67, 0, 0, 0,
-	284, 0, 0, 0, 91, 0, 281, 0, 0, 0,
-	109, 324, 111, 0, 0, 150, 120, 0, 0, 0,
-	0, 0, 315, 316, 0, 0, 0, 0, 0, 0,
-	0, 0, 55, 0, 0, 282, 303, 843, 305, 306,
-	307, 308, 0, 0, 82, 304, 0, 0, 309, 310,
-	311, 0, 0, 0, 279, 296, 0, 323, 0, 0,
-	0, 93, 128, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 293,
-	294, 275, 0, 0, 0, 337, 0, 295, 0, 0,
-	0, 0, 0, 290, 291, 292, 297, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 98, 0, 0, 0,
-	0, 172, 0, 0, 335, 0, 136, 0, 153, 100,
-	108, 69, 76, 0, 99, 126, 141, 145, 0, 0,
-	0, 86, 0, 143, 131, 165, 0, 132, 142, 112,
-	158, 137, 0, 173, 174, 155, 171, 181, 70, 154,
-	164, 83, 146, 72, 162, 152, 118, 104, 105, 71,
-	0, 140, 90, 96, 88, 127, 159, 160, 87, 184,
-	77, 170, 74, 78, 169, 125, 157, 163, 119, 116,
-	73, 161, 117, 115, 107, 94, 101, 134, 114, 135,
-	102, 122, 121, 123, 0, 0, 0, 151, 167, 185,
-	80, 0, 147, 156, 175, 176, 177, 178, 179, 180,
-	0, 0, 81, 97, 92, 133, 124, 79, 103, 148,
-	106, 113, 139, 183, 130, 144, 84, 166, 149, 325,
-	336, 331, 332, 329, 330, 328, 327, 326, 338, 317,
-	318, 319, 320, 322, 0, 333, 334, 321, 68, 75,
-	110, 0, 138, 95, 168, 129, 0, 182, 89, 85,
-	67, 0, 0, 0, 284, 0, 0, 0, 91, 0,
-	281, 0, 0, 0, 109, 324, 111, 0, 0, 150,
-	120, 0, 0, 0, 0, 0, 315, 316, 0, 0,
-	0, 0, 0, 0, 0, 0, 55, 0, 0, 282,
-	303, 840, 305, 306, 307, 308, 0, 0, 82, 304,
-	0, 0, 309, 310, 311, 0, 0, 0, 279, 296,
-	0, 323, 0, 0, 0, 93, 128, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 293, 294, 275, 0, 0, 0, 337,
-	0, 295, 0, 0, 0, 0, 0, 290, 291, 292,
-	297, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	98, 0, 0, 0, 0, 172, 0, 0, 335, 0,
-	136, 0, 153, 100, 108, 69, 76, 0, 99, 126,
-	141, 145, 0, 0, 0, 86, 0, 143, 131, 165,
-	0, 132, 142, 112, 158, 137, 0, 173, 174, 155,
-	171, 181, 70, 154, 164, 83, 146, 72, 162, 152,
-	118, 104, 105, 71, 0, 140, 90, 96, 88, 127,
-	159, 160, 87, 184, 77, 170, 74, 78, 169, 125,
-	157, 163, 119, 116, 73, 161, 117, 115, 107, 94,
-	101, 134, 114, 135, 102, 122, 121, 123, 0, 0,
-	0, 151, 167, 185, 80, 0, 147, 156, 175, 176,
-	177, 178, 179, 180, 0, 0, 81, 97, 92, 133,
-	124, 79, 103, 148, 106, 113, 139, 183, 130, 144,
-	84, 166, 149, 325, 336, 331, 332, 329, 330, 328,
-	327, 326, 338, 317, 318, 319, 320, 322, 0, 333,
-	334, 321, 68, 75, 110, 0, 138, 95, 168, 129,
-	0, 182, 89, 85, 67, 0, 0, 0, 284, 0,
-	0, 0, 91, 0, 281, 0, 0, 0, 109, 324,
-	111, 0, 0, 150, 120, 0, 0, 0, 0, 0,
-	315, 316, 0, 0, 0, 0, 0, 0, 0, 0,
-	55, 0, 0, 282, 303, 302, 305, 306, 307, 308,
-	0, 0, 82, 304, 0, 0, 309, 310, 311, 0,
-	0, 0, 279, 296, 0, 323, 0, 0, 0, 93,
-	128, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 293, 294, 0,
-	0, 0, 0, 337, 0, 295, 0, 0, 0, 0,
-	0, 290, 291, 292, 297, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 98, 0, 0, 0, 0, 172,
-	0, 0, 335, 0, 136, 0, 153, 100, 108, 69,
-	76, 0, 99, 126, 141, 145, 0, 0, 0, 86,
-	0, 143, 131, 165, 0, 132, 142, 112, 158, 137,
-	0, 173, 174, 155, 171, 181, 70, 154, 164, 83,
-	146, 72, 162, 152, 118, 104, 105, 71, 0, 140,
-	90, 96, 88, 127, 159, 160, 87, 184, 77, 170,
-	74, 78, 169, 125, 157, 163, 119, 116, 73, 161,
-	117, 115, 107, 94, 101, 134, 114, 135, 102, 122,
-	121, 123, 0, 0, 0, 151, 167, 185, 80, 0,
-	147, 156, 175, 176, 177, 178, 179, 180, 0, 0,
-	81, 97, 92, 133, 124, 79, 103, 148, 106, 113,
-	139, 183, 130, 144, 84, 166, 149, 325, 336, 331,
-	332, 329, 330, 328, 327, 326, 338, 317, 318, 319,
-	320, 322, 0, 333, 334, 321, 68, 75, 110, 0,
-	138, 95, 168, 129, 0, 182, 89, 85, 67, 0,
-	0, 0, 0, 0, 0, 0, 91, 0, 0, 0,
-	0, 0, 109, 324, 111, 0, 0, 150, 120, 0,
-	0, 0, 0, 0, 315, 316, 0, 0, 0, 0,
-	0, 0, 0, 0, 55, 0, 0, 282, 303, 302,
-	305, 306, 307, 308, 0, 0, 82, 304, 0, 0,
-	309, 310, 311, 0, 0, 0, 0, 296, 0, 323,
-	0, 0, 0, 93, 128, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 293, 294, 0, 0, 0, 0, 337, 0, 295,
-	0, 0, 0, 0, 0, 290, 291, 292, 297, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 98, 0,
-	0, 0, 0, 172, 0, 0, 335, 0, 136, 0,
-	153, 100, 108, 69, 76, 0, 99, 126, 141, 145,
-	0, 0, 0, 86, 0, 143, 131, 165, 1476, 132,
-	142, 112, 158, 137, 0, 173, 174, 155, 171, 181,
-	70, 154, 164, 83, 146, 72, 162, 152, 118, 104,
-	105, 71, 0, 140, 90, 96, 88, 127, 159, 160,
-	87, 184, 77, 170, 74, 78, 169, 125, 157, 163,
-	119, 116, 73, 161, 117, 115, 107, 94, 101, 134,
-	114, 135, 102, 122, 121, 123, 0, 0, 0, 151,
-	167, 185, 80, 0, 147, 156, 175, 176, 177, 178,
-	179, 180, 0, 0, 81, 97, 92, 133, 124, 79,
-	103, 148, 106, 113, 139, 183, 130, 144, 84, 166,
-	149, 325, 336, 331, 332, 329, 330, 328, 327, 326,
-	338, 317, 318, 319, 320, 322, 0, 333, 334, 321,
-	68, 75, 110, 0, 138, 95, 168, 129, 0, 182,
-	89, 85, 67, 0, 0, 0, 0, 0, 0, 0,
-	91, 0, 0, 0, 0, 0, 109, 324, 111, 0,
-	0, 150, 120, 0, 0, 0, 0, 0, 315, 316,
-	0, 0, 0, 0, 0, 0, 0, 0, 55, 0,
-	516, 282, 303, 302, 305, 306, 307, 308, 0, 0,
-	82, 304, 0, 0, 309, 310, 311, 0, 0, 0,
-	0, 296, 0, 323, 0, 0, 0, 93, 128, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 293, 294, 0, 0, 0,
-	0, 337, 0, 295, 0, 0, 0, 0, 0, 290,
-	291, 292, 297, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 98, 0, 0, 0, 0, 172, 0, 0,
-	335, 0, 136, 0, 153, 100, 108, 69, 76, 0,
-	99, 126, 141, 145, 0, 0, 0, 86, 0, 143,
-	131, 165, 0, 132, 142, 112, 158, 137, 0, 173,
-	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
-	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
-	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
-	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
-	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
-	0, 0, 0, 151, 167, 185, 80, 0, 147, 156,
-	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
-	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
-	130, 144, 84, 166, 149, 325, 336, 331, 332, 329,
-	330, 328, 327, 326, 338, 317, 318, 319, 320, 322,
-	0, 333, 334, 321, 68, 75, 110, 0, 138, 95,
-	168, 129, 0, 182, 89, 85, 67, 0, 0, 0,
-	0, 0, 0, 0, 91, 0, 0, 0, 0, 0,
-	109, 324, 111, 0, 0, 150, 120, 0, 0, 0,
-	0, 0, 315, 316, 0, 0, 0, 0, 0, 0,
-	0, 0, 55, 0, 0, 282, 303, 302, 305, 306,
-	307, 308, 0, 0, 82, 304, 0, 0, 309, 310,
-	311, 0, 0, 0, 0, 296, 0, 323, 0, 0,
-	0, 93, 128, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 293,
-	294, 0, 0, 0, 0, 337, 0, 295, 0, 0,
-	0, 0, 0, 290, 291, 292, 297, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 98, 0, 0, 0,
-	0, 172, 0, 0, 335, 0, 136, 0, 153, 100,
-	108, 69, 76, 0, 99, 126, 141, 145, 0, 0,
-	0, 86, 0, 143, 131, 165, 0, 132, 142, 112,
-	158, 137, 0, 173, 174, 155, 171, 181, 70, 154,
-	164, 83, 146, 72, 162, 152, 118, 104, 105, 71,
-	0, 140, 90, 96, 88, 127, 159, 160, 87, 184,
-	77, 170, 74, 78, 169, 125, 157, 163, 119, 116,
-	73, 161, 117, 115, 107, 94, 101, 134, 114, 135,
-	102, 122, 121, 123, 0, 0, 0, 151, 167, 185,
-	80, 0, 147, 156, 175, 176, 177, 178, 179, 180,
-	0, 0, 81, 97, 92, 133, 124, 79, 103, 148,
-	106, 113, 139, 183, 130, 144, 84, 166, 149, 325,
-	336, 331, 332, 329, 330, 328, 327, 326, 338, 317,
-	318, 319, 320, 322, 0, 333, 334, 321, 68, 75,
-	110, 0, 138, 95, 168, 129, 0, 182, 89, 85,
-	67, 0, 0, 0, 0, 0, 0, 0, 91, 0,
-	0, 0, 0, 0, 109, 0, 111, 0, 0, 150,
-	120, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 207,
-	0, 0, 0, 0, 0, 0, 570, 0, 82, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 93, 128, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 557,
-	556, 566, 567, 559, 560, 561, 562, 563, 564, 565,
-	558, 0, 0, 0, 0, 0, 568, 0, 0, 0,
-	0, 0, 571, 0, 0, 0, 0, 0, 0, 0,
-	98, 0, 0, 0, 0, 172, 0, 0, 0, 0,
-	136, 0, 153, 100, 108, 69, 76, 0, 99, 126,
-	141, 145, 0, 0, 0, 86, 0, 143, 131, 165,
-	0, 132, 142, 112, 158, 137, 0, 173, 174, 155,
-	171, 181, 70, 154, 164, 83, 146, 72, 162, 152,
-	118, 104, 105, 71, 0, 140, 90, 96, 88, 127,
-	159, 160, 87, 184, 77, 170, 74, 78, 169, 125,
-	157, 163, 119, 116, 73, 161, 117, 115, 107, 94,
-	101, 134, 114, 135, 102, 122, 121, 123, 0, 0,
-	0, 151, 167, 185, 80, 0, 147, 156, 175, 176,
-	177, 178, 179, 180, 0, 0, 81, 97, 92, 133,
-	124, 79, 103, 148, 106, 113, 139, 183, 130, 144,
-	84, 166, 149, 0, 25, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 129, 0, 182, 89,
-	85, 67, 68, 75, 110, 0, 138, 95, 168, 91,
-	569, 0, 0, 0, 0, 109, 0, 111, 0, 0,
-	150, 120, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 55, 0, 0,
-	207, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 93, 128, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 98, 0, 0, 0, 0, 172, 0, 0, 0,
-	0, 136, 0, 153, 100, 108, 69, 76, 0, 99,
-	126, 141, 145, 0, 0, 0, 86, 0, 143, 131,
-	165, 0, 132, 142, 112, 158, 137, 0, 173, 174,
-	155, 171, 181, 70, 154, 164, 83, 146, 72, 162,
-	152, 118, 104, 105, 71, 0, 140, 90, 96, 88,
-	127, 159, 160, 87, 184, 77, 170, 74, 78, 169,
-	125, 157, 163, 119, 116, 73, 161, 117, 115, 107,
-	94, 101, 134, 114, 135, 102, 122, 121, 123, 0,
-	0, 0, 151, 167, 185, 80, 0, 147, 156, 175,
-	176, 177, 178, 179, 180, 0, 0, 81, 97, 92,
-	133, 124, 79, 103, 148, 106, 113, 139, 183, 130,
-	144, 84, 166, 149, 0, 25, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 0, 182,
-	89, 85, 67, 68, 75, 110, 23, 138, 95, 168,
-	91, 0, 0, 0, 0, 0, 109, 0, 111, 0,
-	0, 150, 120, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 55, 0,
-	0, 639, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 93, 128, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 98, 0, 0, 0, 0, 172, 0, 0,
-	0, 0, 136, 0, 153, 100, 108, 69, 76, 0,
-	99, 126, 141, 145, 0, 0, 0, 86, 0, 143,
-	131, 165, 0, 132, 142, 112, 158, 137, 0, 173,
-	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
-	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
-	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
-	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
-	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
-	0, 0, 0, 151, 167, 185, 80, 0, 147, 156,
-	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
-	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
-	130, 144, 84, 166, 149, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 68, 75, 110, 23, 138, 95,
-	168, 129, 0, 182, 89, 85, 67, 0, 0, 884,
-	0, 0, 0, 0, 91, 0, 0, 0, 0, 0,
-	109, 0, 111, 0, 0, 150, 120, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 65, 0, 64, 0, 0,
-	0, 0, 0, 0, 82, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 93, 128, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 98, 0, 0, 0,
-	0, 172, 0, 0, 0, 0, 136, 0, 153, 100,
-	108, 69, 76, 0, 99, 126, 141, 145, 0, 0,
-	0, 86, 0, 143, 131, 165, 0, 132, 142, 112,
-	158, 137, 0, 173, 174, 155, 171, 181, 70, 154,
-	164, 83, 146, 72, 162, 152, 118, 104, 105, 71,
-	0, 140, 90, 96, 88, 127, 159, 160, 87, 184,
-	77, 170, 74, 78, 169, 125, 157, 163, 119, 116,
-	73, 161, 117, 115, 107, 94, 101, 134, 114, 135,
-	102, 122, 121, 123, 0, 0, 0, 151, 167, 185,
-	80, 0, 147, 156, 175, 176, 177, 178, 179, 180,
-	0, 0, 81, 97, 92, 133, 124, 79, 103, 148,
-	106, 113, 139, 183, 130, 144, 84, 166, 149, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 129, 0,
-	182, 89, 85, 67, 0, 0, 0, 0, 68, 75,
-	110, 91, 138, 95, 168, 0, 0, 109, 0, 111,
-	0, 0, 150, 120, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 820, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 0, 822, 823, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 93, 128,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 98, 0, 0, 0, 0, 172, 0,
-	0, 0, 0, 136, 0, 153, 100, 108, 69, 76,
-	0, 99, 126, 141, 145, 0, 0, 0, 86, 0,
-	143, 131, 165, 0, 132, 142, 112, 158, 137, 0,
-	173, 174, 155, 171, 181, 70, 154, 164, 83, 146,
-	72, 162, 152, 118, 104, 105, 71, 0, 140, 90,
-	96, 88, 127, 159, 160, 87, 184, 77, 170, 74,
-	78, 169, 125, 157, 163, 119, 116, 73, 161, 117,
-	115, 107, 94, 101, 134, 114, 135, 102, 122, 121,
-	123, 0, 0, 0, 151, 167, 185, 80, 0, 147,
-	156, 175, 176, 177, 178, 179, 180, 0, 0, 81,
-	97, 92, 133, 124, 79, 103, 148, 106, 113, 139,
-	183, 130, 144, 84, 166, 149, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 0, 182, 89, 85,
-	67, 0, 0, 884, 0, 68, 75, 110, 91, 138,
-	95, 168, 0, 0, 109, 0, 111, 0, 0, 150,
-	120, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 65,
-	0, 64, 0, 0, 0, 0, 0, 0, 82, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 93, 128, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	98, 0, 0, 0, 0, 172, 0, 0, 0, 0,
-	136, 0, 153, 100, 108, 69, 76, 0, 99, 126,
-	141, 145, 0, 0, 0, 86, 0, 143, 131, 165,
-	0, 882, 142, 112, 158, 137, 0, 173, 174, 155,
-	171, 181, 70, 154, 164, 83, 146, 72, 162, 152,
-	118, 104, 105, 71, 0, 140, 90, 96, 88, 127,
-	159, 160, 87, 184, 77, 170, 74, 78, 169, 125,
-	157, 163, 119, 116, 73, 161, 117, 115, 107, 94,
-	101, 134, 114, 135, 102, 122, 121, 123, 0, 0,
-	0, 151, 167, 185, 80, 0, 147, 156, 175, 176,
-	177, 178, 179, 180, 0, 0, 81, 97, 92, 133,
-	124, 79, 103, 148, 106, 113, 139, 183, 130, 144,
-	84, 166, 149, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 129, 0, 182, 89, 85, 67, 0, 0,
-	0, 0, 68, 75, 110, 91, 138, 95, 168, 0,
-	0, 109, 0, 111, 0, 0, 150, 120, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 207, 0, 0, 770,
-	0, 0, 771, 0, 0, 82, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 93, 128, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 98, 0, 0,
-	0, 0, 172, 0, 0, 0, 0, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 0,
-	0, 0, 86, 0, 143, 131, 165, 0, 132, 142,
-	112, 158, 137, 0, 173, 174, 155, 171, 181, 70,
-	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
-	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
-	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 0, 0, 151, 167,
-	185, 80, 0, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
-	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 129, 0, 182, 89, 85, 67, 68,
-	75, 110, 0, 138, 95, 168, 91, 0, 661, 0,
-	0, 0, 109, 0, 111, 0, 0, 150, 120, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 207, 0, 660,
-	0, 0, 0, 0, 0, 0, 82, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 93, 128, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 98, 0,
-	0, 0, 0, 172, 0, 0, 0, 0, 136, 0,
-	153, 100, 108, 69, 76, 0, 99, 126, 141, 145,
-	0, 0, 0, 86, 0, 143, 131, 165, 0, 132,
-	142, 112, 158, 137, 0, 173, 174, 155, 171, 181,
-	70, 154, 164, 83, 146, 72, 162, 152, 118, 104,
-	105, 71, 0, 140, 90, 96, 88, 127, 159, 160,
-	87, 184, 77, 170, 74, 78, 169, 125, 157, 163,
-	119, 116, 73, 161, 117, 115, 107, 94, 101, 134,
-	114, 135, 102, 122, 121, 123, 0, 0, 0, 151,
-	167, 185, 80, 0, 147, 156, 175, 176, 177, 178,
-	179, 180, 0, 0, 81, 97, 92, 133, 124, 79,
-	103, 148, 106, 113, 139, 183, 130, 144, 84, 166,
-	149, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	129, 0, 182, 89, 85, 67, 0, 0, 0, 0,
-	68, 75, 110, 91, 138, 95, 168, 0, 0, 109,
-	0, 111, 0, 0, 150, 120, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 55, 0, 0, 639, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	93, 128, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 98, 0, 0, 0, 0,
-	172, 0, 0, 0, 0, 136, 0, 153, 100, 108,
-	69, 76, 0, 99, 126, 141, 145, 0, 0, 0,
-	86, 0, 143, 131, 165, 0, 132, 142, 112, 158,
-	137, 0, 173, 174, 155, 171, 181, 70, 154, 164,
-	83, 146, 72, 162, 152, 118, 104, 105, 71, 0,
-	140, 90, 96, 88, 127, 159, 160, 87, 184, 77,
-	170, 74, 78, 169, 125, 157, 163, 119, 116, 73,
-	161, 117, 115, 107, 94, 101, 134, 114, 135, 102,
-	122, 121, 123, 0, 0, 0, 151, 167, 185, 80,
-	0, 147, 156, 175, 176, 177, 178, 179, 180, 0,
-	0, 81, 97, 92, 133, 124, 79, 103, 148, 106,
-	113, 139, 183, 130, 144, 84, 166, 149, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 0, 182,
-	89, 85, 67, 0, 0, 0, 0, 68, 75, 110,
-	91, 138, 95, 168, 0, 0, 109, 0, 111, 0,
-	0, 150, 120, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 65, 0, 64, 0, 0, 0, 0, 0, 0,
-	82, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 93, 128, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 98, 0, 0, 0, 0, 172, 0, 0,
-	0, 0, 136, 0, 153, 100, 108, 69, 76, 0,
-	99, 126, 141, 145, 0, 0, 0, 86, 0, 143,
-	131, 165, 0, 132, 142, 112, 158, 137, 0, 173,
-	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
-	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
-	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
-	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
-	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
-	0, 0, 0, 151, 167, 185, 80, 0, 147, 156,
-	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
-	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
-	130, 144, 84, 166, 149, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 129, 0, 182, 89, 85, 67,
-	0, 0, 0, 0, 68, 75, 110, 91, 138, 95,
-	168, 0, 0, 109, 0, 111, 0, 0, 150, 120,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 207, 0,
-	543, 0, 0, 0, 0, 0, 0, 82, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 93, 128, 0, 0, 0, 0,
+var yyAct = [...]int16{
+	346, 56, 1629, 1618, 1604, 1564, 1336, 1555, 642, 1516,
+	567, 1531, 1525, 1219, 963, 60, 1457, 1137, 682, 1120,
+	1418, 1146, 329, 1425, 315, 959, 277, 992, 345, 1144,
+	1310, 1138, 1389, 1381, 1121, 986, 1042, 65, 1267, 962,
+	268, 938, 1173, 972, 935, 1274, 683, 523, 875, 786,
+	889, 388, 311, 886, 871, 1075, 1152, 56, 1199, 799,
+	313, 1190, 976, 843, 1125, 306, 285, 554, 907, 561,
+	703, 495, 1006, 382, 641, 3, 702, 1002, 920, 377,
+	574, 22, 53, 296, 374, 379, 692, 269, 270, 271,
+	272, 656, 59, 275, 1622, 582, 1573, 1616, 1539, 525,
+	1608, 1337, 1572, 1259, 1365, 657, 500, 205, 281, 1304,
+	64, 613, 357, 613, 363, 364, 361, 362, 360, 359,
+	358, 1161, 26, 590, 1160, 597, 953, 1162, 365, 366,
+	1538, 276, 616, 617, 618, 619, 620, 621, 622, 274,
+	591, 596, 589, 888, 600, 599, 598, 609, 610, 602,
+	603, 604, 605, 606, 607, 608, 601, 592, 594, 593,
+	595, 548, 611, 615, 611, 615, 527, 26, 26, 614,
+	273, 614, 1305, 1306, 613, 544, 613, 57, 954, 955,
+	704, 1181, 705, 545, 542, 543, 985, 237, 233, 1134,
+	234, 235, 1129, 1130, 1115, 1408, 501, 1126, 1116, 1439,
+	1129, 1130, 1127, 56, 1128, 1489, 56, 600, 599, 598,
+	609, 610, 602, 603, 604, 605, 606, 607, 608, 601,
+	547, 601, 57, 57, 993, 611, 615, 611, 615, 229,
+	881, 231, 614, 228, 614, 513, 207, 25, 267, 1079,
+	529, 537, 538, 531, 524, 1222, 524, 524, 1221, 524,
+	524, 775, 524, 773, 524, 1562, 1593, 1591, 1592, 1522,
+	300, 1589, 1590, 524, 209, 210, 211, 212, 213, 1567,
+	1610, 1510, 1597, 528, 530, 1517, 1426, 1218, 613, 1354,
+	977, 921, 56, 1248, 287, 566, 1633, 287, 293, 1637,
+	514, 502, 1215, 532, 533, 231, 534, 535, 1217, 536,
+	774, 539, 569, 1465, 1223, 979, 1458, 1299, 624, 572,
+	549, 626, 779, 385, 550, 551, 602, 603, 604, 605,
+	606, 607, 608, 601, 236, 1460, 766, 1298, 1567, 611,
+	615, 1297, 638, 639, 1362, 625, 614, 498, 776, 230,
+	505, 612, 1174, 612, 640, 241, 1565, 644, 645, 646,
+	647, 648, 649, 650, 651, 652, 563, 655, 658, 658,
+	658, 664, 658, 658, 664, 658, 672, 673, 674, 675,
+	676, 677, 232, 687, 1537, 1036, 526, 1566, 1035, 1496,
+	1568, 979, 627, 628, 629, 630, 631, 632, 633, 634,
+	613, 1355, 565, 564, 570, 1249, 1374, 686, 960, 1490,
+	1147, 1149, 1131, 1459, 612, 949, 612, 1229, 1157, 1631,
+	1131, 1216, 1632, 1214, 1630, 23, 1106, 681, 978, 503,
+	504, 1466, 1464, 600, 599, 598, 609, 610, 602, 603,
+	604, 605, 606, 607, 608, 601, 1566, 979, 510, 1568,
+	286, 611, 615, 1069, 371, 372, 808, 613, 614, 698,
+	659, 661, 663, 665, 667, 669, 670, 586, 691, 520,
+	23, 23, 696, 1322, 660, 662, 700, 666, 668, 1296,
+	671, 516, 517, 518, 496, 800, 805, 1044, 496, 553,
+	385, 599, 598, 609, 610, 602, 603, 604, 605, 606,
+	607, 608, 601, 1148, 978, 850, 580, 579, 611, 615,
+	524, 613, 580, 579, 581, 614, 1091, 524, 612, 1263,
+	848, 849, 847, 494, 581, 1090, 507, 1508, 508, 216,
+	581, 509, 1323, 524, 1474, 571, 1278, 524, 524, 524,
+	706, 524, 524, 580, 579, 811, 812, 1599, 524, 524,
+	579, 604, 605, 606, 607, 608, 601, 765, 1580, 1092,
+	978, 581, 611, 615, 772, 975, 973, 581, 974, 614,
+	1261, 217, 908, 971, 977, 768, 56, 56, 1043, 801,
+	789, 56, 788, 1607, 790, 791, 792, 831, 794, 795,
+	1638, 1179, 1206, 309, 576, 796, 797, 912, 580, 579,
+	807, 1512, 580, 579, 806, 908, 817, 1103, 780, 820,
+	1547, 1414, 57, 1413, 1194, 982, 581, 69, 580, 579,
+	581, 983, 580, 579, 845, 846, 1193, 227, 844, 1204,
+	612, 69, 1581, 873, 69, 1065, 581, 56, 1639, 1182,
+	581, 1532, 872, 833, 834, 835, 839, 1164, 841, 832,
+	813, 814, 1613, 553, 553, 644, 1163, 69, 816, 1609,
+	891, 553, 287, 818, 842, 819, 1506, 851, 852, 853,
+	854, 855, 856, 857, 858, 859, 860, 861, 862, 863,
+	864, 865, 866, 867, 868, 869, 870, 612, 874, 836,
+	1339, 1066, 1067, 1068, 816, 553, 840, 1174, 936, 937,
+	1551, 553, 1471, 687, 1169, 1205, 882, 687, 816, 1543,
+	1210, 1207, 1200, 1208, 1203, 885, 929, 785, 1201, 1202,
+	898, 901, 686, 816, 1520, 1470, 909, 686, 784, 893,
+	769, 686, 1209, 767, 913, 816, 1462, 940, 764, 917,
+	522, 612, 515, 905, 1404, 1403, 1319, 944, 1376, 553,
+	61, 946, 1373, 553, 980, 930, 928, 1329, 1328, 1233,
+	788, 1153, 931, 1325, 1326, 994, 995, 996, 988, 989,
+	990, 991, 1325, 1324, 1292, 553, 553, 923, 524, 942,
+	524, 1085, 553, 950, 999, 1000, 1001, 947, 951, 924,
+	553, 713, 712, 943, 524, 967, 1153, 1579, 694, 894,
+	895, 1085, 693, 900, 903, 904, 69, 227, 1268, 385,
+	924, 69, 694, 69, 1085, 924, 1277, 891, 1277, 1559,
+	1292, 1473, 964, 69, 924, 1012, 69, 1014, 916, 1327,
+	918, 919, 69, 1295, 1165, 69, 952, 227, 1109, 227,
+	227, 1040, 227, 227, 695, 227, 1008, 227, 1004, 1005,
+	1277, 1070, 1108, 697, 1085, 693, 227, 699, 695, 809,
+	778, 282, 1549, 284, 288, 57, 62, 693, 1051, 1575,
+	841, 613, 278, 1447, 552, 1420, 69, 987, 1315, 227,
+	1168, 1007, 845, 1003, 998, 997, 844, 1509, 1435, 1052,
+	1411, 1226, 1191, 637, 929, 1056, 227, 1220, 1057, 57,
+	636, 635, 1382, 1383, 600, 599, 598, 609, 610, 602,
+	603, 604, 605, 606, 607, 608, 601, 279, 840, 57,
+	1010, 1071, 611, 615, 1072, 1073, 1074, 1624, 1619, 614,
+	283, 1118, 1119, 930, 928, 687, 1317, 687, 687, 1290,
+	931, 1558, 1557, 1382, 1383, 1140, 1268, 936, 1195, 803,
+	1150, 929, 782, 1123, 687, 826, 1387, 1287, 1285, 686,
+	1386, 686, 686, 1288, 1286, 69, 69, 69, 1385, 1283,
+	1282, 686, 1281, 1139, 227, 1284, 1556, 1595, 686, 1063,
+	227, 297, 298, 1132, 1133, 1102, 1048, 1166, 1571, 1151,
+	930, 928, 1228, 575, 1122, 1577, 1062, 931, 1154, 1061,
+	555, 1186, 1514, 711, 1178, 1117, 1135, 1513, 573, 1438,
+	1176, 1155, 1170, 1156, 1369, 1416, 556, 1013, 781, 1390,
+	1054, 893, 524, 294, 295, 575, 1183, 1184, 1185, 1175,
+	1187, 1188, 1189, 1158, 291, 292, 289, 290, 1582, 1060,
+	1481, 1084, 1478, 206, 1477, 280, 1059, 1171, 1172, 61,
+	524, 1235, 1423, 61, 1482, 1424, 1153, 546, 1097, 1100,
+	1626, 1625, 1611, 1096, 1094, 1230, 1198, 1093, 1081, 1197,
+	1192, 1064, 964, 798, 577, 1626, 1493, 1409, 804, 206,
+	203, 204, 208, 58, 1, 1617, 1211, 1338, 69, 1417,
+	1019, 1515, 925, 227, 1456, 1309, 970, 1224, 69, 69,
+	227, 612, 1078, 961, 69, 215, 493, 69, 214, 1507,
+	69, 1225, 969, 968, 69, 1463, 227, 1407, 981, 1180,
+	227, 227, 227, 69, 227, 227, 1260, 1140, 984, 56,
+	1316, 227, 227, 1177, 1238, 687, 687, 1511, 1239, 719,
+	1234, 717, 1244, 718, 1270, 1269, 1251, 716, 721, 1252,
+	1245, 1254, 1241, 1242, 1280, 1139, 1253, 720, 1247, 686,
+	686, 715, 252, 380, 707, 815, 1051, 227, 841, 1255,
+	1256, 69, 1257, 1258, 1009, 578, 218, 227, 1301, 1237,
+	1213, 1279, 1212, 1015, 1265, 1266, 1122, 1276, 540, 541,
+	254, 623, 1308, 1058, 1159, 386, 1272, 1554, 1521, 810,
+	560, 1476, 1603, 1271, 1524, 1422, 1300, 877, 227, 1101,
+	653, 906, 314, 830, 330, 1307, 1264, 327, 328, 1320,
+	1321, 1303, 1243, 1312, 1313, 1314, 821, 1114, 227, 588,
+	312, 304, 227, 685, 678, 927, 926, 1124, 375, 890,
+	892, 56, 1289, 1380, 687, 1394, 1142, 1143, 684, 1232,
+	1331, 1364, 1488, 825, 1318, 28, 202, 299, 19, 1352,
+	1353, 18, 1332, 17, 1334, 20, 227, 227, 686, 16,
+	1363, 15, 1343, 69, 14, 964, 511, 964, 32, 21,
+	69, 69, 13, 69, 12, 11, 69, 69, 1346, 10,
+	69, 69, 69, 227, 9, 302, 8, 7, 6, 1345,
+	5, 4, 1377, 1140, 24, 2, 227, 0, 1398, 1399,
+	1400, 1347, 0, 0, 0, 1344, 1349, 0, 1370, 0,
+	0, 0, 1378, 0, 1384, 0, 0, 0, 0, 0,
+	0, 1139, 1166, 0, 1391, 1393, 1406, 1392, 1402, 1237,
+	0, 524, 0, 0, 0, 0, 0, 0, 1122, 0,
+	0, 0, 0, 0, 0, 0, 0, 1405, 0, 0,
+	69, 227, 1410, 227, 1412, 1427, 1428, 227, 227, 69,
+	69, 0, 69, 69, 0, 0, 69, 227, 0, 0,
+	0, 0, 0, 1441, 0, 0, 0, 0, 1415, 0,
+	0, 0, 69, 0, 69, 69, 0, 69, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1450, 1451, 0,
+	227, 0, 1053, 0, 1445, 0, 0, 964, 0, 1452,
+	1453, 1454, 343, 0, 0, 0, 0, 1472, 0, 0,
+	0, 0, 0, 0, 0, 1429, 1430, 1431, 1432, 1433,
+	0, 1475, 1467, 1436, 1437, 1461, 1468, 1419, 1469, 940,
+	1140, 1480, 56, 1455, 0, 0, 225, 1440, 0, 1498,
+	0, 687, 1483, 0, 0, 0, 0, 1494, 1497, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1139, 1499,
+	1080, 0, 0, 1505, 1083, 686, 0, 1504, 0, 0,
+	0, 1087, 1088, 1089, 0, 0, 0, 0, 1095, 1519,
+	1533, 1098, 1099, 0, 1518, 0, 0, 1105, 0, 1535,
+	0, 1107, 0, 0, 1110, 1111, 1112, 1113, 69, 1544,
+	69, 69, 1500, 1540, 1025, 0, 1495, 69, 0, 0,
+	69, 227, 0, 1141, 0, 69, 0, 69, 1560, 1561,
+	0, 1024, 0, 0, 1553, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 227, 1570, 0, 0,
+	0, 0, 0, 0, 1122, 0, 0, 0, 0, 0,
+	1578, 1576, 0, 1587, 557, 559, 562, 1029, 0, 1585,
+	1586, 1588, 1584, 1419, 964, 1023, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1596, 1598, 1605, 0,
+	0, 587, 0, 0, 227, 227, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 644, 0, 0, 0,
+	0, 1620, 0, 1615, 1605, 0, 0, 0, 1621, 0,
+	0, 1623, 0, 227, 0, 0, 387, 0, 0, 1634,
+	0, 0, 643, 0, 1020, 1017, 1018, 0, 1016, 0,
+	0, 654, 69, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 227, 0, 0, 387, 0, 387, 387,
+	0, 387, 387, 0, 387, 0, 387, 0, 0, 0,
+	1027, 1030, 1250, 0, 877, 387, 877, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1627, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 568, 0,
+	0, 0, 227, 227, 0, 0, 0, 0, 69, 69,
+	0, 0, 0, 0, 0, 584, 553, 0, 0, 0,
+	1022, 0, 0, 613, 0, 1291, 0, 0, 1293, 0,
+	1294, 0, 0, 0, 227, 0, 0, 0, 0, 0,
+	0, 0, 1021, 0, 0, 0, 0, 0, 0, 227,
+	0, 227, 227, 0, 0, 0, 600, 599, 598, 609,
+	610, 602, 603, 604, 605, 606, 607, 608, 601, 0,
+	0, 0, 0, 0, 611, 615, 0, 0, 0, 69,
+	0, 614, 0, 0, 0, 0, 1026, 0, 0, 0,
+	0, 0, 0, 387, 0, 0, 69, 0, 0, 708,
+	0, 1028, 227, 0, 0, 227, 227, 69, 0, 0,
+	0, 0, 0, 227, 0, 0, 0, 69, 0, 0,
+	0, 0, 0, 0, 0, 0, 1348, 0, 0, 0,
+	0, 613, 802, 0, 1350, 1351, 0, 0, 0, 0,
+	1356, 1357, 1358, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1371,
+	1372, 0, 1375, 0, 0, 828, 829, 609, 610, 602,
+	603, 604, 605, 606, 607, 608, 601, 0, 0, 227,
+	0, 0, 611, 615, 0, 0, 0, 0, 1401, 614,
+	0, 227, 0, 0, 0, 0, 0, 0, 0, 227,
+	0, 0, 0, 0, 0, 0, 0, 0, 689, 0,
+	0, 0, 387, 0, 227, 0, 0, 0, 0, 387,
+	0, 227, 0, 0, 0, 0, 0, 0, 0, 0,
+	643, 0, 1421, 896, 897, 387, 0, 0, 0, 387,
+	387, 387, 0, 387, 387, 0, 239, 0, 0, 0,
+	387, 387, 1434, 612, 0, 0, 0, 227, 227, 0,
+	227, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 69, 0, 0, 69, 0, 0, 0,
+	0, 0, 227, 227, 227, 69, 822, 0, 227, 0,
+	0, 0, 958, 0, 0, 0, 584, 0, 0, 387,
+	0, 0, 0, 0, 227, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1484, 1485, 1486,
+	1487, 0, 0, 0, 1491, 1492, 0, 880, 0, 0,
+	0, 227, 0, 0, 69, 0, 0, 0, 0, 0,
+	1501, 1502, 1503, 0, 0, 0, 0, 883, 0, 0,
+	0, 884, 0, 0, 0, 0, 0, 227, 227, 0,
+	0, 612, 0, 0, 0, 0, 0, 1530, 910, 26,
+	27, 54, 29, 30, 0, 0, 1536, 0, 0, 0,
+	227, 0, 227, 1541, 0, 914, 915, 1545, 1546, 0,
+	0, 45, 1049, 1050, 69, 562, 31, 50, 51, 0,
+	0, 227, 0, 1550, 0, 0, 0, 0, 0, 0,
+	0, 0, 387, 0, 0, 0, 0, 40, 0, 1563,
+	0, 376, 1569, 0, 57, 387, 497, 613, 499, 0,
+	0, 0, 1574, 0, 0, 0, 0, 0, 506, 0,
+	0, 512, 0, 0, 0, 0, 0, 519, 0, 0,
+	521, 0, 0, 0, 0, 0, 0, 0, 1594, 227,
+	600, 599, 598, 609, 610, 602, 603, 604, 605, 606,
+	607, 608, 601, 1601, 1602, 0, 0, 1086, 611, 615,
+	387, 0, 387, 0, 0, 614, 1031, 1032, 0, 0,
+	0, 1612, 0, 1614, 1104, 0, 387, 33, 34, 36,
+	35, 38, 0, 52, 0, 0, 613, 0, 0, 0,
+	0, 0, 0, 0, 0, 1635, 1636, 1240, 0, 1076,
+	0, 387, 0, 0, 0, 39, 46, 47, 0, 1055,
+	48, 49, 37, 0, 0, 0, 0, 0, 0, 600,
+	599, 598, 609, 610, 602, 603, 604, 605, 606, 607,
+	608, 601, 0, 0, 0, 0, 0, 611, 615, 41,
+	42, 0, 43, 44, 614, 0, 0, 0, 0, 0,
+	0, 558, 0, 1368, 0, 0, 0, 0, 0, 0,
+	680, 613, 690, 0, 0, 0, 0, 1361, 0, 0,
+	0, 0, 0, 0, 0, 66, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 240,
+	0, 0, 266, 0, 600, 599, 598, 609, 610, 602,
+	603, 604, 605, 606, 607, 608, 601, 0, 0, 0,
+	1227, 0, 611, 615, 0, 66, 910, 0, 0, 614,
+	0, 0, 0, 613, 0, 0, 0, 0, 0, 55,
+	1145, 0, 0, 0, 0, 0, 0, 612, 0, 0,
+	0, 0, 23, 0, 0, 0, 0, 1246, 0, 0,
+	0, 0, 0, 0, 0, 387, 600, 599, 598, 609,
+	610, 602, 603, 604, 605, 606, 607, 608, 601, 1262,
+	0, 0, 0, 0, 611, 615, 0, 0, 0, 0,
+	0, 614, 0, 714, 0, 0, 0, 0, 0, 0,
+	0, 0, 1367, 770, 771, 0, 0, 0, 0, 777,
+	613, 0, 376, 1196, 387, 783, 643, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 612, 0, 793, 1302,
+	0, 0, 0, 0, 1360, 0, 0, 0, 0, 0,
+	0, 0, 387, 600, 599, 598, 609, 610, 602, 603,
+	604, 605, 606, 607, 608, 601, 0, 0, 0, 0,
+	0, 611, 615, 0, 0, 0, 0, 0, 614, 0,
+	0, 303, 387, 0, 378, 0, 827, 0, 0, 240,
+	0, 240, 910, 0, 0, 0, 0, 0, 0, 0,
+	613, 240, 0, 0, 240, 0, 0, 0, 0, 0,
+	240, 612, 0, 240, 1359, 0, 0, 0, 0, 387,
+	0, 0, 0, 0, 0, 0, 0, 0, 910, 0,
+	0, 1273, 1275, 600, 599, 598, 609, 610, 602, 603,
+	604, 605, 606, 607, 608, 601, 0, 0, 0, 1366,
+	0, 611, 615, 0, 66, 0, 0, 0, 614, 0,
+	0, 0, 0, 1275, 1379, 0, 0, 0, 0, 0,
+	613, 0, 0, 612, 0, 0, 1388, 0, 387, 0,
+	387, 1311, 1395, 0, 0, 0, 0, 0, 922, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 945, 600, 599, 598, 609, 610, 602, 603,
+	604, 605, 606, 607, 608, 601, 0, 0, 613, 0,
+	0, 611, 615, 0, 0, 0, 0, 0, 614, 0,
+	0, 1335, 0, 0, 1340, 1341, 0, 0, 0, 0,
+	0, 0, 387, 240, 240, 240, 0, 0, 0, 1082,
+	612, 600, 599, 598, 609, 610, 602, 603, 604, 605,
+	606, 607, 608, 601, 0, 0, 0, 0, 1446, 611,
+	615, 0, 0, 0, 0, 1011, 614, 0, 0, 0,
+	0, 0, 0, 0, 1033, 1034, 0, 1037, 1038, 0,
+	910, 1039, 0, 0, 0, 0, 613, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1041, 1145, 0,
+	1479, 0, 1047, 0, 0, 0, 0, 0, 0, 0,
+	387, 0, 0, 0, 0, 0, 0, 0, 568, 0,
+	612, 598, 609, 610, 602, 603, 604, 605, 606, 607,
+	608, 601, 0, 387, 0, 0, 0, 611, 615, 0,
+	387, 0, 0, 0, 614, 0, 0, 0, 0, 0,
+	0, 0, 0, 1523, 1526, 0, 240, 643, 1534, 0,
+	0, 0, 0, 0, 0, 0, 240, 240, 0, 0,
+	0, 0, 240, 0, 0, 240, 1442, 1443, 240, 1444,
+	0, 0, 787, 0, 0, 0, 0, 0, 0, 0,
+	612, 240, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 568, 568, 568, 0, 0, 0, 1311, 0, 0,
+	0, 736, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 568, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1583, 1526, 643, 643, 612, 240,
+	0, 0, 0, 0, 0, 0, 0, 0, 787, 0,
+	568, 0, 0, 0, 910, 0, 0, 0, 1600, 0,
+	0, 0, 0, 1606, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 387, 387, 0, 0,
+	0, 643, 0, 0, 0, 0, 0, 0, 0, 1606,
+	0, 0, 0, 0, 0, 0, 910, 0, 0, 1542,
+	0, 568, 303, 0, 0, 724, 0, 303, 303, 0,
+	0, 303, 303, 303, 0, 0, 612, 911, 0, 0,
+	1552, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 303, 303, 303, 303,
+	0, 240, 0, 737, 0, 0, 0, 0, 932, 240,
+	0, 66, 0, 0, 240, 240, 0, 1231, 240, 948,
+	787, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 568, 750,
+	753, 754, 755, 756, 757, 758, 0, 759, 760, 761,
+	762, 763, 738, 739, 740, 741, 722, 723, 751, 0,
+	725, 0, 726, 727, 728, 729, 730, 731, 732, 733,
+	734, 735, 742, 743, 744, 745, 746, 747, 748, 749,
+	0, 0, 0, 0, 0, 0, 0, 0, 240, 0,
+	0, 0, 0, 0, 0, 0, 0, 240, 240, 0,
+	240, 240, 0, 0, 240, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	240, 0, 1045, 1046, 0, 240, 0, 0, 0, 0,
+	787, 0, 0, 249, 0, 752, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 303, 0, 0,
+	0, 0, 613, 0, 1330, 0, 0, 0, 0, 0,
+	0, 0, 0, 1077, 0, 262, 0, 0, 0, 0,
+	0, 1333, 0, 0, 0, 0, 0, 0, 613, 0,
+	0, 0, 1342, 0, 0, 600, 599, 598, 609, 610,
+	602, 603, 604, 605, 606, 607, 608, 601, 0, 0,
+	0, 0, 0, 611, 615, 0, 0, 0, 0, 303,
+	614, 600, 599, 598, 609, 610, 602, 603, 604, 605,
+	606, 607, 608, 601, 242, 0, 0, 303, 0, 611,
+	615, 244, 0, 0, 0, 0, 614, 0, 0, 253,
+	0, 248, 0, 0, 0, 911, 240, 0, 240, 240,
+	0, 0, 0, 0, 0, 1136, 0, 0, 240, 0,
+	0, 0, 0, 66, 0, 240, 0, 0, 0, 0,
+	0, 0, 251, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 261, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 255, 245, 246, 0, 256,
+	257, 258, 260, 0, 259, 265, 0, 0, 0, 247,
+	250, 0, 243, 264, 263, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	240, 0, 612, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 303, 0, 0, 0, 0, 0, 0, 0,
+	0, 911, 0, 0, 0, 0, 0, 303, 612, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 787, 0,
+	0, 0, 0, 0, 0, 0, 0, 911, 0, 0,
+	0, 0, 0, 0, 0, 0, 240, 240, 197, 94,
+	88, 70, 0, 0, 0, 0, 0, 0, 0, 96,
+	0, 0, 0, 0, 0, 118, 0, 120, 0, 1548,
+	164, 129, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 138, 0, 0, 104, 0, 0, 226, 0, 0,
+	0, 0, 0, 0, 0, 85, 0, 0, 0, 0,
+	0, 0, 0, 220, 0, 0, 0, 240, 0, 0,
+	0, 0, 98, 137, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 240, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 240, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 240, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 105,
+	222, 223, 0, 0, 219, 0, 0, 0, 224, 147,
+	0, 167, 108, 117, 72, 79, 0, 107, 135, 152,
+	156, 0, 0, 0, 91, 0, 154, 140, 179, 911,
+	141, 153, 121, 172, 148, 0, 0, 180, 146, 106,
+	90, 159, 112, 163, 158, 89, 0, 101, 201, 145,
+	188, 189, 169, 186, 196, 73, 168, 178, 86, 157,
+	75, 176, 166, 127, 113, 114, 74, 0, 151, 95,
+	102, 93, 136, 173, 174, 92, 199, 80, 185, 77,
+	81, 184, 134, 171, 177, 128, 125, 76, 175, 126,
+	124, 116, 99, 109, 143, 123, 144, 110, 131, 130,
+	132, 0, 0, 0, 165, 182, 200, 83, 0, 160,
+	170, 190, 191, 192, 193, 194, 195, 0, 0, 84,
+	103, 97, 142, 133, 82, 111, 161, 115, 122, 150,
+	198, 139, 155, 87, 181, 162, 0, 221, 0, 0,
+	0, 1448, 0, 0, 1449, 0, 0, 0, 0, 0,
+	0, 0, 0, 66, 0, 71, 78, 119, 0, 149,
+	100, 183, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 240, 911, 480, 422, 437, 468, 0, 436,
+	483, 414, 428, 491, 429, 430, 459, 400, 445, 426,
+	197, 94, 88, 70, 0, 417, 394, 423, 395, 415,
+	439, 96, 442, 413, 470, 448, 482, 118, 489, 120,
+	453, 0, 164, 129, 0, 911, 441, 472, 0, 443,
+	466, 435, 460, 405, 452, 484, 427, 457, 485, 0,
+	965, 0, 240, 138, 0, 0, 104, 0, 0, 226,
+	0, 966, 0, 0, 0, 0, 0, 85, 0, 0,
+	0, 455, 479, 425, 456, 458, 393, 454, 0, 398,
+	401, 490, 474, 420, 98, 137, 1167, 0, 0, 0,
+	0, 0, 0, 440, 444, 463, 433, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 418, 0, 451,
+	0, 0, 0, 0, 0, 0, 402, 396, 399, 0,
+	0, 438, 0, 0, 0, 404, 0, 419, 464, 0,
+	392, 105, 467, 473, 0, 434, 187, 477, 432, 431,
+	481, 147, 0, 167, 108, 117, 72, 79, 0, 107,
+	135, 152, 156, 471, 416, 424, 91, 421, 154, 140,
+	179, 450, 141, 153, 121, 172, 148, 478, 461, 180,
+	146, 106, 90, 159, 112, 163, 158, 89, 462, 101,
+	201, 145, 188, 189, 169, 186, 196, 73, 168, 178,
+	86, 157, 75, 176, 166, 127, 113, 114, 74, 0,
+	151, 95, 102, 93, 136, 173, 174, 92, 199, 80,
+	185, 77, 81, 184, 134, 171, 177, 128, 125, 76,
+	175, 126, 124, 116, 99, 109, 143, 123, 144, 110,
+	131, 130, 132, 0, 397, 0, 165, 182, 200, 83,
+	412, 160, 170, 190, 191, 192, 193, 194, 195, 0,
+	0, 84, 103, 97, 142, 133, 82, 111, 161, 115,
+	122, 150, 198, 139, 155, 87, 181, 162, 408, 411,
+	406, 407, 446, 447, 486, 487, 488, 465, 403, 0,
+	409, 410, 0, 469, 475, 476, 449, 71, 78, 119,
+	492, 149, 100, 183, 480, 422, 437, 468, 0, 436,
+	483, 414, 428, 491, 429, 430, 459, 400, 445, 426,
+	197, 94, 88, 70, 0, 417, 394, 423, 395, 415,
+	439, 96, 442, 413, 470, 448, 482, 118, 489, 120,
+	453, 0, 164, 129, 0, 0, 441, 472, 0, 443,
+	466, 435, 460, 405, 452, 484, 427, 457, 485, 0,
+	965, 0, 0, 138, 0, 0, 104, 0, 0, 226,
+	0, 966, 0, 0, 0, 0, 0, 85, 0, 0,
+	0, 455, 479, 425, 456, 458, 393, 454, 0, 398,
+	401, 490, 474, 420, 98, 137, 0, 0, 0, 0,
+	0, 0, 0, 440, 444, 463, 433, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 418, 0, 451,
+	0, 0, 0, 0, 0, 0, 402, 396, 399, 0,
+	0, 438, 0, 0, 0, 404, 0, 419, 464, 0,
+	392, 105, 467, 473, 0, 434, 187, 477, 432, 431,
+	481, 147, 0, 167, 108, 117, 72, 79, 0, 107,
+	135, 152, 156, 471, 416, 424, 91, 421, 154, 140,
+	179, 450, 141, 153, 121, 172, 148, 478, 461, 180,
+	146, 106, 90, 159, 112, 163, 158, 89, 462, 101,
+	201, 145, 188, 189, 169, 186, 196, 73, 168, 178,
+	86, 157, 75, 176, 166, 127, 113, 114, 74, 0,
+	151, 95, 102, 93, 136, 173, 174, 92, 199, 80,
+	185, 77, 81, 184, 134, 171, 177, 128, 125, 76,
+	175, 126, 124, 116, 99, 109, 143, 123, 144, 110,
+	131, 130, 132, 0, 397, 0, 165, 182, 200, 83,
+	412, 160, 170, 190, 191, 192, 193, 194, 195, 0,
+	0, 84, 103, 97, 142, 133, 82, 111, 161, 115,
+	122, 150, 198, 139, 155, 87, 181, 162, 408, 411,
+	406, 407, 446, 447, 486, 487, 488, 465, 403, 0,
+	409, 410, 0, 469, 475, 476, 449, 71, 78, 119,
+	492, 149, 100, 183, 480, 422, 437, 468, 0, 436,
+	483, 414, 428, 491, 429, 430, 459, 400, 445, 426,
+	197, 94, 88, 70, 0, 417, 394, 423, 395, 415,
+	439, 96, 442, 413, 470, 448, 482, 118, 489, 120,
+	453, 0, 164, 129, 0, 0, 441, 472, 0, 443,
+	466, 435, 460, 405, 452, 484, 427, 457, 485, 0,
+	0, 0, 57, 138, 0, 0, 104, 0, 0, 226,
+	0, 0, 0, 0, 0, 0, 0, 85, 0, 0,
+	0, 455, 479, 425, 456, 458, 393, 454, 0, 398,
+	401, 490, 474, 420, 98, 137, 0, 0, 0, 0,
+	0, 0, 0, 440, 444, 463, 433, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 418, 0, 451,
+	0, 0, 0, 0, 0, 0, 402, 396, 399, 0,
+	0, 438, 0, 0, 0, 404, 0, 419, 464, 0,
+	392, 105, 467, 473, 0, 434, 187, 477, 432, 431,
+	481, 147, 0, 167, 108, 117, 72, 79, 0, 107,
+	135, 152, 156, 471, 416, 424, 91, 421, 154, 140,
+	179, 450, 141, 153, 121, 172, 148, 478, 461, 180,
+	146, 106, 90, 159, 112, 163, 158, 89, 462, 101,
+	201, 145, 188, 189, 169, 186, 196, 73, 168, 178,
+	86, 157, 75, 176, 166, 127, 113, 114, 74, 0,
+	151, 95, 102, 93, 136, 173, 174, 92, 199, 80,
+	185, 77, 81, 184, 134, 171, 177, 128, 125, 76,
+	175, 126, 124, 116, 99, 109, 143, 123, 144, 110,
+	131, 130, 132, 0, 397, 0, 165, 182, 200, 83,
+	412, 160, 170, 190, 191, 192, 193, 194, 195, 0,
+	0, 84, 103, 97, 142, 133, 82, 111, 161, 115,
+	122, 150, 198, 139, 155, 87, 181, 162, 408, 411,
+	406, 407, 446, 447, 486, 487, 488, 465, 403, 0,
+	409, 410, 0, 469, 475, 476, 449, 71, 78, 119,
+	492, 149, 100, 183, 480, 422, 437, 468, 0, 436,
+	483, 414, 428, 491, 429, 430, 459, 400, 445, 426,
+	197, 94, 88, 70, 0, 417, 394, 423, 395, 415,
+	439, 96, 442, 413, 470, 448, 482, 118, 489, 120,
+	453, 0, 164, 129, 0, 0, 441, 472, 0, 443,
+	466, 435, 460, 405, 452, 484, 427, 457, 485, 0,
+	0, 0, 0, 138, 0, 0, 104, 0, 0, 226,
+	0, 0, 0, 0, 0, 0, 0, 85, 0, 0,
+	0, 455, 479, 425, 456, 458, 393, 454, 0, 398,
+	401, 490, 474, 420, 98, 137, 0, 0, 0, 0,
+	0, 0, 0, 440, 444, 463, 433, 0, 0, 0,
+	0, 0, 0, 0, 0, 1236, 0, 418, 0, 451,
+	0, 0, 0, 0, 0, 0, 402, 396, 399, 0,
+	0, 438, 0, 0, 0, 404, 0, 419, 464, 0,
+	392, 105, 467, 473, 0, 434, 187, 477, 432, 431,
+	481, 147, 0, 167, 108, 117, 72, 79, 0, 107,
+	135, 152, 156, 471, 416, 424, 91, 421, 154, 140,
+	179, 450, 141, 153, 121, 172, 148, 478, 461, 180,
+	146, 106, 90, 159, 112, 163, 158, 89, 462, 101,
+	201, 145, 188, 189, 169, 186, 196, 73, 168, 178,
+	86, 157, 75, 176, 166, 127, 113, 114, 74, 0,
+	151, 95, 102, 93, 136, 173, 174, 92, 199, 80,
+	185, 77, 81, 184, 134, 171, 177, 128, 125, 76,
+	175, 126, 124, 116, 99, 109, 143, 123, 144, 110,
+	131, 130, 132, 0, 397, 0, 165, 182, 200, 83,
+	412, 160, 170, 190, 191, 192, 193, 194, 195, 0,
+	0, 84, 103, 97, 142, 133, 82, 111, 161, 115,
+	122, 150, 198, 139, 155, 87, 181, 162, 408, 411,
+	406, 407, 446, 447, 486, 487, 488, 465, 403, 0,
+	409, 410, 0, 469, 475, 476, 449, 71, 78, 119,
+	492, 149, 100, 183, 480, 422, 437, 468, 0, 436,
+	483, 414, 428, 491, 429, 430, 459, 400, 445, 426,
+	197, 94, 88, 70, 0, 417, 394, 423, 395, 415,
+	439, 96, 442, 413, 470, 448, 482, 118, 489, 120,
+	453, 0, 164, 129, 0, 0, 441, 472, 0, 443,
+	466, 435, 460, 405, 452, 484, 427, 457, 485, 0,
+	0, 0, 0, 138, 0, 0, 104, 0, 0, 68,
+	0, 0, 0, 0, 0, 0, 0, 85, 0, 0,
+	0, 455, 479, 425, 456, 458, 393, 454, 0, 398,
+	401, 490, 474, 420, 98, 137, 0, 0, 0, 0,
+	0, 0, 0, 440, 444, 463, 433, 0, 0, 0,
+	0, 0, 0, 0, 0, 949, 0, 418, 0, 451,
+	0, 0, 0, 0, 0, 0, 402, 396, 399, 0,
+	0, 438, 0, 0, 0, 404, 0, 419, 464, 0,
+	392, 105, 467, 473, 0, 434, 187, 477, 432, 431,
+	481, 147, 0, 167, 108, 117, 72, 79, 0, 107,
+	135, 152, 156, 471, 416, 424, 91, 421, 154, 140,
+	179, 450, 141, 153, 121, 172, 148, 478, 461, 180,
+	146, 106, 90, 159, 112, 163, 158, 89, 462, 101,
+	201, 145, 188, 189, 169, 186, 196, 73, 168, 178,
+	86, 157, 75, 176, 166, 127, 113, 114, 74, 0,
+	151, 95, 102, 93, 136, 173, 174, 92, 199, 80,
+	185, 77, 81, 184, 134, 171, 177, 128, 125, 76,
+	175, 126, 124, 116, 99, 109, 143, 123, 144, 110,
+	131, 130, 132, 0, 397, 0, 165, 182, 200, 83,
+	412, 160, 170, 190, 191, 192, 193, 194, 195, 0,
+	0, 84, 103, 97, 142, 133, 82, 111, 161, 115,
+	122, 150, 198, 139, 155, 87, 181, 162, 408, 411,
+	406, 407, 446, 447, 486, 487, 488, 465, 403, 0,
+	409, 410, 0, 469, 475, 476, 449, 71, 78, 119,
+	492, 149, 100, 183, 480, 422, 437, 468, 0, 436,
+	483, 414, 428, 491, 429, 430, 459, 400, 445, 426,
+	197, 94, 88, 70, 0, 417, 394, 423, 395, 415,
+	439, 96, 442, 413, 470, 448, 482, 118, 489, 120,
+	453, 0, 164, 129, 0, 0, 441, 472, 0, 443,
+	466, 435, 460, 405, 452, 484, 427, 457, 485, 0,
+	0, 0, 0, 138, 0, 0, 104, 0, 0, 308,
+	0, 0, 0, 0, 0, 0, 0, 85, 0, 0,
+	0, 455, 479, 425, 456, 458, 393, 454, 0, 398,
+	401, 490, 474, 420, 98, 137, 0, 0, 0, 0,
+	0, 0, 0, 440, 444, 463, 433, 0, 0, 0,
+	0, 0, 0, 0, 0, 838, 0, 418, 0, 451,
+	0, 0, 0, 0, 0, 0, 402, 396, 399, 0,
+	0, 438, 0, 0, 0, 404, 0, 419, 464, 0,
+	392, 105, 467, 473, 0, 434, 187, 477, 432, 431,
+	481, 147, 0, 167, 108, 117, 72, 79, 0, 107,
+	135, 152, 156, 471, 416, 424, 91, 421, 154, 140,
+	179, 450, 141, 153, 121, 172, 148, 478, 461, 180,
+	146, 106, 90, 159, 112, 163, 158, 89, 462, 101,
+	201, 145, 188, 189, 169, 186, 196, 73, 168, 178,
+	86, 157, 75, 176, 166, 127, 113, 114, 74, 0,
+	151, 95, 102, 93, 136, 173, 174, 92, 199, 80,
+	185, 77, 81, 184, 134, 171, 177, 128, 125, 76,
+	175, 126, 124, 116, 99, 109, 143, 123, 144, 110,
+	131, 130, 132, 0, 397, 0, 165, 182, 200, 83,
+	412, 160, 170, 190, 191, 192, 193, 194, 195, 0,
+	0, 84, 103, 97, 142, 133, 82, 111, 161, 115,
+	122, 150, 198, 139, 155, 87, 181, 162, 408, 411,
+	406, 407, 446, 447, 486, 487, 488, 465, 403, 0,
+	409, 410, 0, 469, 475, 476, 449, 71, 78, 119,
+	492, 149, 100, 183, 480, 422, 437, 468, 0, 436,
+	483, 414, 428, 491, 429, 430, 459, 400, 445, 426,
+	197, 94, 88, 70, 0, 417, 394, 423, 395, 415,
+	439, 96, 442, 413, 470, 448, 482, 118, 489, 120,
+	453, 0, 164, 129, 0, 0, 441, 472, 0, 443,
+	466, 435, 460, 405, 452, 484, 427, 457, 485, 0,
+	0, 0, 0, 138, 0, 0, 104, 0, 0, 226,
+	0, 0, 0, 0, 0, 0, 0, 85, 0, 0,
+	0, 455, 479, 425, 456, 458, 393, 454, 0, 398,
+	401, 490, 474, 420, 98, 137, 0, 0, 0, 0,
+	0, 0, 0, 440, 444, 463, 433, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 418, 0, 451,
+	0, 0, 0, 0, 0, 0, 402, 396, 399, 0,
+	0, 438, 0, 0, 0, 404, 0, 419, 464, 0,
+	392, 105, 467, 473, 0, 434, 187, 477, 432, 431,
+	481, 147, 0, 167, 108, 117, 72, 79, 0, 107,
+	135, 152, 156, 471, 416, 424, 91, 421, 154, 140,
+	179, 450, 141, 153, 121, 172, 148, 478, 461, 180,
+	146, 106, 90, 159, 112, 163, 158, 89, 462, 101,
+	201, 145, 188, 189, 169, 186, 196, 73, 168, 178,
+	86, 157, 75, 176, 166, 127, 113, 114, 74, 0,
+	151, 95, 102, 93, 136, 173, 174, 92, 199, 80,
+	185, 77, 81, 184, 134, 171, 177, 128, 125, 76,
+	175, 126, 124, 116, 99, 109, 143, 123, 144, 110,
+	131, 130, 132, 0, 397, 0, 165, 182, 200, 83,
+	412, 160, 170, 190, 191, 192, 193, 194, 195, 0,
+	0, 84, 103, 97, 142, 133, 82, 111, 161, 115,
+	122, 150, 198, 139, 155, 87, 181, 162, 408, 411,
+	406, 407, 446, 447, 486, 487, 488, 465, 403, 0,
+	409, 410, 0, 469, 475, 476, 449, 71, 78, 119,
+	492, 149, 100, 183, 480, 422, 437, 468, 0, 436,
+	483, 414, 428, 491, 429, 430, 459, 400, 445, 426,
+	197, 94, 88, 70, 0, 417, 394, 423, 395, 415,
+	439, 96, 442, 413, 470, 448, 482, 118, 489, 120,
+	453, 0, 164, 129, 0, 0, 441, 472, 0, 443,
+	466, 435, 460, 405, 452, 484, 427, 457, 485, 0,
+	0, 0, 0, 138, 0, 0, 104, 0, 0, 308,
+	0, 0, 0, 0, 0, 0, 0, 85, 0, 0,
+	0, 455, 479, 425, 456, 458, 393, 454, 0, 398,
+	401, 490, 474, 420, 98, 137, 0, 0, 0, 0,
+	0, 0, 0, 440, 444, 463, 433, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 418, 0, 451,
+	0, 0, 0, 0, 0, 0, 402, 396, 399, 0,
+	0, 438, 0, 0, 0, 404, 0, 419, 464, 0,
+	392, 105, 467, 473, 0, 434, 187, 477, 432, 431,
+	481, 147, 0, 167, 108, 117, 72, 79, 0, 107,
+	135, 152, 156, 471, 416, 424, 91, 421, 154, 140,
+	179, 450, 141, 153, 121, 172, 148, 478, 461, 180,
+	146, 106, 90, 159, 112, 163, 158, 89, 462, 101,
+	201, 145, 188, 189, 169, 186, 196, 73, 168, 178,
+	86, 157, 75, 176, 166, 127, 113, 114, 74, 0,
+	151, 95, 102, 93, 136, 173, 174, 92, 199, 80,
+	185, 77, 81, 184, 134, 171, 177, 128, 125, 76,
+	175, 126, 124, 116, 99, 109, 143, 123, 144, 110,
+	131, 130, 132, 0, 397, 0, 165, 182, 200, 83,
+	412, 160, 170, 190, 191, 192, 193, 194, 195, 0,
+	0, 84, 103, 97, 142, 133, 82, 111, 161, 115,
+	122, 150, 198, 139, 155, 87, 181, 162, 408, 411,
+	406, 407, 446, 447, 486, 487, 488, 465, 403, 0,
+	409, 410, 0, 469, 475, 476, 449, 71, 78, 119,
+	492, 149, 100, 183, 480, 422, 437, 468, 0, 436,
+	483, 414, 428, 491, 429, 430, 459, 400, 445, 426,
+	197, 94, 88, 70, 0, 417, 394, 423, 395, 415,
+	439, 96, 442, 413, 470, 448, 482, 118, 489, 120,
+	453, 0, 164, 129, 0, 0, 441, 472, 0, 443,
+	466, 435, 460, 405, 452, 484, 427, 457, 485, 0,
+	0, 0, 0, 138, 0, 0, 104, 0, 0, 226,
+	0, 0, 0, 0, 0, 0, 0, 85, 0, 0,
+	0, 455, 479, 425, 456, 458, 393, 454, 0, 398,
+	401, 490, 474, 420, 98, 137, 0, 0, 0, 0,
+	0, 0, 0, 440, 444, 463, 433, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 418, 0, 451,
+	0, 0, 0, 0, 0, 0, 402, 396, 399, 0,
+	0, 438, 0, 0, 0, 404, 0, 419, 464, 0,
+	392, 105, 467, 473, 0, 434, 187, 477, 432, 431,
+	481, 147, 0, 167, 108, 117, 72, 79, 0, 107,
+	135, 152, 156, 471, 416, 424, 91, 421, 154, 140,
+	179, 450, 141, 153, 121, 172, 148, 478, 461, 180,
+	146, 106, 90, 159, 112, 163, 158, 89, 462, 101,
+	201, 145, 188, 189, 169, 186, 196, 73, 168, 178,
+	86, 157, 75, 176, 166, 127, 113, 114, 74, 0,
+	151, 95, 102, 93, 136, 173, 174, 92, 199, 80,
+	185, 77, 390, 184, 134, 171, 177, 128, 125, 76,
+	175, 126, 124, 116, 99, 109, 143, 123, 144, 110,
+	131, 130, 132, 0, 397, 0, 165, 182, 200, 83,
+	412, 160, 170, 190, 191, 192, 193, 194, 195, 0,
+	0, 84, 103, 97, 142, 391, 389, 111, 161, 115,
+	122, 150, 198, 139, 155, 87, 181, 162, 408, 411,
+	406, 407, 446, 447, 486, 487, 488, 465, 403, 0,
+	409, 410, 0, 469, 475, 476, 449, 71, 78, 119,
+	492, 149, 100, 183, 480, 422, 437, 468, 0, 436,
+	483, 414, 428, 491, 429, 430, 459, 400, 445, 426,
+	197, 94, 88, 70, 0, 417, 394, 423, 395, 415,
+	439, 96, 442, 413, 470, 448, 482, 118, 489, 120,
+	453, 0, 164, 129, 0, 0, 441, 472, 0, 443,
+	466, 435, 460, 405, 452, 484, 427, 457, 485, 0,
+	0, 0, 0, 138, 0, 0, 104, 0, 0, 68,
+	0, 0, 0, 0, 0, 0, 0, 85, 0, 0,
+	0, 455, 479, 425, 456, 458, 393, 454, 0, 398,
+	401, 490, 474, 420, 98, 137, 0, 0, 0, 0,
+	0, 0, 0, 440, 444, 463, 433, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 418, 0, 451,
+	0, 0, 0, 0, 0, 0, 402, 396, 399, 0,
+	0, 438, 0, 0, 0, 404, 0, 419, 464, 0,
+	392, 105, 467, 473, 0, 434, 187, 477, 432, 431,
+	481, 147, 0, 167, 108, 117, 72, 79, 0, 107,
+	135, 152, 156, 471, 416, 424, 91, 421, 154, 140,
+	179, 450, 141, 153, 121, 172, 148, 478, 461, 180,
+	146, 106, 90, 159, 112, 163, 158, 89, 462, 101,
+	201, 145, 188, 189, 169, 186, 196, 73, 168, 178,
+	86, 157, 75, 176, 166, 127, 113, 114, 74, 0,
+	151, 95, 102, 93, 136, 173, 174, 92, 199, 80,
+	185, 77, 81, 184, 134, 171, 177, 128, 125, 76,
+	175, 126, 124, 116, 99, 109, 143, 123, 144, 110,
+	131, 130, 132, 0, 397, 0, 165, 182, 200, 83,
+	412, 160, 170, 190, 191, 192, 193, 194, 195, 0,
+	0, 84, 103, 97, 142, 133, 82, 111, 161, 115,
+	122, 150, 198, 139, 155, 87, 181, 162, 408, 411,
+	406, 407, 446, 447, 486, 487, 488, 465, 403, 0,
+	409, 410, 0, 469, 475, 476, 449, 71, 78, 119,
+	492, 149, 100, 183, 480, 422, 437, 468, 0, 436,
+	483, 414, 428, 491, 429, 430, 459, 400, 445, 426,
+	197, 94, 88, 70, 0, 417, 394, 423, 395, 415,
+	439, 96, 442, 413, 470, 448, 482, 118, 489, 120,
+	453, 0, 164, 129, 0, 0, 441, 472, 0, 443,
+	466, 435, 460, 405, 452, 484, 427, 457, 485, 0,
+	0, 0, 0, 138, 0, 0, 104, 0, 0, 226,
+	0, 0, 0, 0, 0, 0, 0, 85, 0, 0,
+	0, 455, 479, 425, 456, 458, 393, 454, 0, 398,
+	401, 490, 474, 420, 98, 137, 0, 0, 0, 0,
+	0, 0, 0, 440, 444, 463, 433, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 418, 0, 451,
+	0, 0, 0, 0, 0, 0, 402, 396, 399, 0,
+	0, 438, 0, 0, 0, 404, 0, 419, 464, 0,
+	392, 105, 467, 473, 0, 434, 187, 477, 432, 431,
+	481, 147, 0, 167, 108, 117, 72, 79, 0, 107,
+	135, 152, 156, 471, 416, 424, 91, 421, 154, 140,
+	179, 450, 141, 153, 121, 172, 148, 478, 461, 180,
+	146, 106, 90, 159, 112, 163, 158, 89, 462, 101,
+	201, 145, 188, 189, 169, 186, 196, 73, 168, 701,
+	86, 157, 75, 176, 166, 127, 113, 114, 74, 0,
+	151, 95, 102, 93, 136, 173, 174, 92, 199, 80,
+	185, 77, 390, 184, 134, 171, 177, 128, 125, 76,
+	175, 126, 124, 116, 99, 109, 143, 123, 144, 110,
+	131, 130, 132, 0, 397, 0, 165, 182, 200, 83,
+	412, 160, 170, 190, 191, 192, 193, 194, 195, 0,
+	0, 84, 103, 97, 142, 391, 389, 111, 161, 115,
+	122, 150, 198, 139, 155, 87, 181, 162, 408, 411,
+	406, 407, 446, 447, 486, 487, 488, 465, 403, 0,
+	409, 410, 0, 469, 475, 476, 449, 71, 78, 119,
+	492, 149, 100, 183, 480, 422, 437, 468, 0, 436,
+	483, 414, 428, 491, 429, 430, 459, 400, 445, 426,
+	197, 94, 88, 70, 0, 417, 394, 423, 395, 415,
+	439, 96, 442, 413, 470, 448, 482, 118, 489, 120,
+	453, 0, 164, 129, 0, 0, 441, 472, 0, 443,
+	466, 435, 460, 405, 452, 484, 427, 457, 485, 0,
+	0, 0, 0, 138, 0, 0, 104, 0, 0, 226,
+	0, 0, 0, 0, 0, 0, 0, 85, 0, 0,
+	0, 455, 479, 425, 456, 458, 393, 454, 0, 398,
+	401, 490, 474, 420, 98, 137, 0, 0, 0, 0,
+	0, 0, 0, 440, 444, 463, 433, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 418, 0, 451,
+	0, 0, 0, 0, 0, 0, 402, 396, 399, 0,
+	0, 438, 0, 0, 0, 404, 0, 419, 464, 0,
+	392, 105, 467, 473, 0, 434, 187, 477, 432, 431,
+	481, 147, 0, 167, 108, 117, 72, 79, 0, 107,
+	135, 152, 156, 471, 416, 424, 91, 421, 154, 140,
+	179, 450, 141, 153, 121, 172, 148, 478, 461, 180,
+	146, 106, 90, 159, 112, 163, 158, 89, 462, 101,
+	201, 145, 188, 189, 169, 186, 196, 73, 168, 381,
+	86, 157, 75, 176, 166, 127, 113, 114, 74, 0,
+	151, 95, 102, 93, 136, 173, 174, 92, 199, 80,
+	185, 77, 390, 184, 134, 171, 177, 128, 125, 76,
+	175, 126, 124, 116, 99, 109, 143, 123, 144, 110,
+	131, 130, 132, 0, 397, 0, 165, 182, 200, 83,
+	412, 160, 170, 190, 191, 192, 193, 194, 195, 0,
+	0, 84, 103, 97, 142, 391, 389, 384, 383, 115,
+	122, 150, 198, 139, 155, 87, 181, 162, 408, 411,
+	406, 407, 446, 447, 486, 487, 488, 465, 403, 0,
+	409, 410, 26, 469, 475, 476, 449, 71, 78, 119,
+	492, 149, 100, 183, 0, 197, 94, 88, 70, 0,
+	0, 0, 310, 0, 0, 0, 96, 0, 307, 0,
+	0, 0, 118, 356, 120, 0, 0, 164, 129, 0,
+	0, 0, 0, 0, 347, 348, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 333, 0, 57, 138, 0,
+	0, 104, 0, 553, 308, 334, 336, 337, 338, 339,
+	0, 0, 85, 335, 0, 0, 340, 341, 342, 0,
+	0, 0, 305, 322, 0, 355, 0, 0, 0, 98,
+	137, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 319, 320,
+	0, 0, 0, 0, 369, 0, 321, 0, 0, 0,
+	0, 0, 344, 316, 317, 318, 323, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 105, 0, 0, 0,
+	0, 187, 0, 0, 367, 0, 147, 0, 167, 108,
+	117, 72, 79, 0, 107, 135, 152, 156, 0, 0,
+	0, 324, 0, 154, 140, 179, 0, 141, 153, 121,
+	172, 148, 0, 0, 180, 146, 106, 90, 159, 112,
+	163, 158, 89, 0, 331, 201, 332, 188, 189, 169,
+	186, 196, 73, 168, 178, 86, 157, 75, 176, 166,
+	127, 113, 114, 74, 0, 151, 95, 102, 93, 136,
+	325, 326, 92, 199, 80, 185, 77, 81, 184, 134,
+	171, 177, 128, 125, 76, 175, 126, 124, 116, 99,
+	109, 143, 123, 144, 110, 131, 130, 132, 0, 0,
+	0, 165, 182, 200, 83, 0, 160, 170, 190, 191,
+	192, 193, 194, 195, 0, 0, 84, 103, 97, 142,
+	133, 82, 111, 161, 115, 122, 150, 198, 139, 155,
+	87, 181, 162, 357, 368, 363, 364, 361, 362, 360,
+	359, 358, 370, 349, 350, 351, 352, 354, 0, 365,
+	366, 353, 71, 78, 119, 23, 149, 100, 183, 197,
+	94, 88, 70, 0, 0, 0, 310, 0, 0, 0,
+	96, 0, 307, 0, 0, 0, 118, 356, 120, 0,
+	0, 164, 129, 0, 0, 0, 0, 0, 347, 348,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 333,
+	0, 57, 138, 0, 0, 104, 0, 0, 308, 334,
+	336, 337, 338, 339, 0, 0, 85, 335, 0, 0,
+	340, 341, 342, 0, 0, 0, 305, 322, 0, 355,
+	0, 0, 0, 98, 137, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 319, 320, 0, 0, 0, 0, 369, 0,
+	321, 0, 0, 0, 0, 0, 344, 316, 317, 318,
+	323, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	105, 0, 1396, 1397, 0, 187, 0, 0, 367, 0,
+	147, 0, 167, 108, 117, 72, 79, 0, 107, 135,
+	152, 156, 0, 0, 0, 324, 0, 154, 140, 179,
+	0, 141, 153, 121, 172, 148, 0, 0, 180, 146,
+	106, 90, 159, 112, 163, 158, 89, 0, 331, 201,
+	332, 188, 189, 169, 186, 196, 73, 168, 178, 86,
+	157, 75, 176, 166, 127, 113, 114, 74, 0, 151,
+	95, 102, 93, 136, 325, 326, 92, 199, 80, 185,
+	77, 81, 184, 134, 171, 177, 128, 125, 76, 175,
+	126, 124, 116, 99, 109, 143, 123, 144, 110, 131,
+	130, 132, 0, 0, 0, 165, 182, 200, 83, 0,
+	160, 170, 190, 191, 192, 193, 194, 195, 0, 0,
+	84, 103, 97, 142, 133, 82, 111, 161, 115, 122,
+	150, 198, 139, 155, 87, 181, 162, 357, 368, 363,
+	364, 361, 362, 360, 359, 358, 370, 349, 350, 351,
+	352, 354, 0, 365, 366, 353, 71, 78, 119, 0,
+	149, 100, 183, 197, 94, 88, 70, 0, 0, 0,
+	310, 0, 0, 0, 96, 0, 307, 0, 0, 0,
+	118, 356, 120, 0, 0, 164, 129, 0, 0, 0,
+	0, 0, 347, 348, 0, 0, 0, 0, 0, 0,
+	956, 0, 0, 333, 0, 57, 138, 0, 0, 104,
+	0, 0, 308, 334, 336, 337, 338, 339, 0, 0,
+	85, 335, 0, 0, 340, 341, 342, 957, 0, 0,
+	305, 322, 0, 355, 0, 0, 0, 98, 137, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 319, 320, 0, 0,
+	0, 0, 369, 0, 321, 0, 0, 0, 0, 0,
+	344, 316, 317, 318, 323, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 105, 0, 0, 0, 0, 187,
+	0, 0, 367, 0, 147, 0, 167, 108, 117, 72,
+	79, 0, 107, 135, 152, 156, 0, 0, 0, 324,
+	0, 154, 140, 179, 0, 141, 153, 121, 172, 148,
+	0, 0, 180, 146, 106, 90, 159, 112, 163, 158,
+	89, 0, 331, 201, 332, 188, 189, 169, 186, 196,
+	73, 168, 178, 86, 157, 75, 176, 166, 127, 113,
+	114, 74, 0, 151, 95, 102, 93, 136, 325, 326,
+	92, 199, 80, 185, 77, 81, 184, 134, 171, 177,
+	128, 125, 76, 175, 126, 124, 116, 99, 109, 143,
+	123, 144, 110, 131, 130, 132, 0, 0, 0, 165,
+	182, 200, 83, 0, 160, 170, 190, 191, 192, 193,
+	194, 195, 0, 0, 84, 103, 97, 142, 133, 82,
+	111, 161, 115, 122, 150, 198, 139, 155, 87, 181,
+	162, 357, 368, 363, 364, 361, 362, 360, 359, 358,
+	370, 349, 350, 351, 352, 354, 26, 365, 366, 353,
+	71, 78, 119, 0, 149, 100, 183, 0, 0, 197,
+	94, 88, 70, 0, 0, 0, 310, 0, 0, 0,
+	96, 0, 307, 0, 0, 0, 118, 356, 120, 0,
+	0, 164, 129, 0, 0, 0, 0, 0, 347, 348,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 333,
+	0, 57, 138, 0, 0, 104, 0, 0, 308, 334,
+	336, 337, 338, 339, 0, 0, 85, 335, 0, 0,
+	340, 341, 342, 0, 0, 0, 305, 322, 0, 355,
+	0, 0, 0, 98, 137, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 319, 320, 0, 0, 0, 0, 369, 0,
+	321, 0, 0, 0, 0, 0, 344, 316, 317, 318,
+	323, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	105, 0, 0, 0, 0, 187, 0, 0, 367, 0,
+	147, 0, 167, 108, 117, 72, 79, 0, 107, 135,
+	152, 156, 0, 0, 0, 324, 0, 154, 140, 179,
+	0, 141, 153, 121, 172, 148, 0, 0, 180, 146,
+	106, 90, 159, 112, 163, 158, 89, 0, 331, 201,
+	332, 188, 189, 169, 186, 196, 73, 168, 178, 86,
+	157, 75, 176, 166, 127, 113, 114, 74, 0, 151,
+	95, 102, 93, 136, 325, 326, 92, 199, 80, 185,
+	77, 81, 184, 134, 171, 177, 128, 125, 76, 175,
+	126, 124, 116, 99, 109, 143, 123, 144, 110, 131,
+	130, 132, 0, 0, 0, 165, 182, 200, 83, 0,
+	160, 170, 190, 191, 192, 193, 194, 195, 0, 0,
+	84, 103, 97, 142, 133, 82, 111, 161, 115, 122,
+	150, 198, 139, 155, 87, 181, 162, 357, 368, 363,
+	364, 361, 362, 360, 359, 358, 370, 349, 350, 351,
+	352, 354, 0, 365, 366, 353, 71, 78, 119, 23,
+	149, 100, 183, 197, 94, 88, 70, 0, 887, 0,
+	310, 0, 0, 0, 96, 0, 307, 0, 0, 0,
+	118, 356, 120, 0, 0, 164, 129, 0, 0, 0,
+	0, 0, 347, 348, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 333, 0, 57, 138, 0, 0, 104,
+	0, 0, 308, 334, 336, 337, 338, 339, 0, 0,
+	85, 335, 0, 0, 340, 341, 342, 0, 0, 0,
+	305, 322, 0, 355, 0, 0, 0, 98, 137, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 319, 320, 301, 0,
+	0, 0, 369, 0, 321, 0, 0, 0, 0, 0,
+	344, 316, 317, 318, 323, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 105, 0, 0, 0, 0, 187,
+	0, 0, 367, 0, 147, 0, 167, 108, 117, 72,
+	79, 0, 107, 135, 152, 156, 0, 0, 0, 324,
+	0, 154, 140, 179, 0, 141, 153, 121, 172, 148,
+	0, 0, 180, 146, 106, 90, 159, 112, 163, 158,
+	89, 0, 331, 201, 332, 188, 189, 169, 186, 196,
+	73, 168, 178, 86, 157, 75, 176, 166, 127, 113,
+	114, 74, 0, 151, 95, 102, 93, 136, 325, 326,
+	92, 199, 80, 185, 77, 81, 184, 134, 171, 177,
+	128, 125, 76, 175, 126, 124, 116, 99, 109, 143,
+	123, 144, 110, 131, 130, 132, 0, 0, 0, 165,
+	182, 200, 83, 0, 160, 170, 190, 191, 192, 193,
+	194, 195, 0, 0, 84, 103, 97, 142, 133, 82,
+	111, 161, 115, 122, 150, 198, 139, 155, 87, 181,
+	162, 357, 368, 363, 364, 361, 362, 360, 359, 358,
+	370, 349, 350, 351, 352, 354, 0, 365, 366, 353,
+	71, 78, 119, 0, 149, 100, 183, 197, 94, 88,
+	70, 0, 0, 0, 310, 0, 0, 0, 96, 0,
+	307, 0, 0, 0, 118, 356, 120, 0, 0, 164,
+	129, 0, 0, 0, 0, 0, 347, 348, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 333, 0, 57,
+	138, 0, 0, 104, 0, 553, 308, 334, 336, 337,
+	338, 339, 0, 0, 85, 335, 0, 0, 340, 341,
+	342, 0, 0, 0, 305, 322, 0, 355, 0, 0,
+	0, 98, 137, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	319, 320, 0, 0, 0, 0, 369, 0, 321, 0,
+	0, 0, 0, 0, 344, 316, 317, 318, 323, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 105, 0,
+	0, 0, 0, 187, 0, 0, 367, 0, 147, 0,
+	167, 108, 117, 72, 79, 0, 107, 135, 152, 156,
+	0, 0, 0, 324, 0, 154, 140, 179, 0, 141,
+	153, 121, 172, 148, 0, 0, 180, 146, 106, 90,
+	159, 112, 163, 158, 89, 0, 331, 201, 332, 188,
+	189, 169, 186, 196, 73, 168, 178, 86, 157, 75,
+	176, 166, 127, 113, 114, 74, 0, 151, 95, 102,
+	93, 136, 325, 326, 92, 199, 80, 185, 77, 81,
+	184, 134, 171, 177, 128, 125, 76, 175, 126, 124,
+	116, 99, 109, 143, 123, 144, 110, 131, 130, 132,
+	0, 0, 0, 165, 182, 200, 83, 0, 160, 170,
+	190, 191, 192, 193, 194, 195, 0, 0, 84, 103,
+	97, 142, 133, 82, 111, 161, 115, 122, 150, 198,
+	139, 155, 87, 181, 162, 357, 368, 363, 364, 361,
+	362, 360, 359, 358, 370, 349, 350, 351, 352, 354,
+	0, 365, 366, 353, 71, 78, 119, 0, 149, 100,
+	183, 197, 94, 88, 70, 0, 0, 0, 310, 0,
+	0, 0, 96, 0, 307, 0, 0, 0, 118, 356,
+	120, 0, 0, 164, 129, 0, 0, 0, 0, 0,
+	347, 348, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 333, 0, 57, 138, 0, 0, 104, 0, 0,
+	308, 334, 336, 337, 338, 339, 0, 0, 85, 335,
+	0, 0, 340, 341, 342, 0, 0, 0, 305, 322,
+	0, 355, 0, 0, 0, 98, 137, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 319, 320, 301, 0, 0, 0,
+	369, 0, 321, 0, 0, 0, 0, 0, 344, 316,
+	317, 318, 323, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 105, 0, 0, 0, 0, 187, 0, 0,
+	367, 0, 147, 0, 167, 108, 117, 72, 79, 0,
+	107, 135, 152, 156, 0, 0, 0, 324, 0, 154,
+	140, 179, 0, 141, 153, 121, 172, 148, 0, 0,
+	180, 146, 106, 90, 159, 112, 163, 158, 89, 0,
+	331, 201, 332, 188, 189, 169, 186, 196, 73, 168,
+	178, 86, 157, 75, 176, 166, 127, 113, 114, 74,
+	0, 151, 95, 102, 93, 136, 325, 326, 92, 199,
+	80, 185, 77, 81, 184, 134, 171, 177, 128, 125,
+	76, 175, 126, 124, 116, 99, 109, 143, 123, 144,
+	110, 131, 130, 132, 0, 0, 0, 165, 182, 200,
+	83, 0, 160, 170, 190, 191, 192, 193, 194, 195,
+	0, 0, 84, 103, 97, 142, 133, 82, 111, 161,
+	115, 122, 150, 198, 139, 155, 87, 181, 162, 357,
+	368, 363, 364, 361, 362, 360, 359, 358, 370, 349,
+	350, 351, 352, 354, 0, 365, 366, 353, 71, 78,
+	119, 0, 149, 100, 183, 197, 94, 88, 70, 0,
+	0, 0, 310, 0, 0, 0, 96, 0, 307, 0,
+	0, 0, 118, 356, 120, 0, 0, 164, 129, 0,
+	0, 0, 0, 0, 347, 348, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 902, 0, 57, 138, 0,
+	0, 104, 0, 0, 308, 334, 336, 337, 338, 339,
+	0, 0, 85, 335, 0, 0, 340, 341, 342, 0,
+	0, 0, 305, 322, 0, 355, 0, 0, 0, 98,
+	137, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 319, 320,
+	301, 0, 0, 0, 369, 0, 321, 0, 0, 0,
+	0, 0, 344, 316, 317, 318, 323, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 105, 0, 0, 0,
+	0, 187, 0, 0, 367, 0, 147, 0, 167, 108,
+	117, 72, 79, 0, 107, 135, 152, 156, 0, 0,
+	0, 324, 0, 154, 140, 179, 0, 141, 153, 121,
+	172, 148, 0, 0, 180, 146, 106, 90, 159, 112,
+	163, 158, 89, 0, 331, 201, 332, 188, 189, 169,
+	186, 196, 73, 168, 178, 86, 157, 75, 176, 166,
+	127, 113, 114, 74, 0, 151, 95, 102, 93, 136,
+	325, 326, 92, 199, 80, 185, 77, 81, 184, 134,
+	171, 177, 128, 125, 76, 175, 126, 124, 116, 99,
+	109, 143, 123, 144, 110, 131, 130, 132, 0, 0,
+	0, 165, 182, 200, 83, 0, 160, 170, 190, 191,
+	192, 193, 194, 195, 0, 0, 84, 103, 97, 142,
+	133, 82, 111, 161, 115, 122, 150, 198, 139, 155,
+	87, 181, 162, 357, 368, 363, 364, 361, 362, 360,
+	359, 358, 370, 349, 350, 351, 352, 354, 0, 365,
+	366, 353, 71, 78, 119, 0, 149, 100, 183, 197,
+	94, 88, 70, 0, 0, 0, 310, 0, 0, 0,
+	96, 0, 307, 0, 0, 0, 118, 356, 120, 0,
+	0, 164, 129, 0, 0, 0, 0, 0, 347, 348,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 899,
+	0, 57, 138, 0, 0, 104, 0, 0, 308, 334,
+	336, 337, 338, 339, 0, 0, 85, 335, 0, 0,
+	340, 341, 342, 0, 0, 0, 305, 322, 0, 355,
+	0, 0, 0, 98, 137, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 319, 320, 301, 0, 0, 0, 369, 0,
+	321, 0, 0, 0, 0, 0, 344, 316, 317, 318,
+	323, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	105, 0, 0, 0, 0, 187, 0, 0, 367, 0,
+	147, 0, 167, 108, 117, 72, 79, 0, 107, 135,
+	152, 156, 0, 0, 0, 324, 0, 154, 140, 179,
+	0, 141, 153, 121, 172, 148, 0, 0, 180, 146,
+	106, 90, 159, 112, 163, 158, 89, 0, 331, 201,
+	332, 188, 189, 169, 186, 196, 73, 168, 178, 86,
+	157, 75, 176, 166, 127, 113, 114, 74, 0, 151,
+	95, 102, 93, 136, 325, 326, 92, 199, 80, 185,
+	77, 81, 184, 134, 171, 177, 128, 125, 76, 175,
+	126, 124, 116, 99, 109, 143, 123, 144, 110, 131,
+	130, 132, 0, 0, 0, 165, 182, 200, 83, 0,
+	160, 170, 190, 191, 192, 193, 194, 195, 0, 0,
+	84, 103, 97, 142, 133, 82, 111, 161, 115, 122,
+	150, 198, 139, 155, 87, 181, 162, 357, 368, 363,
+	364, 361, 362, 360, 359, 358, 370, 349, 350, 351,
+	352, 354, 0, 365, 366, 353, 71, 78, 119, 0,
+	149, 100, 183, 197, 94, 88, 70, 0, 0, 0,
+	310, 0, 0, 0, 96, 0, 307, 0, 0, 0,
+	118, 356, 120, 0, 0, 164, 129, 0, 0, 0,
+	0, 0, 347, 348, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 333, 0, 57, 138, 0, 0, 104,
+	0, 0, 308, 334, 336, 337, 338, 339, 0, 0,
+	85, 335, 0, 0, 340, 341, 342, 0, 0, 0,
+	305, 322, 0, 355, 0, 0, 0, 98, 137, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 319, 320, 0, 0,
+	0, 0, 369, 0, 321, 0, 0, 0, 0, 0,
+	344, 316, 317, 318, 323, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 105, 0, 0, 0, 0, 187,
+	0, 0, 367, 0, 147, 0, 167, 108, 117, 72,
+	79, 0, 107, 135, 152, 156, 0, 0, 0, 324,
+	0, 154, 140, 179, 0, 141, 153, 121, 172, 148,
+	0, 0, 180, 146, 106, 90, 159, 112, 163, 158,
+	89, 0, 331, 201, 332, 188, 189, 169, 186, 196,
+	73, 168, 178, 86, 157, 75, 176, 166, 127, 113,
+	114, 74, 0, 151, 95, 102, 93, 136, 325, 326,
+	92, 199, 80, 185, 77, 81, 184, 134, 171, 177,
+	128, 125, 76, 175, 126, 124, 116, 99, 109, 143,
+	123, 144, 110, 131, 130, 132, 0, 0, 0, 165,
+	182, 200, 83, 0, 160, 170, 190, 191, 192, 193,
+	194, 195, 0, 0, 84, 103, 97, 142, 133, 82,
+	111, 161, 115, 122, 150, 198, 139, 155, 87, 181,
+	162, 357, 368, 363, 364, 361, 362, 360, 359, 358,
+	370, 349, 350, 351, 352, 354, 0, 365, 366, 353,
+	71, 78, 119, 0, 149, 100, 183, 197, 94, 88,
+	70, 0, 0, 0, 310, 0, 0, 0, 96, 0,
+	307, 0, 0, 0, 118, 356, 120, 0, 0, 164,
+	129, 0, 0, 0, 0, 0, 347, 348, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 333, 0, 57,
+	138, 0, 0, 104, 0, 0, 308, 334, 336, 337,
+	338, 339, 0, 0, 85, 335, 0, 0, 340, 341,
+	342, 0, 0, 0, 305, 322, 0, 355, 0, 0,
+	0, 98, 137, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	319, 320, 0, 0, 0, 0, 369, 0, 321, 0,
+	0, 0, 0, 0, 344, 316, 317, 318, 323, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 105, 0,
+	0, 0, 0, 187, 0, 0, 367, 0, 147, 0,
+	167, 108, 117, 72, 79, 0, 107, 135, 152, 156,
+	0, 0, 0, 324, 0, 154, 140, 179, 0, 141,
+	153, 121, 172, 148, 0, 0, 180, 146, 106, 90,
+	159, 1529, 163, 1527, 1528, 0, 331, 201, 332, 188,
+	189, 169, 186, 196, 73, 168, 178, 86, 157, 75,
+	176, 166, 127, 113, 114, 74, 0, 151, 95, 102,
+	93, 136, 325, 326, 92, 199, 80, 185, 77, 81,
+	184, 134, 171, 177, 128, 125, 76, 175, 126, 124,
+	116, 99, 109, 143, 123, 144, 110, 131, 130, 132,
+	0, 0, 0, 165, 182, 200, 83, 0, 160, 170,
+	190, 191, 192, 193, 194, 195, 0, 0, 84, 103,
+	97, 142, 133, 82, 111, 161, 115, 122, 150, 198,
+	139, 155, 87, 181, 162, 357, 368, 363, 364, 361,
+	362, 360, 359, 358, 370, 349, 350, 351, 352, 354,
+	0, 365, 366, 353, 71, 78, 119, 0, 149, 100,
+	183, 197, 94, 88, 70, 0, 0, 0, 0, 0,
+	0, 0, 96, 0, 0, 0, 0, 0, 118, 356,
+	120, 0, 0, 164, 129, 0, 0, 0, 0, 0,
+	347, 348, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 333, 0, 57, 138, 0, 0, 104, 0, 0,
+	308, 334, 336, 337, 338, 339, 0, 0, 85, 335,
+	0, 0, 340, 341, 342, 0, 0, 0, 0, 322,
+	0, 355, 0, 0, 0, 98, 137, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 319, 320, 0, 0, 0, 0,
+	369, 0, 321, 0, 0, 0, 0, 0, 344, 316,
+	317, 318, 323, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 105, 0, 0, 0, 0, 187, 0, 0,
+	367, 0, 147, 0, 167, 108, 117, 72, 79, 0,
+	107, 135, 152, 156, 0, 0, 0, 324, 0, 154,
+	140, 179, 1628, 141, 153, 121, 172, 148, 0, 0,
+	180, 146, 106, 90, 159, 112, 163, 158, 89, 0,
+	331, 201, 332, 188, 189, 169, 186, 196, 73, 168,
+	178, 86, 157, 75, 176, 166, 127, 113, 114, 74,
+	0, 151, 95, 102, 93, 136, 325, 326, 92, 199,
+	80, 185, 77, 81, 184, 134, 171, 177, 128, 125,
+	76, 175, 126, 124, 116, 99, 109, 143, 123, 144,
+	110, 131, 130, 132, 0, 0, 0, 165, 182, 200,
+	83, 0, 160, 170, 190, 191, 192, 193, 194, 195,
+	0, 0, 84, 103, 97, 142, 133, 82, 111, 161,
+	115, 122, 150, 198, 139, 155, 87, 181, 162, 357,
+	368, 363, 364, 361, 362, 360, 359, 358, 370, 349,
+	350, 351, 352, 354, 0, 365, 366, 353, 71, 78,
+	119, 0, 149, 100, 183, 197, 94, 88, 70, 0,
+	0, 0, 0, 0, 0, 0, 96, 0, 0, 0,
+	0, 0, 118, 356, 120, 0, 0, 164, 129, 0,
+	0, 0, 0, 0, 347, 348, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 333, 0, 57, 138, 0,
+	0, 104, 0, 553, 308, 334, 336, 337, 338, 339,
+	0, 0, 85, 335, 0, 0, 340, 341, 342, 0,
+	0, 0, 0, 322, 0, 355, 0, 0, 0, 98,
+	137, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 319, 320,
+	0, 0, 0, 0, 369, 0, 321, 0, 0, 0,
+	0, 0, 344, 316, 317, 318, 323, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 105, 0, 0, 0,
+	0, 187, 0, 0, 367, 0, 147, 0, 167, 108,
+	117, 72, 79, 0, 107, 135, 152, 156, 0, 0,
+	0, 324, 0, 154, 140, 179, 0, 141, 153, 121,
+	172, 148, 0, 0, 180, 146, 106, 90, 159, 112,
+	163, 158, 89, 0, 331, 201, 332, 188, 189, 169,
+	186, 196, 73, 168, 178, 86, 157, 75, 176, 166,
+	127, 113, 114, 74, 0, 151, 95, 102, 93, 136,
+	325, 326, 92, 199, 80, 185, 77, 81, 184, 134,
+	171, 177, 128, 125, 76, 175, 126, 124, 116, 99,
+	109, 143, 123, 144, 110, 131, 130, 132, 0, 0,
+	0, 165, 182, 200, 83, 0, 160, 170, 190, 191,
+	192, 193, 194, 195, 0, 0, 84, 103, 97, 142,
+	133, 82, 111, 161, 115, 122, 150, 198, 139, 155,
+	87, 181, 162, 357, 368, 363, 364, 361, 362, 360,
+	359, 358, 370, 349, 350, 351, 352, 354, 0, 365,
+	366, 353, 71, 78, 119, 0, 149, 100, 183, 197,
+	94, 88, 70, 0, 0, 0, 0, 0, 0, 0,
+	96, 0, 0, 0, 0, 0, 118, 356, 120, 0,
+	0, 164, 129, 0, 0, 0, 0, 0, 347, 348,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 333,
+	0, 57, 138, 0, 0, 104, 0, 0, 308, 334,
+	336, 337, 338, 339, 0, 0, 85, 335, 0, 0,
+	340, 341, 342, 0, 0, 0, 0, 322, 0, 355,
+	0, 0, 0, 98, 137, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 319, 320, 0, 0, 0, 0, 369, 0,
+	321, 0, 0, 0, 0, 0, 344, 316, 317, 318,
+	323, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	105, 0, 0, 0, 0, 187, 0, 0, 367, 0,
+	147, 0, 167, 108, 117, 72, 79, 0, 107, 135,
+	152, 156, 0, 0, 0, 324, 0, 154, 140, 179,
+	0, 141, 153, 121, 172, 148, 0, 0, 180, 146,
+	106, 90, 159, 112, 163, 158, 89, 0, 331, 201,
+	332, 188, 189, 169, 186, 196, 73, 168, 178, 86,
+	157, 75, 176, 166, 127, 113, 114, 74, 0, 151,
+	95, 102, 93, 136, 325, 326, 92, 199, 80, 185,
+	77, 81, 184, 134, 171, 177, 128, 125, 76, 175,
+	126, 124, 116, 99, 109, 143, 123, 144, 110, 131,
+	130, 132, 0, 0, 0, 165, 182, 200, 83, 0,
+	160, 170, 190, 191, 192, 193, 194, 195, 0, 0,
+	84, 103, 97, 142, 133, 82, 111, 161, 115, 122,
+	150, 198, 139, 155, 87, 181, 162, 357, 368, 363,
+	364, 361, 362, 360, 359, 358, 370, 349, 350, 351,
+	352, 354, 0, 365, 366, 353, 71, 78, 119, 0,
+	149, 100, 183, 197, 94, 88, 70, 0, 0, 0,
+	0, 0, 0, 0, 96, 0, 0, 0, 0, 0,
+	118, 0, 120, 0, 0, 164, 129, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 138, 0, 0, 104,
+	0, 0, 226, 0, 0, 0, 0, 0, 613, 0,
+	85, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 98, 137, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 600, 599, 598, 609, 610, 602, 603, 604, 605,
+	606, 607, 608, 601, 0, 0, 0, 0, 0, 611,
+	615, 0, 0, 0, 0, 0, 614, 0, 0, 0,
+	0, 0, 0, 0, 105, 0, 0, 0, 0, 187,
+	0, 0, 0, 0, 147, 0, 167, 108, 117, 72,
+	79, 0, 107, 135, 152, 156, 0, 0, 0, 91,
+	0, 154, 140, 179, 0, 141, 153, 121, 172, 148,
+	0, 0, 180, 146, 106, 90, 159, 112, 163, 158,
+	89, 0, 101, 201, 145, 188, 189, 169, 186, 196,
+	73, 168, 178, 86, 157, 75, 176, 166, 127, 113,
+	114, 74, 0, 151, 95, 102, 93, 136, 173, 174,
+	92, 199, 80, 185, 77, 81, 184, 134, 171, 177,
+	128, 125, 76, 175, 126, 124, 116, 99, 109, 143,
+	123, 144, 110, 131, 130, 132, 0, 0, 0, 165,
+	182, 200, 83, 0, 160, 170, 190, 191, 192, 193,
+	194, 195, 0, 0, 84, 103, 97, 142, 133, 82,
+	111, 161, 115, 122, 150, 198, 139, 155, 87, 181,
+	162, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 197, 94, 88, 70, 0, 0, 0, 0,
+	71, 78, 119, 96, 149, 100, 183, 0, 612, 118,
+	0, 120, 0, 0, 164, 129, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 333, 0, 0, 138, 0, 0, 104, 0,
+	0, 308, 334, 336, 337, 338, 339, 0, 0, 85,
+	335, 0, 0, 340, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 98, 137, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 344,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 105, 0, 0, 0, 0, 187, 0,
+	0, 0, 0, 147, 0, 167, 108, 117, 72, 79,
+	0, 107, 135, 152, 156, 0, 0, 0, 91, 0,
+	154, 140, 179, 0, 141, 153, 121, 172, 148, 0,
+	0, 180, 146, 106, 90, 159, 112, 163, 158, 89,
+	0, 101, 201, 145, 188, 189, 169, 186, 196, 73,
+	168, 178, 86, 157, 75, 176, 166, 127, 113, 114,
+	74, 0, 151, 95, 102, 93, 136, 173, 174, 92,
+	199, 80, 185, 77, 81, 184, 134, 171, 177, 128,
+	125, 76, 175, 126, 124, 116, 99, 109, 143, 123,
+	144, 110, 131, 130, 132, 0, 0, 0, 165, 182,
+	200, 83, 0, 160, 170, 190, 191, 192, 193, 194,
+	195, 0, 0, 84, 103, 97, 142, 133, 82, 111,
+	161, 115, 122, 150, 198, 139, 155, 87, 181, 162,
+	0, 0, 26, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 197, 94, 88, 70, 71,
+	78, 119, 0, 149, 100, 183, 96, 0, 0, 0,
+	0, 0, 118, 934, 120, 0, 0, 164, 129, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 57, 138, 0,
+	0, 104, 0, 0, 688, 0, 0, 0, 0, 0,
+	0, 0, 85, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 98,
+	137, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 105, 0, 0, 0,
+	0, 187, 0, 0, 0, 0, 147, 0, 167, 108,
+	117, 72, 79, 0, 107, 135, 152, 156, 0, 0,
+	0, 91, 0, 154, 140, 179, 0, 141, 153, 121,
+	172, 148, 0, 0, 180, 146, 106, 90, 159, 112,
+	163, 158, 89, 0, 101, 201, 145, 188, 189, 169,
+	186, 196, 73, 168, 178, 86, 157, 75, 176, 166,
+	127, 113, 114, 74, 0, 151, 95, 102, 93, 136,
+	173, 174, 92, 199, 80, 185, 77, 81, 184, 134,
+	171, 177, 128, 125, 76, 175, 126, 124, 116, 99,
+	109, 143, 123, 144, 110, 131, 130, 132, 0, 0,
+	0, 165, 182, 200, 83, 0, 160, 170, 190, 191,
+	192, 193, 194, 195, 0, 0, 84, 103, 97, 142,
+	133, 82, 111, 161, 115, 122, 150, 198, 139, 155,
+	87, 181, 162, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 71, 78, 119, 23, 149, 100, 183, 197,
+	94, 88, 70, 0, 0, 583, 0, 0, 0, 0,
+	96, 0, 0, 0, 0, 0, 118, 0, 120, 0,
+	0, 164, 129, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 585,
+	0, 0, 0, 0, 0, 104, 0, 0, 226, 0,
+	0, 0, 0, 0, 0, 0, 85, 0, 0, 0,
+	0, 0, 0, 0, 580, 579, 0, 0, 0, 0,
+	0, 0, 0, 98, 137, 0, 0, 0, 0, 0,
+	0, 0, 581, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	105, 0, 0, 0, 0, 187, 0, 0, 0, 0,
+	147, 0, 167, 108, 117, 72, 79, 0, 107, 135,
+	152, 156, 0, 0, 0, 91, 0, 154, 140, 179,
+	0, 141, 153, 121, 172, 148, 0, 0, 180, 146,
+	106, 90, 159, 112, 163, 158, 89, 0, 101, 201,
+	145, 188, 189, 169, 186, 196, 73, 168, 178, 86,
+	157, 75, 176, 166, 127, 113, 114, 74, 0, 151,
+	95, 102, 93, 136, 173, 174, 92, 199, 80, 185,
+	77, 81, 184, 134, 171, 177, 128, 125, 76, 175,
+	126, 124, 116, 99, 109, 143, 123, 144, 110, 131,
+	130, 132, 0, 0, 0, 165, 182, 200, 83, 0,
+	160, 170, 190, 191, 192, 193, 194, 195, 0, 0,
+	84, 103, 97, 142, 133, 82, 111, 161, 115, 122,
+	150, 198, 139, 155, 87, 181, 162, 0, 0, 26,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 197, 94, 88, 70, 71, 78, 119, 0,
+	149, 100, 183, 96, 0, 0, 0, 0, 0, 118,
+	0, 120, 0, 0, 164, 129, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 57, 138, 0, 0, 104, 0,
+	0, 226, 0, 0, 0, 0, 0, 0, 0, 85,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 98, 137, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 105, 0, 0, 0, 0, 187, 0,
+	0, 0, 0, 147, 0, 167, 108, 117, 72, 79,
+	0, 107, 135, 152, 156, 0, 0, 0, 91, 0,
+	154, 140, 179, 0, 141, 153, 121, 172, 148, 0,
+	0, 180, 146, 106, 90, 159, 112, 163, 158, 89,
+	0, 101, 201, 145, 188, 189, 169, 186, 196, 73,
+	168, 178, 86, 157, 75, 176, 166, 127, 113, 114,
+	74, 0, 151, 95, 102, 93, 136, 173, 174, 92,
+	199, 80, 185, 77, 81, 184, 134, 171, 177, 128,
+	125, 76, 175, 126, 124, 116, 99, 109, 143, 123,
+	144, 110, 131, 130, 132, 0, 0, 0, 165, 182,
+	200, 83, 0, 160, 170, 190, 191, 192, 193, 194,
+	195, 0, 0, 84, 103, 97, 142, 133, 82, 111,
+	161, 115, 122, 150, 198, 139, 155, 87, 181, 162,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 197, 94, 88, 70, 71,
+	78, 119, 23, 149, 100, 183, 96, 0, 0, 0,
+	0, 0, 118, 0, 120, 0, 0, 164, 129, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 138, 0,
+	0, 104, 0, 0, 876, 0, 0, 0, 0, 0,
+	0, 0, 85, 0, 878, 879, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 98,
+	137, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 105, 0, 0, 0,
+	0, 187, 0, 0, 0, 0, 147, 0, 167, 108,
+	117, 72, 79, 0, 107, 135, 152, 156, 0, 0,
+	0, 91, 0, 154, 140, 179, 0, 141, 153, 121,
+	172, 148, 0, 0, 180, 146, 106, 90, 159, 112,
+	163, 158, 89, 0, 101, 201, 145, 188, 189, 169,
+	186, 196, 73, 168, 178, 86, 157, 75, 176, 166,
+	127, 113, 114, 74, 0, 151, 95, 102, 93, 136,
+	173, 174, 92, 199, 80, 185, 77, 81, 184, 134,
+	171, 177, 128, 125, 76, 175, 126, 124, 116, 99,
+	109, 143, 123, 144, 110, 131, 130, 132, 0, 0,
+	0, 165, 182, 200, 83, 0, 160, 170, 190, 191,
+	192, 193, 194, 195, 0, 0, 84, 103, 97, 142,
+	133, 82, 111, 161, 115, 122, 150, 198, 139, 155,
+	87, 181, 162, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 197, 94, 88, 70, 0, 0,
+	0, 0, 71, 78, 119, 96, 149, 100, 183, 0,
+	0, 118, 0, 120, 0, 0, 164, 129, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 585, 0, 0, 138, 0, 0,
+	104, 0, 0, 226, 0, 0, 0, 0, 0, 0,
+	0, 85, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 98, 137,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 837, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 105, 0, 0, 0, 0,
+	187, 0, 0, 0, 0, 147, 0, 167, 108, 117,
+	72, 79, 0, 107, 135, 152, 156, 0, 0, 0,
+	91, 0, 154, 140, 179, 0, 141, 153, 121, 172,
+	148, 0, 0, 180, 146, 106, 90, 159, 112, 163,
+	158, 89, 0, 101, 201, 145, 188, 189, 169, 186,
+	196, 73, 168, 178, 86, 157, 75, 176, 166, 127,
+	113, 114, 74, 0, 151, 95, 102, 93, 136, 173,
+	174, 92, 199, 80, 185, 77, 81, 184, 134, 171,
+	177, 128, 125, 76, 175, 126, 124, 116, 99, 109,
+	143, 123, 144, 110, 131, 130, 132, 0, 0, 0,
+	165, 182, 200, 83, 0, 160, 170, 190, 191, 192,
+	193, 194, 195, 0, 0, 84, 103, 97, 142, 133,
+	82, 111, 161, 115, 122, 150, 198, 139, 155, 87,
+	181, 162, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 197, 94, 88, 70, 0, 0, 0,
+	0, 71, 78, 119, 96, 149, 100, 183, 0, 0,
+	118, 0, 120, 0, 0, 164, 129, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 138, 0, 0, 104,
+	0, 0, 226, 0, 823, 0, 0, 824, 0, 0,
+	85, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 98, 137, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 105, 0, 0, 0, 0, 187,
+	0, 0, 0, 0, 147, 0, 167, 108, 117, 72,
+	79, 0, 107, 135, 152, 156, 0, 0, 0, 91,
+	0, 154, 140, 179, 0, 141, 153, 121, 172, 148,
+	0, 0, 180, 146, 106, 90, 159, 112, 163, 158,
+	89, 0, 101, 201, 145, 188, 189, 169, 186, 196,
+	73, 168, 178, 86, 157, 75, 176, 166, 127, 113,
+	114, 74, 0, 151, 95, 102, 93, 136, 173, 174,
+	92, 199, 80, 185, 77, 81, 184, 134, 171, 177,
+	128, 125, 76, 175, 126, 124, 116, 99, 109, 143,
+	123, 144, 110, 131, 130, 132, 0, 0, 0, 165,
+	182, 200, 83, 0, 160, 170, 190, 191, 192, 193,
+	194, 195, 0, 0, 84, 103, 97, 142, 133, 82,
+	111, 161, 115, 122, 150, 198, 139, 155, 87, 181,
+	162, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 197, 94, 88, 70,
+	71, 78, 119, 0, 149, 100, 183, 96, 0, 710,
+	0, 0, 0, 118, 0, 120, 0, 0, 164, 129,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 709, 0, 0, 138,
+	0, 0, 104, 0, 0, 226, 0, 0, 0, 0,
+	0, 0, 0, 85, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	98, 137, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 105, 0, 0,
+	0, 0, 187, 0, 0, 0, 0, 147, 0, 167,
+	108, 117, 72, 79, 0, 107, 135, 152, 156, 0,
+	0, 0, 91, 0, 154, 140, 179, 0, 141, 153,
+	121, 172, 148, 0, 0, 180, 146, 106, 90, 159,
+	112, 163, 158, 89, 0, 101, 201, 145, 188, 189,
+	169, 186, 196, 73, 168, 178, 86, 157, 75, 176,
+	166, 127, 113, 114, 74, 0, 151, 95, 102, 93,
+	136, 173, 174, 92, 199, 80, 185, 77, 81, 184,
+	134, 171, 177, 128, 125, 76, 175, 126, 124, 116,
+	99, 109, 143, 123, 144, 110, 131, 130, 132, 0,
+	0, 0, 165, 182, 200, 83, 0, 160, 170, 190,
+	191, 192, 193, 194, 195, 0, 0, 84, 103, 97,
+	142, 133, 82, 111, 161, 115, 122, 150, 198, 139,
+	155, 87, 181, 162, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 197, 94, 88, 70, 0,
+	0, 0, 0, 71, 78, 119, 96, 149, 100, 183,
+	0, 0, 118, 0, 120, 0, 0, 164, 129, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 67, 0, 0, 138, 0,
+	0, 104, 0, 0, 68, 0, 0, 0, 0, 0,
+	0, 0, 85, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 98,
-	0, 0, 0, 0, 172, 0, 0, 0, 0, 136,
-	0, 153, 100, 108, 69, 76, 0, 99, 126, 141,
-	145, 0, 0, 0, 86, 0, 143, 131, 165, 0,
-	132, 142, 112, 158, 137, 0, 173, 174, 155, 171,
-	181, 70, 154, 164, 83, 146, 72, 162, 152, 118,
-	104, 105, 71, 0, 140, 90, 96, 88, 127, 159,
-	160, 87, 184, 77, 170, 74, 78, 169, 125, 157,
-	163, 119, 116, 73, 161, 117, 115, 107, 94, 101,
-	134, 114, 135, 102, 122, 121, 123, 0, 0, 0,
-	151, 167, 185, 80, 0, 147, 156, 175, 176, 177,
-	178, 179, 180, 0, 0, 81, 97, 92, 133, 124,
-	79, 103, 148, 106, 113, 139, 183, 130, 144, 84,
-	166, 149, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 68, 75, 110, 0, 138, 95, 168, 129, 0,
-	182, 89, 85, 67, 0, 0, 0, 0, 0, 0,
-	630, 91, 0, 0, 0, 0, 0, 109, 0, 111,
-	0, 0, 150, 120, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 65, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 93, 128,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 98, 0, 0, 0, 0, 172, 0,
-	0, 0, 0, 136, 0, 153, 100, 108, 69, 76,
-	0, 99, 126, 141, 145, 0, 0, 0, 86, 0,
-	143, 131, 165, 0, 132, 142, 112, 158, 137, 0,
-	173, 174, 155, 171, 181, 70, 154, 164, 83, 146,
-	72, 162, 152, 118, 104, 105, 71, 0, 140, 90,
-	96, 88, 127, 159, 160, 87, 184, 77, 170, 74,
-	78, 169, 125, 157, 163, 119, 116, 73, 161, 117,
-	115, 107, 94, 101, 134, 114, 135, 102, 122, 121,
-	123, 0, 0, 0, 151, 167, 185, 80, 0, 147,
-	156, 175, 176, 177, 178, 179, 180, 0, 0, 81,
-	97, 92, 133, 124, 79, 103, 148, 106, 113, 139,
-	183, 130, 144, 84, 166, 149, 0, 0, 341, 0,
-	0, 0, 0, 0, 0, 129, 0, 182, 89, 85,
-	67, 0, 0, 0, 0, 68, 75, 110, 91, 138,
-	95, 168, 0, 0, 109, 0, 111, 0, 0, 150,
-	120, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 65,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 93, 128, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	98, 0, 0, 0, 0, 172, 0, 0, 0, 0,
-	136, 0, 153, 100, 108, 69, 76, 0, 99, 126,
-	141, 145, 0, 0, 0, 86, 0, 143, 131, 165,
-	0, 132, 142, 112, 158, 137, 0, 173, 174, 155,
-	171, 181, 70, 154, 164, 83, 146, 72, 162, 152,
-	118, 104, 105, 71, 0, 140, 90, 96, 88, 127,
-	159, 160, 87, 184, 77, 170, 74, 78, 169, 125,
-	157, 163, 119, 116, 73, 161, 117, 115, 107, 94,
-	101, 134, 114, 135, 102, 122, 121, 123, 0, 0,
-	0, 151, 167, 185, 80, 0, 147, 156, 175, 176,
-	177, 178, 179, 180, 0, 0, 81, 97, 92, 133,
-	124, 79, 103, 148, 106, 113, 139, 183, 130, 144,
-	84, 166, 149, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 129, 0, 182, 89, 85, 67, 0, 0,
-	0, 0, 68, 75, 110, 91, 138, 95, 168, 0,
-	0, 109, 0, 111, 0, 0, 150, 120, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 65, 0, 0, 0,
-	0, 0, 0, 0, 0, 82, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 93, 128, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 98, 0, 219,
-	0, 0, 172, 0, 0, 0, 0, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 0,
-	0, 0, 86, 0, 143, 131, 165, 0, 132, 142,
-	112, 158, 137, 0, 173, 174, 155, 171, 181, 70,
-	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
-	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
-	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 0, 0, 151, 167,
-	185, 80, 0, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
-	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	0, 182, 89, 85, 67, 0, 0, 0, 0, 68,
-	75, 110, 91, 138, 95, 168, 0, 0, 109, 0,
-	111, 0, 0, 150, 120, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 207, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 93,
-	128, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 98, 0, 0, 0, 0, 172,
-	0, 0, 0, 0, 136, 0, 153, 100, 108, 69,
-	76, 0, 99, 126, 141, 145, 0, 0, 0, 86,
-	0, 143, 131, 165, 0, 132, 142, 112, 158, 137,
-	0, 173, 174, 155, 171, 181, 70, 154, 164, 83,
-	146, 72, 162, 152, 118, 104, 105, 71, 0, 140,
-	90, 96, 88, 127, 159, 160, 87, 184, 77, 170,
-	74, 78, 169, 125, 157, 163, 119, 116, 73, 161,
-	117, 115, 107, 94, 101, 134, 114, 135, 102, 122,
-	121, 123, 0, 0, 0, 151, 167, 185, 80, 0,
-	147, 156, 175, 176, 177, 178, 179, 180, 0, 0,
-	81, 97, 92, 133, 124, 79, 103, 148, 106, 113,
-	139, 183, 130, 144, 84, 166, 149, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 129, 0, 182, 89,
-	85, 67, 0, 0, 0, 0, 68, 75, 110, 91,
-	138, 95, 168, 0, 0, 109, 0, 111, 0, 0,
-	150, 120, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	65, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 93, 128, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 98, 0, 0, 0, 0, 172, 0, 0, 0,
-	0, 136, 0, 153, 100, 108, 69, 76, 0, 99,
-	126, 141, 145, 0, 0, 0, 86, 0, 143, 131,
-	165, 0, 132, 142, 112, 158, 137, 0, 173, 174,
-	155, 171, 181, 70, 154, 164, 83, 146, 72, 162,
-	152, 118, 104, 105, 71, 0, 140, 90, 96, 88,
-	127, 159, 160, 87, 184, 77, 170, 74, 78, 169,
-	125, 157, 163, 119, 116, 73, 161, 117, 115, 107,
-	94, 101, 134, 114, 135, 102, 122, 121, 123, 0,
-	0, 0, 151, 167, 185, 80, 0, 147, 156, 175,
-	176, 177, 178, 179, 180, 0, 0, 81, 97, 92,
-	133, 124, 79, 103, 148, 106, 113, 139, 183, 130,
-	144, 84, 166, 149, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 129, 0, 182, 89, 85, 67, 0,
-	0, 0, 0, 68, 75, 110, 91, 138, 95, 168,
-	0, 0, 109, 0, 111, 0, 0, 150, 120, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 282, 0, 0,
-	0, 0, 0, 0, 0, 0, 82, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 93, 128, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 98, 0,
-	0, 0, 0, 172, 0, 0, 0, 0, 136, 0,
-	153, 100, 108, 69, 76, 0, 99, 126, 141, 145,
-	0, 0, 0, 86, 0, 143, 131, 165, 0, 132,
-	142, 112, 158, 137, 0, 173, 174, 155, 171, 181,
-	70, 154, 164, 83, 146, 72, 162, 152, 118, 104,
-	105, 71, 0, 140, 90, 96, 88, 127, 159, 160,
-	87, 184, 77, 170, 74, 78, 169, 125, 157, 163,
-	119, 116, 73, 161, 117, 115, 107, 94, 101, 134,
-	114, 135, 102, 122, 121, 123, 0, 0, 0, 151,
-	167, 185, 80, 0, 147, 156, 175, 176, 177, 178,
-	179, 180, 0, 0, 81, 97, 92, 133, 124, 79,
-	103, 148, 106, 113, 139, 183, 130, 144, 84, 166,
-	149, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	68, 75, 110, 0, 138, 95, 168,
+	137, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 105, 0, 0, 0,
+	0, 187, 0, 0, 0, 0, 147, 0, 167, 108,
+	117, 72, 79, 0, 107, 135, 152, 156, 0, 0,
+	0, 91, 0, 154, 140, 179, 0, 141, 153, 121,
+	172, 148, 0, 0, 180, 146, 106, 90, 159, 112,
+	163, 158, 89, 63, 101, 201, 145, 188, 189, 169,
+	186, 196, 73, 168, 178, 86, 157, 75, 176, 166,
+	127, 113, 114, 74, 0, 151, 95, 102, 93, 136,
+	173, 174, 92, 199, 80, 185, 77, 81, 184, 134,
+	171, 177, 128, 125, 76, 175, 126, 124, 116, 99,
+	109, 143, 123, 144, 110, 131, 130, 132, 0, 0,
+	0, 165, 182, 200, 83, 0, 160, 170, 190, 191,
+	192, 193, 194, 195, 0, 0, 84, 103, 97, 142,
+	133, 82, 111, 161, 115, 122, 150, 198, 139, 155,
+	87, 181, 162, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 197, 94, 88, 70, 0, 0,
+	0, 0, 71, 78, 119, 96, 149, 100, 183, 0,
+	0, 118, 0, 120, 0, 0, 164, 129, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 138, 0, 0,
+	104, 0, 0, 308, 0, 0, 0, 0, 0, 0,
+	0, 85, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 98, 137,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 344, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 105, 0, 0, 0, 0,
+	187, 0, 0, 0, 0, 147, 0, 167, 108, 117,
+	72, 79, 0, 107, 135, 152, 156, 0, 0, 0,
+	91, 0, 154, 140, 179, 0, 141, 153, 121, 172,
+	148, 0, 0, 180, 146, 106, 90, 159, 112, 163,
+	158, 89, 0, 101, 201, 145, 188, 189, 169, 186,
+	196, 73, 168, 178, 86, 157, 75, 176, 166, 127,
+	113, 114, 74, 0, 151, 95, 102, 93, 136, 173,
+	174, 92, 199, 80, 185, 77, 81, 184, 134, 171,
+	177, 128, 125, 76, 175, 126, 124, 116, 99, 109,
+	143, 123, 144, 110, 131, 130, 132, 0, 0, 0,
+	165, 182, 200, 83, 0, 160, 170, 190, 191, 192,
+	193, 194, 195, 0, 0, 84, 103, 97, 142, 133,
+	82, 111, 161, 115, 122, 150, 198, 139, 155, 87,
+	181, 162, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 197, 94, 88, 70, 0, 0, 0,
+	0, 71, 78, 119, 96, 149, 100, 183, 0, 0,
+	118, 0, 120, 0, 0, 164, 129, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 57, 138, 0, 0, 104,
+	0, 0, 688, 0, 0, 0, 0, 0, 0, 0,
+	85, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 98, 137, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 105, 0, 0, 0, 0, 187,
+	0, 0, 0, 0, 147, 0, 167, 108, 117, 72,
+	79, 0, 107, 135, 152, 156, 0, 0, 0, 91,
+	0, 154, 140, 179, 0, 141, 153, 121, 172, 148,
+	0, 0, 180, 146, 106, 90, 159, 112, 163, 158,
+	89, 0, 101, 201, 145, 188, 189, 169, 186, 196,
+	73, 168, 178, 86, 157, 75, 176, 166, 127, 113,
+	114, 74, 0, 151, 95, 102, 93, 136, 173, 174,
+	92, 199, 80, 185, 77, 81, 184, 134, 171, 177,
+	128, 125, 76, 175, 126, 124, 116, 99, 109, 143,
+	123, 144, 110, 131, 130, 132, 0, 0, 0, 165,
+	182, 200, 83, 0, 160, 170, 190, 191, 192, 193,
+	194, 195, 0, 0, 84, 103, 97, 142, 133, 82,
+	111, 161, 115, 122, 150, 198, 139, 155, 87, 181,
+	162, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 197, 94, 88, 70, 0, 0, 941, 0,
+	71, 78, 119, 96, 149, 100, 183, 0, 0, 118,
+	0, 120, 0, 0, 164, 129, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 67, 0, 0, 0, 0, 0, 104, 0,
+	0, 68, 0, 0, 0, 0, 0, 0, 0, 85,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 98, 137, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 105, 0, 0, 0, 0, 187, 0,
+	0, 0, 0, 147, 0, 167, 108, 117, 72, 79,
+	0, 107, 135, 152, 156, 0, 0, 0, 91, 0,
+	154, 140, 179, 0, 141, 153, 121, 172, 148, 0,
+	0, 180, 146, 106, 90, 159, 112, 163, 158, 89,
+	0, 101, 201, 145, 188, 189, 169, 186, 196, 73,
+	168, 178, 86, 157, 75, 176, 166, 127, 113, 114,
+	74, 0, 151, 95, 102, 93, 136, 173, 174, 92,
+	199, 80, 185, 77, 81, 184, 134, 171, 177, 128,
+	125, 76, 175, 126, 124, 116, 99, 109, 143, 123,
+	144, 110, 131, 130, 132, 0, 0, 0, 165, 182,
+	200, 83, 0, 160, 170, 190, 191, 192, 193, 194,
+	195, 0, 0, 84, 103, 97, 142, 133, 82, 111,
+	161, 115, 122, 150, 198, 139, 155, 87, 181, 162,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 197, 94, 88, 70, 0, 0, 0, 0, 71,
+	78, 119, 96, 149, 100, 183, 0, 0, 118, 0,
+	120, 0, 0, 164, 129, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 67, 0, 0, 138, 0, 0, 104, 0, 0,
+	68, 0, 0, 0, 0, 0, 0, 0, 85, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 137, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 105, 0, 0, 0, 0, 187, 0, 0,
+	0, 0, 147, 0, 167, 108, 117, 72, 79, 0,
+	107, 135, 152, 156, 0, 0, 0, 91, 0, 154,
+	140, 179, 0, 141, 153, 121, 172, 148, 0, 0,
+	180, 146, 106, 90, 159, 112, 163, 158, 89, 0,
+	101, 201, 145, 188, 189, 169, 186, 196, 73, 168,
+	178, 86, 157, 75, 176, 166, 127, 113, 114, 74,
+	0, 151, 95, 102, 93, 136, 173, 174, 92, 199,
+	80, 185, 77, 81, 184, 134, 171, 177, 128, 125,
+	76, 175, 126, 124, 116, 99, 109, 143, 123, 144,
+	110, 131, 130, 132, 0, 0, 0, 165, 182, 200,
+	83, 0, 160, 170, 190, 191, 192, 193, 194, 195,
+	0, 0, 84, 103, 97, 142, 133, 82, 111, 161,
+	115, 122, 150, 198, 139, 155, 87, 181, 162, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	197, 94, 88, 70, 0, 0, 941, 0, 71, 78,
+	119, 96, 149, 100, 183, 0, 0, 118, 0, 120,
+	0, 0, 164, 129, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	67, 0, 0, 0, 0, 0, 104, 0, 0, 68,
+	0, 0, 0, 0, 0, 0, 0, 85, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 98, 137, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 105, 0, 0, 0, 0, 187, 0, 0, 0,
+	0, 147, 0, 167, 108, 117, 72, 79, 0, 107,
+	135, 152, 156, 0, 0, 0, 91, 0, 154, 140,
+	179, 0, 939, 153, 121, 172, 148, 0, 0, 180,
+	146, 106, 90, 159, 112, 163, 158, 89, 0, 101,
+	201, 145, 188, 189, 169, 186, 196, 73, 168, 178,
+	86, 157, 75, 176, 166, 127, 113, 114, 74, 0,
+	151, 95, 102, 93, 136, 173, 174, 92, 199, 80,
+	185, 77, 81, 184, 134, 171, 177, 128, 125, 76,
+	175, 126, 124, 116, 99, 109, 143, 123, 144, 110,
+	131, 130, 132, 0, 0, 0, 165, 182, 200, 83,
+	0, 160, 170, 190, 191, 192, 193, 194, 195, 0,
+	0, 84, 103, 97, 142, 133, 82, 111, 161, 115,
+	122, 150, 198, 139, 155, 87, 181, 162, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 71, 78, 119,
+	0, 149, 100, 183, 197, 94, 88, 70, 0, 0,
+	0, 0, 0, 0, 679, 96, 0, 0, 0, 0,
+	0, 118, 0, 120, 0, 0, 164, 129, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 138, 0, 0,
+	104, 0, 0, 68, 0, 0, 0, 0, 0, 0,
+	0, 85, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 98, 137,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 105, 0, 0, 0, 0,
+	187, 0, 0, 0, 0, 147, 0, 167, 108, 117,
+	72, 79, 0, 107, 135, 152, 156, 0, 0, 0,
+	91, 0, 154, 140, 179, 0, 141, 153, 121, 172,
+	148, 0, 0, 180, 146, 106, 90, 159, 112, 163,
+	158, 89, 0, 101, 201, 145, 188, 189, 169, 186,
+	196, 73, 168, 178, 86, 157, 75, 176, 166, 127,
+	113, 114, 74, 0, 151, 95, 102, 93, 136, 173,
+	174, 92, 199, 80, 185, 77, 81, 184, 134, 171,
+	177, 128, 125, 76, 175, 126, 124, 116, 99, 109,
+	143, 123, 144, 110, 131, 130, 132, 0, 0, 0,
+	165, 182, 200, 83, 0, 160, 170, 190, 191, 192,
+	193, 194, 195, 0, 0, 84, 103, 97, 142, 133,
+	82, 111, 161, 115, 122, 150, 198, 139, 155, 87,
+	181, 162, 0, 0, 0, 373, 0, 0, 0, 0,
+	0, 0, 0, 197, 94, 88, 70, 0, 0, 0,
+	0, 71, 78, 119, 96, 149, 100, 183, 0, 0,
+	118, 0, 120, 0, 0, 164, 129, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 138, 0, 0, 104,
+	0, 0, 68, 0, 0, 0, 0, 0, 0, 0,
+	85, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 98, 137, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 105, 0, 0, 0, 0, 187,
+	0, 0, 0, 0, 147, 0, 167, 108, 117, 72,
+	79, 0, 107, 135, 152, 156, 0, 0, 0, 91,
+	0, 154, 140, 179, 0, 141, 153, 121, 172, 148,
+	0, 0, 180, 146, 106, 90, 159, 112, 163, 158,
+	89, 0, 101, 201, 145, 188, 189, 169, 186, 196,
+	73, 168, 178, 86, 157, 75, 176, 166, 127, 113,
+	114, 74, 0, 151, 95, 102, 93, 136, 173, 174,
+	92, 199, 80, 185, 77, 81, 184, 134, 171, 177,
+	128, 125, 76, 175, 126, 124, 116, 99, 109, 143,
+	123, 144, 110, 131, 130, 132, 0, 0, 0, 165,
+	182, 200, 83, 0, 160, 170, 190, 191, 192, 193,
+	194, 195, 0, 0, 84, 103, 97, 142, 133, 82,
+	111, 161, 115, 122, 150, 198, 139, 155, 87, 181,
+	162, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 197, 94, 88, 70, 0, 0, 0, 0,
+	71, 78, 119, 96, 149, 100, 183, 0, 0, 118,
+	0, 120, 0, 0, 164, 129, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 138, 0, 0, 104, 0,
+	0, 68, 0, 0, 0, 0, 0, 0, 0, 85,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 98, 137, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 105, 0, 238, 0, 0, 187, 0,
+	0, 0, 0, 147, 0, 167, 108, 117, 72, 79,
+	0, 107, 135, 152, 156, 0, 0, 0, 91, 0,
+	154, 140, 179, 0, 141, 153, 121, 172, 148, 0,
+	0, 180, 146, 106, 90, 159, 112, 163, 158, 89,
+	0, 101, 201, 145, 188, 189, 169, 186, 196, 73,
+	168, 178, 86, 157, 75, 176, 166, 127, 113, 114,
+	74, 0, 151, 95, 102, 93, 136, 173, 174, 92,
+	199, 80, 185, 77, 81, 184, 134, 171, 177, 128,
+	125, 76, 175, 126, 124, 116, 99, 109, 143, 123,
+	144, 110, 131, 130, 132, 0, 0, 0, 165, 182,
+	200, 83, 0, 160, 170, 190, 191, 192, 193, 194,
+	195, 0, 0, 84, 103, 97, 142, 133, 82, 111,
+	161, 115, 122, 150, 198, 139, 155, 87, 181, 162,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 197, 94, 88, 70, 0, 0, 0, 0, 71,
+	78, 119, 96, 149, 100, 183, 0, 0, 118, 0,
+	120, 0, 0, 164, 129, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 138, 0, 0, 104, 0, 0,
+	226, 0, 0, 0, 0, 0, 0, 0, 85, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 137, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 105, 0, 0, 0, 0, 187, 0, 0,
+	0, 0, 147, 0, 167, 108, 117, 72, 79, 0,
+	107, 135, 152, 156, 0, 0, 0, 91, 0, 154,
+	140, 179, 0, 141, 153, 121, 172, 148, 0, 0,
+	180, 146, 106, 90, 159, 112, 163, 158, 89, 0,
+	101, 201, 145, 188, 189, 169, 186, 196, 73, 168,
+	178, 86, 157, 75, 176, 166, 127, 113, 114, 74,
+	0, 151, 95, 102, 93, 136, 173, 174, 92, 199,
+	80, 185, 77, 81, 184, 134, 171, 177, 128, 125,
+	76, 175, 126, 124, 116, 99, 109, 143, 123, 144,
+	110, 131, 130, 132, 0, 0, 0, 165, 182, 200,
+	83, 0, 160, 170, 190, 191, 192, 193, 194, 195,
+	0, 0, 84, 103, 97, 142, 133, 82, 111, 161,
+	115, 122, 150, 198, 139, 155, 87, 181, 162, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	197, 94, 88, 70, 0, 0, 0, 0, 71, 78,
+	119, 96, 149, 100, 183, 0, 0, 118, 0, 120,
+	0, 0, 164, 129, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 138, 0, 0, 104, 0, 0, 68,
+	0, 0, 0, 0, 0, 0, 0, 85, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 98, 137, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 105, 0, 0, 0, 0, 187, 0, 0, 0,
+	0, 147, 0, 167, 108, 117, 72, 79, 0, 107,
+	135, 152, 156, 0, 0, 0, 91, 0, 154, 140,
+	179, 0, 141, 153, 121, 172, 148, 0, 0, 180,
+	146, 106, 90, 159, 112, 163, 158, 89, 0, 101,
+	201, 145, 188, 189, 169, 186, 196, 73, 168, 178,
+	86, 157, 75, 176, 166, 127, 113, 114, 74, 0,
+	151, 95, 102, 93, 136, 173, 174, 92, 199, 80,
+	185, 77, 81, 184, 134, 171, 177, 128, 125, 76,
+	175, 126, 124, 116, 99, 109, 143, 123, 144, 110,
+	131, 130, 132, 0, 0, 0, 165, 182, 200, 83,
+	0, 160, 170, 190, 191, 192, 193, 194, 195, 0,
+	0, 84, 103, 97, 142, 133, 82, 111, 161, 115,
+	122, 150, 198, 139, 155, 87, 181, 162, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 197,
+	94, 88, 70, 0, 0, 933, 0, 71, 78, 119,
+	96, 149, 100, 183, 0, 0, 118, 0, 120, 0,
+	0, 164, 129, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 104, 0, 0, 68, 0,
+	0, 0, 0, 0, 0, 0, 85, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 98, 137, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	105, 0, 0, 0, 0, 187, 0, 0, 0, 0,
+	147, 0, 167, 108, 117, 72, 79, 0, 107, 135,
+	152, 156, 0, 0, 0, 91, 0, 154, 140, 179,
+	0, 141, 153, 121, 172, 148, 0, 0, 180, 146,
+	106, 90, 159, 112, 163, 158, 89, 0, 101, 201,
+	145, 188, 189, 169, 186, 196, 73, 168, 178, 86,
+	157, 75, 176, 166, 127, 113, 114, 74, 0, 151,
+	95, 102, 93, 136, 173, 174, 92, 199, 80, 185,
+	77, 81, 184, 134, 171, 177, 128, 125, 76, 175,
+	126, 124, 116, 99, 109, 143, 123, 144, 110, 131,
+	130, 132, 0, 0, 0, 165, 182, 200, 83, 0,
+	160, 170, 190, 191, 192, 193, 194, 195, 0, 0,
+	84, 103, 97, 142, 133, 82, 111, 161, 115, 122,
+	150, 198, 139, 155, 87, 181, 162, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 71, 78, 119, 0,
+	149, 100, 183,
 }
 
-var yyPact = [...]int{
-	1877, -1000, -203, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+var yyPact = [...]int16{
+	2061, -1000, -213, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 980, 12039, 1031, -1000, -1000, -1000, -1000, -1000,
-	-1000, 366, 2951, 27, 176, 75, 13044, 174, 2349, 13538,
-	-1000, 14, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -78,
-	-89, -1000, 84, -1000, -1000, -1000, -1000, -1000, 965, 973,
-	761, -1000, 948, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1022, 13734, 1065, 1062, -1000, -1000, -1000, -1000,
+	-1000, -1000, 456, 3377, 82, 228, 44, 15901, 201, 3045,
+	16439, -1000, 38, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-83, -114, -1000, -1000, -1000, -1000, 114, -1000, -1000, -1000,
+	843, 1017, 783, 14810, -1000, 826, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2184,25 +2528,28 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 813, 953, 886, -1000,
-	7939, 122, 122, 12797, 6354, -1000, -1000, 341, 13538, 162,
-	13538, -167, 119, 119, 119, -1000, -1000, -1000, -1000, 173,
-	13538, 294, -1000, 13538, 117, 655, 117, 117, 117, 13538,
-	-1000, 239, 13538, 652, 3861, 39, 3861, 3861, -1000, 3861,
-	3861, -1000, 3861, 20, 3861, 12, 989, -1000, -1000, -1000,
-	-1000, -64, -1000, 3861, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 540, 925, 8731,
-	8731, 84, 12039, 609, 980, -1000, 84, -1000, -1000, -1000,
-	917, -1000, -1000, 445, 1005, -1000, 2650, 234, -1000, 8731,
-	23, 609, -1000, -1000, 609, -1000, -1000, -1000, -1000, -1000,
-	9523, 9523, 9523, 9523, 9523, 9523, 9523, 9523, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 609, -1000, 7147, 609, 609, 609, 609, 609,
-	609, 609, 609, 8731, 609, 609, 609, 609, 609, 609,
-	609, 609, 609, 609, 609, 609, 609, 609, 609, 12550,
-	11792, 13538, 734, 701, -1000, -1000, 232, 756, 6077, -100,
-	-1000, -1000, -1000, 332, 11545, -1000, -1000, -1000, 916, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 846, 1001, 999, 846, 988, 926, -1000, 9010,
+	146, 146, 15632, 7019, -1000, -1000, 408, 16439, 191, 16439,
+	-182, 141, 141, 141, -1000, -1000, -1000, -1000, 196, 16439,
+	380, -1000, 16439, 140, 662, 140, 140, 140, 16439, -1000,
+	326, 16439, 660, 4319, 96, 4319, 4319, -1000, 4319, 4319,
+	-1000, 4319, 46, 4319, -78, 1033, -1000, -1000, -1000, -1000,
+	-33, -1000, 4319, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 575, 970, 9862, 9862,
+	9862, 114, 14810, 783, 792, 16170, 1026, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1022, -1000, -1000, 957, -1000, -1000, 507,
+	1051, -1000, 12108, 324, -1000, 9862, 35, 792, -1000, -1000,
+	792, -1000, -1000, -1000, -1000, -1000, 10998, 10998, 10998, 10998,
+	10998, 10998, 10998, 10998, 830, 829, 822, -1000, -1000, -1000,
+	-1000, 792, 792, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 792, -1000, -1000, 8158, 792, 792, 792,
+	792, 792, 792, 792, 792, 9862, 792, 792, 792, 792,
+	792, 792, 792, 792, 792, 792, 792, 792, 792, 792,
+	792, 15363, 14272, 16439, 789, 775, -1000, -1000, 316, 779,
+	6719, -90, -1000, -1000, -1000, 433, 13465, -1000, -1000, -1000,
+	958, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2211,295 +2558,321 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 672, 13538, -1000, 1661,
-	-1000, 638, 3861, 140, 628, 394, 615, 13538, 13538, 3861,
-	49, 89, 172, 13538, 758, 137, 13538, 943, 841, 13538,
-	608, 603, -1000, 5800, -1000, 3861, -1000, -1000, -1000, 3861,
-	3861, 3861, 13538, 3861, 3861, -1000, -1000, -1000, -1000, -1000,
-	3861, 3861, -1000, 1004, 337, -1000, -1000, -1000, -1000, 8731,
-	-1000, 837, -1000, -1000, -1000, -1000, -1000, -1000, 1025, 297,
-	543, 229, 757, -1000, 438, -1000, -1000, 84, 965, 540,
-	886, 11294, 854, -1000, -1000, 13538, -1000, 8731, 8731, 473,
-	-1000, 12286, -1000, -1000, 4692, 303, 9523, 496, 373, 9523,
-	9523, 9523, 9523, 9523, 9523, 9523, 9523, 9523, 9523, 9523,
-	9523, 9523, 9523, 9523, 9523, 9523, 9523, 9523, 506, 9523,
-	10800, 13291, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	590, -1000, 84, 81, 81, 81, 81, 81, 81, 81,
-	9787, 7411, 540, 646, 465, 7147, 7939, 7939, 8731, 8731,
-	8467, 8203, 7939, 954, 368, 465, 13785, -1000, -1000, 9259,
-	-1000, -1000, -1000, -1000, -1000, 540, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 13291, 13291, 7939, 7939, 7939, 7939, 82,
-	13538, -1000, 703, 879, -1000, -1000, -1000, 946, 10289, 609,
-	11047, 82, 662, 11792, 13538, -1000, -1000, 11792, 13538, 4415,
-	5523, 756, -100, 704, -1000, -124, -141, 6882, 178, -1000,
-	-1000, -1000, -1000, 3584, 546, 602, 488, -56, -1000, -1000,
-	-1000, 771, -1000, 771, 771, 771, 771, -22, -22, -22,
-	-22, -1000, -1000, -1000, -1000, -1000, 817, 812, -1000, 771,
-	771, 771, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	808, 808, 808, 800, 800, 824, -1000, 13538, 3861, 942,
-	3861, -1000, 2016, -1000, 13291, 13291, 13538, 13538, 199, 13538,
-	13538, 755, -1000, 13538, 3861, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 13538,
-	387, 13538, 13538, 465, 13538, -1000, 897, 8731, 8731, 5246,
-	8731, -1000, -1000, -1000, 540, 925, -1000, 954, 975, -1000,
-	905, 903, 7939, -1000, -1000, 303, 362, -1000, -1000, 428,
-	-1000, -1000, -1000, -1000, 228, 609, -1000, 2197, -1000, -1000,
-	-1000, -1000, 496, 9523, 9523, 9523, 1961, 2197, 2197, 2197,
-	2197, 2197, 2102, 160, 1845, 81, 179, 179, 128, 128,
-	128, 128, 128, 912, 912, -1000, -1000, -1000, 77, -1000,
-	-1000, -1000, -1000, -1000, -1000, 540, -1000, 540, 7939, 754,
-	-1000, -1000, 8731, -1000, 540, 642, 642, 539, 550, 1003,
-	1002, 642, 996, 994, 642, 642, 7939, 413, -1000, 8731,
-	540, -1000, 223, -1000, 214, 747, 746, 642, 540, 642,
-	642, 198, 609, -1000, 13785, 11792, 866, 11792, 11792, 11792,
-	-1000, -1000, -1000, 856, 853, 888, 13538, -1000, 644, 10289,
-	13291, 258, 609, -1000, 12039, 986, 11792, 737, -1000, 737,
-	-1000, 213, -1000, -1000, 704, -100, -144, -1000, -1000, -1000,
-	-1000, 465, -1000, 536, 698, 3307, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 797, 584, -1000, 933, 254, 403, 573,
-	932, -1000, -1000, -1000, 918, -1000, 404, -58, -1000, -1000,
-	508, -22, -22, -1000, -1000, 178, 913, 178, 178, 178,
-	528, 528, -1000, -1000, -1000, -1000, 499, -1000, -1000, -1000,
-	487, -1000, 834, 13291, 3861, -1000, -1000, -1000, -1000, 1671,
-	1671, 318, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 79, 804, -1000, -1000, -1000, 47, 46,
-	135, -1000, 3861, -1000, 337, -1000, 513, 8731, -1000, -1000,
-	-1000, 889, 465, 465, 211, -1000, -1000, -1000, 13538, -1000,
-	-1000, -1000, -1000, 733, -1000, -1000, -1000, 4138, 7939, -1000,
-	1961, 2197, 1682, -1000, 9523, 9523, -1000, -1000, -1000, 642,
-	7939, 465, -1000, -1000, -1000, 10800, 506, 10800, 9523, 9523,
-	-1000, 9523, 9523, -1000, -186, 798, 352, -1000, 8731, 402,
-	-1000, 5246, -1000, 9523, 9523, -1000, -1000, -1000, -1000, 833,
-	13785, 609, -1000, 10038, 13291, 745, -1000, 328, 879, 11792,
-	-1000, 869, 865, 831, 803, -1000, -1000, 858, -1000, 857,
-	-1000, -1000, -1000, -1000, 540, 696, -1000, 245, -1000, 158,
-	157, 147, 13291, -1000, 980, 8731, 737, -1000, -1000, 230,
-	-1000, -1000, -146, -109, -1000, -1000, -1000, 3584, -1000, 3584,
-	13291, 98, -1000, 573, 573, -1000, -1000, -1000, 787, 827,
-	9523, -1000, -1000, -1000, 598, 178, 178, -1000, 334, -1000,
-	-1000, -1000, 633, -1000, 624, 687, 619, 13538, -1000, -1000,
+	-1000, -1000, -1000, 713, 16439, -1000, 2786, -1000, 658, 4319,
+	179, 653, 477, 650, 16439, 16439, 4319, 60, 107, 194,
+	16439, 782, 164, 16439, 980, 884, 16439, 648, 637, -1000,
+	6419, -1000, 4319, -1000, -1000, -1000, 4319, 4319, 4319, 16439,
+	4319, 4319, -1000, -1000, -1000, -1000, -1000, 4319, 4319, -1000,
+	1050, 462, -1000, -1000, -1000, -1000, 9862, -1000, 881, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1057, 369, 526, 313, 502,
+	781, -1000, 506, -1000, -1000, 114, 114, 616, -1000, 843,
+	846, 926, 843, 13192, 897, -1000, -1000, 16439, -1000, 9862,
+	9862, 551, -1000, 12923, -1000, -1000, 5219, 400, 10998, 539,
+	405, 10998, 10998, 10998, 10998, 10998, 10998, 10998, 10998, 10998,
+	10998, 10998, 10998, 10998, 10998, 10998, 10998, 10998, 10998, 10998,
+	10998, 562, 10998, 12654, 16170, 14, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 626, -1000, 114, 37, 37, 37,
+	37, 37, 37, 37, 11282, -1000, -1000, -1000, 16170, 10998,
+	8442, 575, 582, 502, 8158, 9010, 9010, 9862, 9862, 9578,
+	9294, 9010, 989, 470, 502, 14003, -1000, -1000, 10714, -1000,
+	-1000, -1000, -1000, -1000, 575, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 16170, 16170, 9010, 9010, 9010, 9010, 108, 16439,
+	-1000, 732, 932, -1000, -1000, -1000, 16708, 11824, 792, 15079,
+	108, 724, 14272, 16439, -1000, -1000, 14272, 16439, 4919, 6119,
+	779, -90, 758, -1000, -145, -95, 7872, 271, -1000, -1000,
+	-1000, -1000, 4019, 404, 675, 523, -62, -1000, -1000, -1000,
+	804, -1000, 804, 804, 804, 804, 1, 1, 1, 1,
+	-1000, -1000, -1000, -1000, -1000, 812, 811, -1000, 804, 804,
+	804, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 810,
+	810, 810, 808, 808, 851, -1000, 16439, 4319, 979, 4319,
+	-1000, 1497, -1000, 16170, 16170, 16439, 16439, 237, 16439, 16439,
+	777, -1000, 16439, 4319, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 16439, 463,
+	16439, 16439, 502, 16439, -1000, 933, 9862, 9862, 5819, 9862,
+	-1000, -1000, -1000, -1000, 575, 983, 16170, 970, -1000, 989,
+	970, 1016, -1000, 950, 947, 9010, -1000, -1000, 400, 453,
+	-1000, 1048, 599, -1000, -1000, -1000, -1000, -1000, -1000, 310,
+	792, -1000, 3052, -1000, -1000, -1000, -1000, 539, 10998, 10998,
+	10998, 2051, 3052, 3052, 3052, 3052, 3052, 3026, 1755, 2620,
+	371, 37, 425, 425, 100, 100, 100, 100, 100, 202,
+	202, -1000, -1000, -1000, 785, -1000, -1000, -1000, -1000, -1000,
+	-1000, 48, 575, -1000, 1045, 2542, 575, 9010, 776, -1000,
+	-1000, 9862, -1000, 575, 703, 703, 447, 522, 1044, 1041,
+	703, 1040, 1035, 703, 703, 9010, 503, -1000, 9862, 575,
+	-1000, 283, -1000, 1647, 774, 760, 703, 575, 703, 703,
+	159, 792, -1000, 14003, 14272, 150, 14272, 14272, -1000, -1000,
+	-1000, 142, -1000, 16439, 792, 711, 11824, 16170, 344, 792,
+	-1000, 14810, 1032, 14272, 737, -1000, 737, -1000, 275, -1000,
+	-1000, 758, -90, -151, -1000, -1000, -1000, -1000, 502, -1000,
+	576, 756, 3719, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	807, 624, -1000, 969, 348, 272, 617, 967, -1000, -1000,
+	-1000, 960, -1000, 499, -68, -1000, -1000, 557, 1, 1,
+	-1000, -1000, 271, 956, 271, 271, 271, 821, 821, -1000,
+	-1000, -1000, -1000, 544, -1000, -1000, -1000, 532, -1000, 880,
+	16170, 4319, -1000, -1000, -1000, -1000, 549, 549, 265, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 13538, -1000, -1000, -1000, -1000, -1000,
-	13291, -193, 571, 13291, 13291, 13538, -1000, 387, -1000, 465,
-	-1000, 4969, -1000, 986, 11792, -1000, -1000, 540, -1000, 9523,
-	2197, 2197, -1000, -1000, 540, 540, 540, 1611, 1550, 729,
-	477, 609, -174, -1000, 465, 8731, -1000, 418, 276, -1000,
-	935, 679, 682, -1000, -1000, 7675, 540, 613, 206, 601,
-	-1000, 980, 13785, 8731, 807, -1000, -1000, -1000, 8731, -1000,
-	8731, 786, -1000, -1000, 946, 13291, 6618, 609, 609, 609,
-	601, 965, 465, -1000, -1000, -1000, -1000, 3307, -1000, 597,
-	-1000, 771, -1000, -1000, -1000, 13291, -51, 1024, 2197, -1000,
-	-1000, -1000, -1000, -1000, -22, 510, -22, 476, -1000, 454,
-	3861, -1000, -1000, -1000, -1000, 937, -1000, 4969, -1000, -1000,
-	764, -1000, -1000, -1000, 984, 686, -1000, 2197, -1000, -1000,
-	-1000, 9523, 9523, 9523, 9523, 9523, 540, 489, 465, 9523,
-	9523, 927, -1000, 609, -1000, -1000, 167, 13291, 13291, -1000,
-	13291, 965, -1000, 465, -1000, -1000, 465, 465, 13291, 13538,
-	-1000, -1000, 465, 609, 609, 13291, 13291, 13291, 10553, -1000,
-	222, 13291, -1000, 582, -1000, 224, -1000, 188, 178, -1000,
-	178, 567, 551, -1000, 609, 683, -1000, 325, 13291, 982,
-	971, 214, 214, 214, 214, 51, -1000, -1000, 214, 214,
-	1023, -1000, 609, -1000, 84, 191, -1000, -1000, -1000, 577,
-	-1000, 11792, 13785, 566, 566, 566, 258, 222, -1000, 533,
-	312, 474, -1000, 92, 13291, 412, 926, -1000, 921, -1000,
-	-1000, -1000, -1000, -1000, 76, 4969, 3584, 560, 53, 8731,
-	8731, -1000, -1000, -1000, -1000, 540, 34, -196, -1000, -1000,
-	13785, 682, 540, 13291, -1000, 606, 540, -1000, -1000, -1000,
-	-1000, -1000, -1000, 451, -1000, -1000, 13538, -1000, -1000, 470,
-	-1000, -1000, 556, -1000, 13291, -1000, -1000, 804, -1000, 821,
-	465, 681, -1000, 881, -191, -199, 680, -1000, -1000, -1000,
-	-1000, -1000, 763, -1000, -1000, 76, 902, -193, 547, -1000,
-	469, 959, 8731, -1000, 873, -1000, 13291, -1000, 72, -1000,
-	821, -1000, 345, 8731, 465, -194, 545, 67, -1000, 1028,
-	465, -197, 826, 609, -1000, -200, 822, -1000, 993, 8995,
-	-1000, -1000, 1021, 205, 205, 214, 540, -1000, -1000, -1000,
-	106, 481, -1000, -1000, -1000, -1000, -1000, -1000,
+	104, 828, -1000, -1000, -1000, 55, 52, 156, -1000, 4319,
+	-1000, 462, -1000, 820, 9862, -1000, -1000, -1000, 938, 502,
+	502, 274, -1000, -1000, 792, -1000, -1000, -1000, 16439, -1000,
+	-1000, -1000, -1000, 736, 10998, 1028, -1000, -1000, -1000, 4619,
+	9010, -1000, 2051, 3052, 2130, -1000, 10998, 10998, -1000, 11551,
+	-1000, 9862, 10998, 216, 703, 9010, 502, -1000, -1000, -1000,
+	12654, 562, 12654, 10998, 10998, -1000, 10998, 10998, -1000, -195,
+	723, 465, -1000, 9862, 416, -1000, 5819, -1000, 10998, 10998,
+	-1000, -1000, -1000, -1000, 878, 14003, 792, -1000, 12381, 16170,
+	772, -1000, 429, 932, 14272, 14272, -1000, 915, 913, 912,
+	901, 900, 871, -1000, -1000, -1000, -1000, 696, -1000, -1000,
+	8726, -1000, 575, 755, -1000, 361, -1000, 185, 181, 161,
+	16170, -1000, 1022, 9862, 737, -1000, -1000, 289, -1000, -1000,
+	-163, -104, -1000, -1000, -1000, 4019, -1000, 4019, 16170, 120,
+	-1000, 617, 617, -1000, -1000, -1000, 805, 868, 10998, -1000,
+	-1000, -1000, 667, 271, 271, -1000, 393, -1000, -1000, -1000,
+	694, -1000, 685, 751, 679, 16439, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 16439, -1000, -1000, -1000, -1000, -1000, 16170, -200,
+	610, 16170, 16170, 16439, -1000, 463, -1000, 502, -1000, 5519,
+	114, -1000, 1032, 14272, 3052, 10998, -1000, -1000, 575, -1000,
+	10998, 3052, 3052, -1000, -1000, -1000, 410, 1647, 792, 792,
+	212, -1000, 575, 575, 575, 2494, 2424, 2267, 314, 792,
+	-190, -1000, 502, 9862, -1000, 2344, 2205, -1000, 972, 740,
+	742, 575, 674, 263, 670, -1000, 1022, 14003, 9862, 834,
+	875, -1000, -1000, -1000, 911, -1000, 903, -1000, 899, -1000,
+	9862, 982, 792, -1000, 982, 16170, 7588, 792, 792, 792,
+	670, 843, 502, -1000, -1000, -1000, -1000, 3719, -1000, 666,
+	-1000, 804, -1000, -1000, -1000, 16170, -50, 1056, 3052, -1000,
+	-1000, -1000, -1000, -1000, 1, 819, 1, 531, -1000, 529,
+	4319, -1000, -1000, -1000, -1000, 974, -1000, 5519, -1000, -1000,
+	802, -1000, -1000, -1000, 575, 1027, 746, 3052, -1000, 3052,
+	-1000, -1000, 1031, 103, 792, 792, -1000, -1000, -1000, 10998,
+	10998, 10998, 10998, 10998, 575, 817, 502, 10998, 10998, 966,
+	-1000, -1000, 160, 16170, 16170, -1000, 16170, 843, -1000, 502,
+	-1000, -1000, 9862, 800, -1000, -1000, -1000, -1000, 502, 16439,
+	-1000, -1000, 16439, -1000, -1000, 502, 792, 792, 16170, 16170,
+	16170, 14541, -1000, 247, 16170, -1000, 657, 270, -1000, -167,
+	271, -1000, 271, 646, 623, -1000, 792, 743, -1000, 427,
+	16170, -1000, 1018, 1014, 9862, 1022, 1012, 1030, 103, 1647,
+	1647, 1647, 1647, 98, -1000, -1000, 1647, 1647, 1055, 792,
+	-1000, 114, 246, -1000, -1000, -1000, 502, 16170, 792, -1000,
+	14272, 14003, 616, 616, 616, 344, 247, -1000, 586, 420,
+	816, -1000, 109, 513, 964, -1000, 959, -1000, -1000, -1000,
+	-1000, -1000, 102, 5519, 4019, 645, 81, 9862, 10146, 410,
+	561, 9862, 9862, 1022, -1000, -1000, -1000, -1000, 575, 75,
+	-204, -1000, -1000, 14003, 742, 575, 16170, 630, 16170, 697,
+	575, -1000, -1000, -1000, -1000, -1000, -1000, 528, -1000, -1000,
+	16439, -1000, 791, -1000, -1000, 622, -1000, 16170, -1000, -1000,
+	828, -1000, 908, 502, 741, -1000, 502, 792, 792, 69,
+	-1000, 575, 256, 739, 410, 561, -1000, 934, -198, -207,
+	738, -1000, -1000, -1000, 616, -1000, -1000, -1000, 796, -1000,
+	-1000, 102, 946, -200, 719, -1000, 527, 1006, 9862, 10146,
+	9862, 9862, 792, -1000, -1000, 197, 80, 76, 72, -1000,
+	575, -1000, 923, -1000, -1000, 16170, -1000, 97, -1000, 908,
+	-1000, 441, 9862, 502, -1000, 582, 582, 9862, 486, -1000,
+	-1000, -1000, -1000, -1000, -1000, -201, 580, 94, -1000, 1043,
+	502, -1000, -1000, 574, -1000, 7304, 502, 197, -205, 860,
+	792, -1000, -1000, 9862, -1000, -1000, -209, 859, -1000, 1039,
+	10430, -1000, -1000, -1000, 1054, 251, 251, 1647, 575, -1000,
+	-1000, -1000, 132, 546, -1000, -1000, -1000, -1000, -1000, -1000,
 }
 
-var yyPgo = [...]int{
-	0, 1252, 38, 203, 1250, 1246, 1243, 102, 1242, 1239,
-	1229, 1227, 1226, 1224, 1223, 1222, 1221, 1220, 1219, 1218,
-	1215, 1213, 1212, 1210, 1209, 1208, 1207, 1206, 1205, 343,
-	1204, 1203, 1202, 68, 1201, 73, 1200, 1198, 53, 198,
-	54, 46, 724, 1197, 34, 23, 55, 1194, 1190, 1188,
-	21, 1186, 20, 1184, 1183, 74, 1182, 1181, 59, 1180,
-	1178, 218, 1177, 66, 1176, 9, 32, 1175, 1174, 1173,
-	1172, 1171, 215, 1170, 1167, 13, 1166, 1164, 100, 1163,
-	62, 10, 17, 19, 14, 1161, 27, 8, 1159, 61,
-	1157, 1153, 1152, 1150, 36, 1149, 67, 1146, 18, 63,
-	1144, 1138, 3, 1135, 51, 71, 41, 22, 5, 75,
-	70, 1134, 30, 65, 60, 1133, 1131, 221, 1130, 1125,
-	44, 1122, 1120, 31, 175, 172, 1114, 1113, 1112, 1111,
-	49, 0, 1040, 87, 72, 1110, 1108, 1103, 1907, 48,
-	28, 25, 26, 47, 1278, 50, 1102, 1099, 42, 1097,
-	1096, 1094, 1093, 1089, 1086, 1085, 112, 1084, 1082, 1081,
-	29, 52, 1080, 1079, 78, 69, 1078, 1076, 1075, 58,
-	64, 1074, 1069, 57, 43, 1066, 1065, 1062, 1061, 1058,
-	45, 4, 1057, 15, 1053, 6, 1052, 1051, 35, 1050,
-	12, 1049, 16, 1047, 7, 1044, 11, 56, 1, 1043,
-	2, 1042, 1041, 1105, 281, 76, 1038, 77,
+var yyPgo = [...]int16{
+	0, 1295, 74, 81, 1294, 237, 82, 108, 110, 856,
+	1291, 1290, 1288, 1287, 1286, 1284, 1279, 1275, 1274, 1272,
+	1269, 1268, 1266, 1264, 1261, 1259, 1255, 1253, 1251, 1248,
+	236, 1247, 1246, 107, 1245, 80, 1243, 83, 1242, 1241,
+	55, 143, 53, 50, 1285, 1239, 44, 18, 46, 1238,
+	1237, 1236, 29, 1235, 33, 1233, 1232, 84, 1228, 1227,
+	64, 1226, 1225, 1908, 1224, 79, 1223, 21, 56, 1221,
+	1220, 1219, 1217, 52, 65, 1216, 1212, 1208, 22, 1207,
+	1204, 105, 1203, 63, 8, 17, 28, 31, 1202, 24,
+	60, 1201, 68, 1200, 1199, 1195, 1194, 4, 12, 1192,
+	1191, 15, 1190, 23, 11, 5, 69, 1189, 26, 67,
+	1188, 1187, 7, 1186, 10, 78, 45, 38, 19, 85,
+	76, 1185, 34, 73, 70, 1184, 1183, 233, 1181, 1180,
+	59, 1179, 1178, 36, 235, 196, 1173, 1172, 1170, 1166,
+	51, 583, 1412, 99, 95, 1165, 1164, 1154, 2271, 49,
+	37, 41, 32, 40, 47, 54, 1153, 1152, 48, 1151,
+	1147, 1138, 1137, 1133, 1131, 1129, 35, 1127, 1123, 1120,
+	27, 25, 1118, 1109, 77, 72, 1108, 1107, 1105, 61,
+	71, 1103, 1102, 62, 42, 1099, 1098, 1096, 1095, 1093,
+	39, 14, 1086, 30, 1085, 16, 1084, 1082, 43, 1081,
+	9, 1080, 20, 1079, 6, 1077, 13, 58, 2, 1075,
+	3, 1074, 1073, 0, 587, 86, 1072, 91,
 }
 
-var yyR1 = [...]int{
-	0, 201, 202, 202, 1, 1, 1, 1, 1, 1,
+var yyR1 = [...]uint8{
+	0, 211, 212, 212, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 2, 2, 2, 6, 6,
-	8, 8, 7, 9, 3, 4, 5, 5, 10, 10,
-	32, 32, 11, 12, 12, 12, 12, 205, 205, 55,
-	55, 56, 56, 105, 105, 13, 13, 13, 13, 110,
-	110, 114, 114, 114, 115, 115, 115, 115, 146, 146,
-	14, 14, 14, 14, 14, 14, 14, 196, 196, 195,
-	194, 194, 193, 193, 192, 20, 176, 178, 178, 177,
-	177, 177, 177, 170, 149, 149, 149, 149, 152, 152,
-	150, 150, 150, 150, 150, 150, 150, 150, 150, 151,
-	151, 151, 151, 151, 153, 153, 153, 153, 153, 154,
-	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
-	154, 154, 154, 154, 155, 155, 155, 155, 155, 155,
-	155, 155, 169, 169, 156, 156, 164, 164, 165, 165,
-	165, 162, 162, 163, 163, 166, 166, 166, 158, 158,
-	159, 159, 167, 167, 160, 160, 160, 161, 161, 161,
-	168, 168, 168, 168, 168, 157, 157, 171, 171, 186,
-	186, 185, 185, 185, 175, 175, 182, 182, 182, 182,
-	182, 173, 173, 174, 174, 184, 184, 183, 172, 172,
-	188, 188, 188, 188, 199, 200, 198, 198, 198, 198,
-	198, 179, 179, 179, 180, 180, 180, 181, 181, 181,
-	15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-	15, 15, 15, 197, 197, 197, 197, 197, 197, 197,
-	197, 197, 197, 197, 191, 189, 189, 190, 190, 16,
-	21, 21, 17, 17, 17, 17, 17, 18, 18, 22,
-	23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
-	23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
-	23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
-	23, 121, 121, 119, 119, 122, 122, 120, 120, 120,
-	123, 123, 123, 147, 147, 147, 24, 24, 26, 26,
-	27, 28, 25, 25, 25, 25, 25, 25, 25, 19,
-	206, 29, 30, 30, 31, 31, 31, 35, 35, 35,
-	33, 33, 34, 34, 40, 40, 39, 39, 41, 41,
-	41, 41, 135, 135, 135, 134, 134, 43, 43, 44,
-	44, 45, 45, 46, 46, 46, 46, 46, 64, 64,
-	49, 49, 48, 48, 50, 51, 51, 51, 104, 104,
-	106, 106, 47, 47, 47, 47, 52, 52, 53, 53,
-	54, 54, 142, 142, 141, 141, 141, 187, 187, 187,
-	140, 140, 57, 57, 57, 59, 58, 58, 58, 58,
-	60, 60, 62, 62, 61, 61, 63, 65, 65, 65,
-	65, 66, 66, 42, 42, 42, 42, 42, 42, 42,
-	118, 118, 68, 68, 67, 67, 67, 67, 67, 67,
-	67, 67, 67, 67, 67, 67, 67, 67, 79, 79,
-	79, 79, 79, 79, 69, 69, 69, 69, 69, 69,
-	69, 38, 38, 80, 80, 80, 86, 81, 81, 72,
-	72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
-	72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
-	72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
-	72, 72, 76, 76, 76, 74, 74, 74, 74, 74,
-	74, 74, 74, 74, 74, 74, 74, 74, 75, 75,
-	75, 75, 75, 75, 75, 75, 75, 75, 75, 75,
-	75, 75, 75, 75, 207, 207, 78, 77, 77, 77,
-	77, 77, 77, 36, 36, 36, 36, 36, 145, 145,
-	148, 148, 148, 148, 90, 90, 37, 37, 88, 88,
-	89, 91, 91, 87, 87, 87, 71, 71, 71, 71,
-	71, 71, 71, 71, 73, 73, 73, 92, 92, 93,
-	93, 94, 94, 95, 95, 96, 97, 97, 97, 98,
-	98, 98, 98, 99, 99, 99, 100, 100, 101, 101,
-	102, 102, 102, 102, 70, 70, 70, 70, 70, 70,
-	103, 103, 103, 103, 107, 107, 82, 82, 84, 84,
-	83, 85, 108, 108, 112, 109, 109, 113, 113, 113,
-	113, 111, 111, 111, 137, 137, 137, 116, 116, 124,
-	124, 125, 125, 117, 117, 126, 126, 126, 126, 126,
-	126, 126, 126, 126, 126, 127, 127, 127, 128, 128,
-	129, 129, 129, 136, 136, 132, 132, 133, 133, 138,
-	138, 139, 139, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 203, 204,
-	143, 144, 144, 144,
+	1, 1, 1, 1, 2, 2, 2, 2, 2, 2,
+	7, 7, 9, 9, 8, 8, 10, 3, 4, 4,
+	5, 5, 6, 6, 11, 11, 34, 34, 12, 13,
+	13, 13, 13, 215, 215, 57, 57, 58, 58, 115,
+	115, 14, 14, 14, 14, 120, 120, 124, 124, 124,
+	125, 125, 125, 125, 156, 156, 15, 15, 15, 15,
+	15, 15, 15, 206, 206, 205, 204, 204, 203, 203,
+	202, 21, 186, 188, 188, 187, 187, 187, 187, 180,
+	159, 159, 159, 159, 162, 162, 160, 160, 160, 160,
+	160, 160, 160, 160, 160, 161, 161, 161, 161, 161,
+	163, 163, 163, 163, 163, 164, 164, 164, 164, 164,
+	164, 164, 164, 164, 164, 164, 164, 164, 164, 164,
+	165, 165, 165, 165, 165, 165, 165, 165, 179, 179,
+	166, 166, 174, 174, 175, 175, 175, 172, 172, 173,
+	173, 176, 176, 176, 168, 168, 169, 169, 177, 177,
+	170, 170, 170, 171, 171, 171, 178, 178, 178, 178,
+	178, 167, 167, 181, 181, 196, 196, 195, 195, 195,
+	185, 185, 192, 192, 192, 192, 192, 183, 183, 184,
+	184, 194, 194, 193, 182, 182, 198, 198, 198, 198,
+	209, 210, 208, 208, 208, 208, 208, 189, 189, 189,
+	190, 190, 190, 191, 191, 191, 16, 16, 16, 16,
+	16, 16, 16, 16, 16, 16, 16, 16, 16, 207,
+	207, 207, 207, 207, 207, 207, 207, 207, 207, 207,
+	201, 199, 199, 200, 200, 17, 22, 22, 18, 18,
+	18, 18, 18, 19, 19, 23, 24, 24, 24, 24,
+	24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
+	24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
+	24, 24, 24, 24, 24, 24, 24, 131, 131, 129,
+	129, 132, 132, 130, 130, 130, 133, 133, 133, 157,
+	157, 157, 25, 25, 27, 27, 28, 29, 26, 26,
+	26, 26, 26, 26, 26, 20, 216, 30, 31, 31,
+	32, 32, 32, 32, 32, 32, 33, 33, 33, 37,
+	37, 37, 35, 35, 36, 36, 42, 42, 41, 41,
+	43, 43, 43, 43, 145, 145, 145, 145, 144, 144,
+	45, 45, 46, 46, 47, 47, 48, 48, 48, 48,
+	48, 48, 48, 48, 66, 66, 51, 51, 50, 50,
+	52, 53, 53, 53, 114, 114, 116, 116, 49, 49,
+	49, 49, 54, 54, 55, 55, 56, 56, 152, 152,
+	151, 151, 151, 197, 197, 197, 150, 150, 59, 59,
+	59, 61, 60, 60, 60, 60, 60, 60, 62, 62,
+	64, 64, 63, 63, 65, 67, 67, 67, 67, 68,
+	68, 44, 44, 44, 44, 44, 44, 44, 44, 44,
+	128, 128, 70, 70, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 82, 82,
+	82, 82, 82, 82, 71, 71, 71, 71, 71, 71,
+	71, 40, 40, 83, 83, 83, 89, 84, 84, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 76, 76, 79,
+	79, 79, 79, 79, 79, 79, 103, 103, 104, 104,
+	104, 105, 105, 105, 105, 105, 77, 77, 77, 77,
+	77, 77, 77, 77, 77, 77, 77, 77, 77, 77,
+	77, 78, 78, 78, 78, 78, 78, 78, 78, 78,
+	78, 78, 78, 78, 78, 78, 78, 217, 217, 81,
+	80, 80, 80, 80, 80, 80, 38, 38, 38, 38,
+	38, 155, 155, 158, 158, 158, 158, 93, 93, 39,
+	39, 91, 91, 92, 94, 94, 90, 90, 90, 90,
+	73, 73, 73, 73, 73, 73, 73, 73, 75, 75,
+	75, 95, 95, 96, 96, 98, 98, 98, 98, 99,
+	99, 97, 97, 100, 100, 101, 101, 102, 102, 106,
+	107, 107, 107, 108, 108, 108, 108, 108, 109, 109,
+	109, 110, 110, 111, 111, 112, 112, 112, 112, 72,
+	72, 72, 72, 72, 72, 113, 113, 113, 113, 117,
+	117, 85, 85, 87, 87, 86, 88, 118, 118, 122,
+	119, 119, 123, 123, 123, 123, 121, 121, 121, 147,
+	147, 147, 126, 126, 134, 134, 135, 135, 127, 127,
+	136, 136, 136, 136, 136, 136, 136, 136, 136, 136,
+	137, 137, 137, 138, 138, 139, 139, 139, 146, 146,
+	142, 142, 143, 143, 148, 148, 149, 149, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 213, 214, 153, 154, 154, 154,
 }
 
-var yyR2 = [...]int{
+var yyR2 = [...]int8{
 	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 0, 4, 4, 6, 7, 0, 1,
-	1, 3, 5, 5, 11, 3, 1, 3, 7, 8,
-	1, 1, 9, 8, 7, 6, 6, 1, 1, 1,
-	3, 1, 3, 0, 4, 3, 4, 5, 4, 1,
-	3, 3, 2, 2, 2, 2, 2, 1, 1, 1,
-	2, 2, 8, 4, 6, 5, 5, 0, 2, 1,
-	0, 2, 1, 3, 3, 4, 4, 2, 4, 1,
-	3, 3, 3, 8, 3, 1, 1, 1, 2, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
-	2, 2, 2, 2, 1, 2, 2, 2, 1, 4,
-	4, 2, 2, 3, 3, 3, 3, 1, 1, 1,
-	1, 1, 6, 6, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 3, 0, 3, 0, 5, 0, 3,
-	5, 0, 1, 0, 1, 0, 1, 2, 0, 2,
-	0, 3, 0, 1, 0, 3, 3, 0, 2, 2,
-	0, 2, 1, 2, 1, 0, 2, 5, 4, 1,
-	2, 2, 3, 2, 0, 1, 2, 3, 3, 2,
-	2, 1, 1, 0, 1, 1, 3, 2, 3, 1,
-	10, 11, 11, 12, 3, 3, 1, 1, 2, 2,
-	2, 0, 1, 3, 1, 2, 3, 1, 1, 1,
-	6, 7, 7, 7, 7, 4, 5, 7, 5, 5,
-	5, 12, 7, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 7, 1, 3, 8, 8, 3,
-	3, 5, 4, 6, 5, 4, 4, 3, 2, 3,
-	4, 3, 4, 4, 4, 4, 4, 4, 3, 3,
-	2, 3, 3, 2, 3, 4, 3, 7, 5, 4,
-	2, 4, 2, 2, 2, 2, 3, 3, 5, 2,
-	3, 1, 1, 0, 1, 1, 1, 0, 2, 2,
-	0, 2, 2, 0, 1, 1, 2, 1, 1, 2,
-	1, 1, 2, 2, 2, 2, 2, 3, 3, 2,
-	0, 2, 0, 2, 1, 2, 2, 0, 1, 1,
-	0, 1, 0, 1, 0, 1, 1, 3, 1, 2,
-	3, 5, 0, 1, 2, 1, 1, 0, 2, 1,
-	3, 1, 1, 1, 3, 1, 3, 6, 3, 7,
-	0, 1, 1, 3, 3, 1, 4, 4, 1, 3,
-	1, 3, 5, 4, 4, 3, 2, 4, 0, 1,
-	0, 2, 0, 1, 0, 1, 2, 0, 1, 1,
-	1, 1, 1, 2, 2, 1, 2, 3, 2, 3,
-	2, 2, 2, 1, 1, 3, 3, 0, 5, 5,
-	5, 0, 2, 1, 3, 3, 2, 3, 1, 2,
+	1, 1, 1, 0, 4, 4, 5, 6, 6, 7,
+	0, 1, 1, 3, 5, 8, 5, 11, 1, 3,
+	1, 3, 1, 3, 7, 8, 1, 1, 9, 8,
+	7, 6, 6, 1, 1, 1, 3, 1, 3, 0,
+	4, 3, 4, 5, 4, 1, 3, 3, 2, 2,
+	2, 2, 2, 1, 1, 1, 2, 2, 8, 4,
+	6, 5, 5, 0, 2, 1, 0, 2, 1, 3,
+	3, 4, 4, 2, 4, 1, 3, 3, 3, 8,
+	3, 1, 1, 1, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 2, 2, 2, 2, 2,
+	1, 2, 2, 2, 1, 4, 4, 2, 2, 3,
+	3, 3, 3, 1, 1, 1, 1, 1, 6, 6,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	0, 3, 0, 5, 0, 3, 5, 0, 1, 0,
+	1, 0, 1, 2, 0, 2, 0, 3, 0, 1,
+	0, 3, 3, 0, 2, 2, 0, 2, 1, 2,
+	1, 0, 2, 5, 4, 1, 2, 2, 3, 2,
+	0, 1, 2, 3, 3, 2, 2, 1, 1, 0,
+	1, 1, 3, 2, 3, 1, 10, 11, 11, 12,
+	3, 3, 1, 1, 2, 2, 2, 0, 1, 3,
+	1, 2, 3, 1, 1, 1, 6, 7, 7, 7,
+	7, 4, 5, 7, 5, 5, 5, 12, 7, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	7, 1, 3, 8, 8, 3, 3, 5, 4, 6,
+	5, 4, 4, 3, 2, 3, 4, 3, 4, 4,
+	4, 4, 4, 4, 3, 3, 2, 3, 3, 2,
+	3, 4, 3, 7, 5, 4, 2, 4, 2, 2,
+	2, 2, 3, 3, 5, 2, 3, 1, 1, 0,
+	1, 1, 1, 0, 2, 2, 0, 2, 2, 0,
+	1, 1, 2, 1, 1, 2, 1, 1, 2, 2,
+	2, 2, 2, 3, 3, 2, 0, 2, 0, 2,
+	1, 2, 2, 1, 2, 2, 1, 2, 2, 0,
+	1, 1, 0, 1, 0, 1, 0, 1, 1, 3,
+	1, 2, 3, 5, 0, 1, 2, 2, 1, 1,
+	0, 2, 1, 3, 1, 1, 1, 2, 3, 1,
+	6, 9, 3, 6, 3, 7, 0, 1, 1, 3,
+	3, 1, 4, 4, 1, 3, 1, 3, 5, 4,
+	5, 3, 2, 4, 0, 1, 0, 2, 0, 1,
+	0, 1, 2, 0, 1, 1, 1, 1, 1, 2,
+	2, 1, 2, 3, 2, 3, 2, 3, 2, 2,
+	2, 1, 1, 3, 3, 0, 5, 5, 5, 0,
+	2, 1, 3, 3, 2, 3, 5, 6, 1, 2,
 	0, 3, 1, 1, 3, 3, 4, 4, 5, 3,
 	3, 3, 3, 3, 4, 5, 6, 2, 1, 2,
 	1, 2, 1, 2, 1, 1, 1, 1, 1, 1,
 	1, 0, 2, 1, 1, 1, 3, 1, 3, 1,
 	1, 1, 1, 1, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 2, 2, 2,
-	2, 2, 2, 2, 3, 1, 1, 1, 1, 4,
-	3, 3, 4, 5, 6, 4, 4, 6, 6, 6,
-	8, 8, 8, 8, 9, 7, 5, 4, 2, 2,
-	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
-	2, 2, 8, 8, 0, 2, 3, 4, 4, 4,
-	4, 4, 4, 0, 3, 4, 7, 3, 1, 1,
-	1, 1, 1, 1, 0, 1, 0, 2, 1, 2,
-	4, 0, 2, 1, 3, 5, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 2, 2, 0, 3, 0,
-	2, 0, 3, 1, 3, 2, 0, 1, 1, 0,
-	2, 4, 4, 0, 2, 4, 0, 2, 1, 3,
-	2, 4, 3, 2, 2, 1, 3, 5, 4, 6,
-	1, 3, 3, 5, 0, 5, 1, 3, 1, 2,
-	3, 1, 1, 3, 3, 1, 3, 3, 3, 3,
-	3, 1, 2, 1, 1, 1, 1, 1, 1, 0,
-	2, 0, 3, 0, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 0, 1, 1, 1, 1,
-	0, 1, 1, 0, 2, 1, 1, 1, 1, 1,
+	3, 3, 3, 3, 3, 3, 3, 3, 2, 2,
+	2, 2, 2, 2, 2, 3, 2, 2, 2, 1,
+	1, 1, 1, 4, 3, 3, 5, 1, 1, 4,
+	5, 6, 9, 10, 10, 11, 0, 3, 0, 2,
+	5, 2, 2, 2, 2, 2, 4, 4, 6, 6,
+	6, 8, 8, 8, 8, 9, 7, 5, 4, 6,
+	6, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 8, 8, 0, 2, 3,
+	4, 4, 4, 4, 4, 4, 0, 3, 4, 7,
+	3, 1, 1, 1, 1, 1, 1, 0, 1, 0,
+	2, 1, 2, 4, 0, 2, 1, 1, 3, 5,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
+	2, 0, 3, 1, 3, 1, 4, 4, 5, 1,
+	3, 2, 1, 0, 2, 0, 3, 1, 3, 2,
+	0, 1, 1, 0, 2, 4, 4, 2, 0, 2,
+	4, 0, 2, 1, 3, 2, 4, 3, 2, 2,
+	1, 3, 5, 4, 6, 1, 3, 3, 5, 0,
+	5, 1, 3, 1, 2, 3, 1, 1, 3, 3,
+	1, 3, 3, 3, 3, 3, 1, 2, 1, 1,
+	1, 1, 1, 1, 0, 2, 0, 3, 0, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	0, 1, 1, 1, 1, 0, 1, 1, 0, 2,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -2522,342 +2895,373 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	0, 0, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 0, 0, 1, 1,
 }
 
-var yyChk = [...]int{
-	-1000, -201, -1, -2, -9, -10, -11, -12, -13, -14,
-	-15, -16, -17, -18, -22, -23, -24, -26, -27, -28,
-	-25, -19, -3, 278, -4, 6, 7, -32, 9, 10,
-	34, -20, 127, 128, 130, 129, 162, 131, 155, 55,
-	175, 176, 178, 179, 29, 156, 157, 160, 161, 35,
-	36, 133, -203, 8, 265, 59, -202, 282, -94, 15,
-	-8, -7, -140, -138, 64, 62, -131, 23, 275, 148,
-	175, 186, 180, 207, 199, 276, 149, 197, 200, 244,
-	227, 239, 71, 178, 253, 22, 158, 195, 191, 21,
-	189, 31, 241, 88, 212, 280, 190, 240, 133, 151,
-	146, 213, 217, 245, 184, 185, 247, 211, 147, 37,
-	277, 39, 166, 248, 215, 210, 206, 209, 183, 205,
-	43, 219, 218, 220, 243, 202, 152, 192, 89, 18,
-	251, 161, 164, 242, 214, 216, 143, 168, 279, 249,
-	188, 153, 165, 160, 252, 154, 179, 229, 246, 255,
-	42, 224, 182, 145, 176, 172, 230, 203, 167, 193,
-	194, 208, 181, 204, 177, 162, 254, 225, 281, 201,
-	198, 173, 138, 170, 171, 231, 232, 233, 234, 235,
-	236, 174, 20, 250, 196, 226, -31, 5, -29, -206,
-	-29, -29, -29, -29, -29, -176, -178, 59, 98, -129,
-	138, 79, 257, 134, 135, 142, -132, 62, -131, -117,
-	138, 234, 140, 135, 135, 137, 138, 257, 134, 135,
-	-61, -138, 135, 120, 244, 127, 228, 229, 241, 137,
-	37, 242, 168, -147, 135, -119, 227, 231, 232, 233,
-	236, 234, 174, 62, 246, 245, 237, -138, 177, -143,
-	-143, -143, -143, -143, 230, 230, -143, -2, -98, 17,
-	16, -6, 60, 26, -5, -3, -203, 6, 24, 25,
-	-35, 44, 45, -30, -41, 108, -42, -138, -67, 81,
-	-72, 33, 62, -131, 27, -71, -68, -87, -85, -86,
-	120, 121, 122, 106, 107, 114, 82, 123, -76, -74,
-	-75, -77, 64, 63, 72, 65, 66, 67, 68, 75,
-	76, 77, -132, -83, -203, 49, 50, 266, 267, 268,
-	269, 274, 270, 84, 38, 256, 264, 263, 262, 260,
-	261, 258, 259, 272, 273, 141, 257, 112, 265, -117,
-	-117, 11, -55, -56, -61, -63, -138, -109, -146, 177,
-	-113, 246, 245, -133, -111, -132, -130, 244, 200, 243,
-	132, 80, 26, 28, 222, 83, 120, 16, 84, 119,
-	266, 127, 53, 258, 259, 256, 268, 269, 257, 228,
-	33, 10, 29, 156, 25, 110, 129, 87, 159, 27,
-	157, 77, 19, 56, 11, 13, 14, 141, 140, 100,
-	137, 51, 8, 123, 30, 97, 46, 32, 49, 98,
-	17, 260, 261, 35, 274, 163, 112, 54, 40, 81,
-	75, 78, 57, 79, 15, 52, 99, 130, 265, 50,
-	134, 6, 271, 34, 155, 47, 135, 86, 272, 273,
-	139, 169, 76, 5, 142, 36, 9, 55, 58, 262,
-	263, 264, 38, 85, 12, 278, -177, 98, -170, 62,
-	-61, 137, -61, 265, -125, 141, -125, -125, 135, -61,
-	127, 129, 132, 57, -21, -61, -124, 141, 62, -124,
-	-124, -124, -61, 124, -61, 62, -144, -203, -133, 257,
-	62, 168, 135, 169, 138, -144, -144, -144, -144, -144,
-	172, 173, -144, -122, -121, 239, 240, 230, 238, 12,
-	230, 171, -144, -143, -143, -204, 61, -99, 19, 35,
-	-42, -138, -95, -96, -42, -2, -7, -203, -94, -2,
-	-29, 40, -33, 25, 70, 11, -135, 80, 79, 97,
-	-134, 26, -132, 64, 124, -42, -69, 100, 81, 98,
-	114, 116, 115, 117, 99, 83, 103, 102, 113, 106,
-	107, 108, 109, 110, 111, 112, 104, 105, 119, 283,
-	69, 125, 90, 91, 92, 93, 94, 95, 96, -118,
-	-203, -86, -203, -72, -72, -72, -72, -72, -72, -72,
-	-72, -203, -2, -81, -42, -203, -203, -203, -203, -203,
-	-203, -203, -203, -203, -90, -42, -203, -207, -78, -203,
-	-207, -78, -207, -78, -207, -203, -207, -78, -207, -78,
-	-207, -207, -78, -203, -203, -203, -203, -203, -203, -62,
-	30, -61, -44, -45, -46, -47, -64, -86, -203, 62,
-	-61, -61, -55, -205, 60, 11, 58, -205, 60, 124,
-	60, -109, 177, -110, -114, 247, 249, 90, -137, -132,
-	64, 33, 34, 61, 60, -61, -149, -152, -154, -153,
-	-155, -150, -151, 197, 198, 120, 201, 203, 204, 205,
-	206, 207, 208, 209, 210, 211, 212, 34, 158, 193,
-	194, 195, 196, 213, 214, 215, 216, 217, 218, 219,
-	220, 180, 199, 276, 181, 182, 183, 184, 185, 186,
-	188, 189, 190, 191, 192, 62, -144, 138, 62, 81,
-	62, -61, -61, -144, 170, 170, 135, 135, -61, 60,
-	139, -55, 27, 57, -61, 62, 62, -139, -138, -130,
-	-144, -144, -144, -144, -61, -144, -144, -144, -144, 11,
-	-120, 11, 100, -42, 57, 9, 100, 60, 18, 124,
-	60, -97, 28, 29, -2, -98, -204, -35, -73, -132,
-	65, 68, -34, 47, -61, -42, -42, -79, 75, 81,
-	76, 77, -134, 108, -139, -133, -130, -72, -80, -83,
-	-86, 69, 100, 98, 99, 83, -72, -72, -72, -72,
-	-72, -72, -72, -72, -72, -72, -72, -72, -72, -72,
-	-72, -72, -72, -72, -72, -145, 62, 64, -72, -148,
-	62, -131, 73, 74, -132, 62, -132, -40, 25, -39,
-	-41, -204, 60, -204, -2, -39, -39, -42, -42, -87,
-	64, -39, -87, 64, -39, -39, -33, -88, -89, 85,
-	-87, -132, -138, -204, -72, -132, -132, -39, -40, -39,
-	-39, -105, 164, -61, 34, 60, -187, -59, -58, -60,
-	48, 7, 47, 49, 50, 54, -142, 26, -44, -203,
-	-203, -141, 164, -140, 26, -105, 58, -44, -61, -44,
-	-63, -138, 108, -113, -110, 60, 248, 250, 251, 57,
-	78, -42, -161, 119, -179, -180, -181, -133, 64, 65,
-	-170, -171, -172, -182, 150, -188, 143, 145, 142, -173,
-	151, 137, 32, 61, -166, 75, 81, -162, 225, -156,
-	59, -156, -156, -156, -156, -160, 200, -160, -160, -160,
-	59, 59, -156, -156, -156, -164, 59, -164, -164, -165,
-	59, -165, -136, 58, -61, -144, 27, -144, -126, 132,
-	129, 130, -191, 128, 222, 200, 71, 33, 15, 266,
-	164, 281, 62, 165, -132, -132, -61, -61, 132, 129,
-	-61, -61, -61, -144, -61, -123, 98, 12, -138, -138,
-	-61, 42, -42, -42, -139, -96, -204, -99, -116, 19,
-	11, 38, 38, -39, 75, 76, 77, 124, -203, -80,
-	-72, -72, -72, -38, 159, 80, 284, -204, -204, -39,
-	60, -42, -204, -204, -204, 60, 58, 26, 11, 11,
-	-204, 11, 11, -204, -204, -39, -91, -89, 87, -42,
-	-204, 124, -204, 60, 60, -204, -204, -204, -204, -70,
-	34, 38, -2, -203, -203, -108, -112, -87, -45, -57,
-	46, 51, 53, -46, -45, -46, 46, 52, 46, 52,
-	46, -58, -138, -204, -49, -48, -50, -132, -65, 55,
-	140, 56, -203, -140, -66, 12, -44, -66, -66, 124,
-	-114, -115, 252, 249, 255, 62, 64, 60, -181, 90,
-	59, 62, 32, -173, -173, -174, 62, -174, 32, -158,
-	33, 75, -163, 226, 65, -160, -160, -161, 34, -161,
-	-161, -161, -169, 64, -169, 65, 65, 57, -132, -144,
-	-143, -197, 144, 150, 151, 146, 62, 137, 32, 143,
-	145, 164, 142, -197, -127, -128, 139, 26, 137, 32,
-	164, -196, 58, 170, 170, 139, -144, -120, 64, -42,
-	43, 124, -61, -43, 11, 108, -133, -40, -38, 80,
-	-72, -72, -204, -41, -148, -145, -148, -72, -72, -72,
-	-72, 275, -94, 88, -42, 86, -133, -72, -72, -107,
-	57, -108, -82, -84, -83, -203, -2, -103, -132, -106,
-	-132, -66, 60, 90, -46, 46, 46, -54, 57, -52,
-	57, 58, 46, 46, -204, 60, 101, 137, 137, 137,
-	-106, -94, -42, -66, 249, 253, 254, -180, -181, -184,
-	-183, -132, -188, -174, -174, 59, -159, 57, -72, 61,
-	-161, -161, 62, 120, 61, 60, 61, 60, 61, 60,
-	-61, -143, -143, -61, -143, -132, -194, 278, -195, 62,
-	-132, -132, -61, -123, -66, -44, -204, -72, -204, -204,
-	-204, 19, 19, 19, 19, -203, -37, 271, -42, 60,
-	60, 31, -107, 60, -204, -204, -204, 60, 124, -204,
-	60, -94, -112, -42, -53, -52, -42, -42, 59, -142,
-	-50, -51, -42, 135, 136, -203, -203, -203, -204, -98,
-	61, 60, -156, -104, -132, -167, 222, 9, -160, 64,
-	-160, 65, 65, -144, 30, -193, -192, -133, 59, -92,
-	13, -72, -72, -72, -72, -72, -204, 64, -72, -72,
-	32, -84, 38, -2, -203, -132, -132, -132, -98, -104,
-	-138, -203, -203, -104, -104, -104, -141, -186, -185, 58,
-	147, 71, -183, 61, 60, -168, 143, 32, 142, -75,
-	-161, -161, 61, 61, -203, 60, 90, -104, -93, 14,
-	16, -204, -204, -204, -204, -36, 100, 278, -204, -204,
-	9, -82, -2, 124, 61, -45, -87, -204, -204, -204,
-	-65, -185, 62, -175, 90, 64, 153, -132, -157, 71,
-	32, 32, -189, -190, 164, -192, -181, 61, -100, 169,
-	-42, -81, -204, 276, 54, 279, -108, -204, -132, -204,
-	-204, 65, -61, 64, -204, 60, -132, -196, -101, -102,
-	57, 23, 22, 43, 277, 280, 59, -190, 38, -194,
-	60, 20, 88, 21, -42, 43, -104, 166, -102, 89,
-	-42, 278, 61, 167, 7, 279, -199, -200, 57, -203,
-	280, -200, 57, 10, 9, -72, 163, -198, 154, 149,
-	152, 34, -198, -204, -204, 148, 33, 75,
+var yyChk = [...]int16{
+	-1000, -211, -1, -2, -10, -11, -12, -13, -14, -15,
+	-16, -17, -18, -19, -23, -24, -25, -27, -28, -29,
+	-26, -20, -3, 301, -4, -5, 8, 9, -34, 11,
+	12, 35, -21, 136, 137, 139, 138, 171, 140, 164,
+	56, 198, 199, 201, 202, 30, 165, 166, 169, 170,
+	36, 37, 142, -6, 10, 288, -213, 63, -212, 305,
+	-101, 17, -9, 189, -8, -150, -148, 61, 70, -141,
+	24, 298, 157, 198, 209, 203, 230, 222, 299, 158,
+	220, 223, 267, 250, 262, 78, 201, 276, 23, 188,
+	183, 167, 218, 214, 22, 212, 32, 264, 95, 235,
+	303, 190, 213, 263, 67, 142, 182, 160, 155, 236,
+	240, 268, 185, 207, 208, 270, 234, 156, 38, 300,
+	40, 175, 271, 238, 233, 229, 232, 206, 228, 44,
+	242, 241, 243, 266, 225, 161, 215, 96, 64, 274,
+	170, 173, 265, 237, 239, 192, 181, 152, 177, 302,
+	272, 211, 162, 174, 169, 275, 163, 202, 187, 184,
+	252, 269, 278, 186, 43, 247, 205, 154, 199, 195,
+	253, 226, 176, 216, 217, 231, 204, 227, 200, 171,
+	180, 277, 248, 304, 224, 221, 196, 147, 193, 194,
+	254, 255, 256, 257, 258, 259, 197, 21, 273, 219,
+	249, 191, -32, 5, 6, -33, 7, -30, -216, -30,
+	-30, -30, -30, -30, -186, -188, 63, 105, -139, 147,
+	86, 280, 143, 144, 151, -142, 70, -141, -127, 147,
+	257, 149, 144, 144, 146, 147, 280, 143, 144, -63,
+	-148, 144, 129, 267, 136, 251, 252, 264, 146, 38,
+	265, 177, -157, 144, -129, 250, 254, 255, 256, 259,
+	257, 197, 70, 269, 268, 260, -148, 200, -153, -153,
+	-153, -153, -153, 253, 253, -153, -2, -108, 19, 64,
+	18, -7, 68, -9, 27, -213, -5, -3, 8, 25,
+	26, 25, 26, -6, 25, 26, -37, 45, 46, -31,
+	-43, 116, -44, -148, -69, 88, -74, 34, 70, -141,
+	28, -73, -70, -90, -88, -89, 129, 130, 131, 114,
+	115, 122, 89, 132, 167, 216, 217, -79, -77, -78,
+	-80, 190, 192, 61, 71, 79, 72, 73, 74, 75,
+	82, 83, 84, -142, 128, -86, -213, 50, 51, 289,
+	290, 291, 292, 297, 293, 91, 39, 279, 287, 286,
+	285, 283, 284, 281, 282, 295, 296, 150, 280, 120,
+	288, -127, -127, 13, -57, -58, -63, -65, -148, -119,
+	-156, 200, -123, 269, 268, -143, -121, -142, -140, 267,
+	223, 266, 141, 87, 27, 29, 128, 245, 90, 129,
+	18, 91, 127, 289, 136, 54, 281, 282, 279, 291,
+	292, 280, 251, 34, 12, 30, 165, 26, 118, 138,
+	94, 168, 6, 28, 166, 84, 20, 57, 13, 15,
+	16, 150, 149, 107, 146, 52, 10, 7, 132, 31,
+	104, 47, 33, 50, 105, 19, 283, 284, 36, 297,
+	172, 120, 55, 41, 88, 82, 85, 58, 86, 17,
+	53, 179, 189, 106, 139, 288, 51, 143, 8, 294,
+	35, 164, 48, 144, 93, 295, 296, 148, 178, 83,
+	5, 151, 37, 11, 56, 59, 285, 286, 287, 39,
+	92, 14, 301, -187, 105, -180, 70, -63, 146, -63,
+	288, -135, 150, -135, -135, 144, -63, 136, 138, 141,
+	58, -22, -63, -134, 150, 70, -134, -134, -134, -63,
+	133, -63, 70, -154, -213, -143, 280, 70, 177, 144,
+	178, 147, -154, -154, -154, -154, -154, 195, 196, -154,
+	-132, -131, 262, 263, 253, 261, 14, 253, 194, -154,
+	-153, -153, -214, 69, -109, 20, 36, -44, -148, -44,
+	-102, -106, -44, -2, -8, -7, -213, -114, -142, -101,
+	-33, -30, -101, 41, -35, 26, 77, 13, -145, 87,
+	86, 104, -144, 27, -142, 61, 133, -44, -71, 107,
+	88, 105, 122, 124, 123, 125, 106, 90, 111, 110,
+	109, 121, 114, 115, 116, 117, 118, 119, 120, 112,
+	113, 127, 306, 76, 134, 128, 97, 98, 99, 100,
+	101, 102, 103, -128, -213, -89, -213, -74, -74, -74,
+	-74, -74, -74, -74, -74, 61, 61, 61, -213, -213,
+	-213, -2, -84, -44, -213, -213, -213, -213, -213, -213,
+	-213, -213, -213, -93, -44, -213, -217, -81, -213, -217,
+	-81, -217, -81, -217, -213, -217, -81, -217, -81, -217,
+	-217, -81, -213, -213, -213, -213, -213, -213, -64, 31,
+	-63, -46, -47, -48, -49, -66, -89, -213, 70, -63,
+	-63, -57, -215, 68, 13, 59, -215, 68, 133, 68,
+	-119, 200, -120, -124, 270, 272, 97, -147, -142, 61,
+	34, 35, 69, 68, -63, -159, -162, -164, -163, -165,
+	-160, -161, 220, 221, 129, 224, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 35, 167, 216, 217,
+	218, 219, 236, 237, 238, 239, 240, 241, 242, 243,
+	203, 222, 299, 204, 205, 206, 207, 208, 209, 211,
+	212, 213, 214, 215, 70, -154, 147, 70, 88, 70,
+	-63, -63, -154, 193, 193, 144, 144, -63, 68, 148,
+	-57, 28, 58, -63, 70, 70, -149, -148, -140, -154,
+	-154, -154, -154, -63, -154, -154, -154, -154, 13, -130,
+	13, 107, -44, 58, 11, 107, 68, 64, 133, 68,
+	-107, 29, 30, -2, -2, -214, 68, -108, -6, -37,
+	-108, -75, -142, 72, 75, -36, 48, -63, -44, -44,
+	-82, 26, 88, 82, 83, 84, -144, 128, 116, -149,
+	-143, -140, -74, -83, -86, -89, 76, 107, 105, 106,
+	90, -74, -74, -74, -74, -74, -74, -74, -74, -74,
+	-74, -74, -74, -74, -74, -74, -74, -74, -74, -74,
+	-74, -155, 70, 61, -74, -158, 70, -141, 80, 81,
+	-142, 216, 70, -142, -142, -74, -42, 26, -41, -43,
+	-214, 68, -214, -2, -41, -41, -44, -44, -90, 61,
+	-41, -90, 61, -41, -41, -35, -91, -92, 92, -90,
+	-142, -148, -214, -74, -142, -142, -41, -42, -41, -41,
+	-115, 173, -63, 35, 68, -197, -61, -62, 49, 9,
+	48, 55, -148, 27, 39, -46, -213, -213, -151, 173,
+	-150, 27, -115, 59, -46, -63, -46, -65, -148, 116,
+	-123, -120, 68, 271, 273, 274, 58, 85, -44, -171,
+	127, -189, -190, -191, -143, 61, 72, -180, -181, -182,
+	-192, 159, -198, 152, 154, 151, -183, 160, 146, 33,
+	69, -176, 82, 88, -172, 248, -166, 63, -166, -166,
+	-166, -166, -170, 223, -170, -170, -170, 63, 63, -166,
+	-166, -166, -174, 63, -174, -174, -175, 63, -175, -146,
+	59, -63, -154, 28, -154, -136, 141, 138, 139, -201,
+	137, 245, 223, 78, 34, 17, 289, 173, 304, 70,
+	174, -142, -142, -63, -63, 141, 138, -63, -63, -63,
+	-154, -63, -133, 105, 14, -148, -148, -63, 43, -44,
+	-44, -149, -106, -214, 27, -142, -109, -109, -126, 20,
+	13, 39, 39, -41, 13, 26, 82, 83, 84, 133,
+	-213, -83, -74, -74, -74, -40, 168, 87, 307, 191,
+	-214, 13, 107, -214, -41, 68, -44, -214, -214, -214,
+	68, 59, 27, 13, 13, -214, 13, 13, -214, -214,
+	-41, -94, -92, 94, -44, -214, 133, -214, 68, 68,
+	-214, -214, -214, -214, -72, 35, 39, -2, -213, -213,
+	-118, -122, -90, -47, -59, -60, 47, 52, 54, 50,
+	51, 260, -48, -48, 47, -60, -148, -85, -87, -86,
+	-213, -214, -51, -50, -52, -142, -67, 56, 149, 57,
+	-213, -150, -68, 14, -46, -68, -68, 133, -124, -125,
+	275, 272, 278, 70, 61, 68, -191, 97, 63, 70,
+	33, -183, -183, -184, 70, -184, 33, -168, 34, 82,
+	-173, 249, 72, -170, -170, -171, 35, -171, -171, -171,
+	-179, 61, -179, 72, 72, 58, -142, -154, -153, -207,
+	153, 159, 160, 155, 70, 146, 33, 152, 154, 173,
+	151, -207, -137, -138, 148, 27, 146, 33, 173, -206,
+	59, 193, 193, 148, -154, -130, 61, -44, 44, 133,
+	-213, -63, -45, 13, -74, 13, 116, -143, -42, -40,
+	87, -74, -74, -76, -73, -90, -44, -74, 67, 179,
+	-214, -43, -158, -155, -158, -74, -74, -74, -74, 298,
+	-101, 95, -44, 93, -143, -74, -74, -117, 58, -118,
+	-85, -2, -113, -142, -116, -142, -68, 68, 97, -48,
+	-47, 47, 47, 47, 53, 47, 53, 47, 53, -56,
+	58, -214, 68, -214, -214, 68, 108, 146, 146, 146,
+	-116, -101, -44, -68, 272, 276, 277, -190, -191, -194,
+	-193, -142, -198, -184, -184, 63, -169, 58, -74, 69,
+	-171, -171, 70, 129, 69, 68, 69, 68, 69, 68,
+	-63, -153, -153, -63, -153, -142, -204, 301, -205, 70,
+	-142, -142, -63, -133, -2, -68, -46, -74, -214, -74,
+	-214, -214, -213, -213, 67, 179, -214, -214, -214, 20,
+	20, 20, 20, -213, -39, 294, -44, 68, 68, 32,
+	-117, -214, -214, 68, 133, -214, 68, -101, -122, -44,
+	-55, -54, 58, 59, -54, 47, 47, 47, -44, -152,
+	27, -87, -152, -52, -53, -44, 144, 145, -213, -213,
+	-213, -214, -108, 69, 68, -166, -114, -177, 245, 11,
+	-170, 61, -170, 72, 72, -154, 31, -203, -202, -143,
+	63, -214, -95, 15, 14, -103, 173, -213, -213, -74,
+	-74, -74, -74, -74, -214, 61, -74, -74, 33, 39,
+	-2, -213, -142, -142, -142, -108, -44, 63, -148, -148,
+	-213, -213, -114, -114, -114, -151, -196, -195, 59, 156,
+	78, -193, 69, -178, 152, 33, 151, -78, -171, -171,
+	69, 69, -213, 68, 97, -114, -100, 16, 18, -44,
+	-101, 18, 14, -103, -214, -214, -214, -214, -38, 107,
+	301, -214, -214, 11, -85, -2, 133, -114, -213, -47,
+	-90, -214, -214, -214, -67, -195, 70, -185, 97, 61,
+	162, -167, 78, 33, 33, -199, -200, 173, -202, -191,
+	69, -110, 178, -44, -96, -98, -44, 187, 188, 185,
+	-214, -104, 70, -84, -44, -101, -214, 299, 55, 302,
+	-118, -214, -142, 69, -114, -214, -214, 72, -63, 61,
+	-214, 68, -142, -206, -111, -112, 58, 24, 23, 68,
+	-213, -213, 186, -214, -105, 90, 180, 72, 183, -214,
+	-104, 44, 300, 303, -214, 63, -200, 39, -204, 68,
+	21, 95, 22, -44, -98, -84, -84, -213, -105, 181,
+	182, 181, 182, 184, -214, 44, -114, 175, -112, 96,
+	-44, -214, -214, -99, -97, -213, -44, 87, 301, 69,
+	176, 9, -214, 68, -214, -105, 302, -209, -210, 58,
+	-213, -97, 303, -210, 58, 12, 11, -74, 172, -208,
+	163, 158, 161, 35, -208, -214, -214, 157, 34, 82,
 }
 
-var yyDef = [...]int{
+var yyDef = [...]int16{
 	23, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
-	21, 22, 571, 0, 0, 320, 320, 320, 320, 320,
-	320, 0, 650, 633, 0, 0, 0, 0, -2, 307,
-	308, 0, 310, 311, 880, 880, 880, 880, 880, 0,
-	0, 880, 0, 40, 41, 878, 1, 3, 579, 0,
-	28, 30, 0, 390, 391, 659, 660, 759, 760, 761,
-	762, 763, 764, 765, 766, 767, 768, 769, 770, 771,
-	772, 773, 774, 775, 776, 777, 778, 779, 780, 781,
-	782, 783, 784, 785, 786, 787, 788, 789, 790, 791,
-	792, 793, 794, 795, 796, 797, 798, 799, 800, 801,
-	802, 803, 804, 805, 806, 807, 808, 809, 810, 811,
-	812, 813, 814, 815, 816, 817, 818, 819, 820, 821,
-	822, 823, 824, 825, 826, 827, 828, 829, 830, 831,
-	832, 833, 834, 835, 836, 837, 838, 839, 840, 841,
-	842, 843, 844, 845, 846, 847, 848, 849, 850, 851,
-	852, 853, 854, 855, 856, 857, 858, 859, 860, 861,
-	862, 863, 864, 865, 866, 867, 868, 869, 870, 871,
-	872, 873, 874, 875, 876, 877, 0, 324, 327, 322,
-	0, 633, 633, 0, 0, 70, 71, 0, 0, 0,
-	864, 0, 631, 631, 631, 651, 652, 655, 656, 0,
-	0, 0, 634, 0, 629, 0, 629, 629, 629, 0,
-	258, 404, 0, 0, 881, 0, 881, 881, 270, 881,
-	881, 273, 881, 0, 881, 0, 280, 282, 283, 284,
-	285, 0, 289, 881, 304, 305, 294, 306, 309, 312,
-	313, 314, 315, 316, 880, 880, 319, 0, 583, 0,
-	0, 0, 29, 0, 571, 36, 0, 320, 325, 326,
-	330, 328, 329, 321, 0, 338, 342, 0, 413, 0,
-	418, 420, -2, -2, 0, 459, 460, 461, 462, 463,
-	0, 0, 0, 0, 0, 0, 0, 0, 485, 486,
-	487, 488, 556, 557, 558, 559, 560, 561, 562, 563,
-	422, 423, 553, 611, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 544, 0, 524, 524, 524, 524, 524,
-	524, 524, 524, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 49, 51, 404, 55, 0, 856,
-	615, -2, -2, 0, 0, 657, 658, -2, 770, -2,
-	663, 664, 665, 666, 667, 668, 669, 670, 671, 672,
-	673, 674, 675, 676, 677, 678, 679, 680, 681, 682,
-	683, 684, 685, 686, 687, 688, 689, 690, 691, 692,
-	693, 694, 695, 696, 697, 698, 699, 700, 701, 702,
-	703, 704, 705, 706, 707, 708, 709, 710, 711, 712,
-	713, 714, 715, 716, 717, 718, 719, 720, 721, 722,
-	723, 724, 725, 726, 727, 728, 729, 730, 731, 732,
-	733, 734, 735, 736, 737, 738, 739, 740, 741, 742,
-	743, 744, 745, 746, 747, 748, 749, 750, 751, 752,
-	753, 754, 755, 756, 757, 758, 0, 0, 89, 0,
-	87, 0, 881, 0, 0, 0, 0, 0, 0, 881,
-	0, 0, 0, 0, 249, 0, 0, 0, 0, 0,
-	0, 0, 257, 0, 259, 881, 261, 882, 883, 881,
-	881, 881, 0, 881, 881, 268, 269, 271, 272, 274,
-	881, 881, 276, 0, 297, 295, 296, 291, 292, 0,
-	286, 287, 290, 317, 318, 35, 879, 24, 0, 0,
-	580, 0, 572, 573, 576, 25, 31, 0, 579, 0,
-	327, 0, 332, 331, 323, 0, 339, 0, 0, 0,
-	343, 0, 345, 346, 0, 416, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 444, 445, 446, 447, 448, 449, 450, 419,
-	0, 437, 0, 477, 478, 479, 480, 481, 482, 483,
-	0, 334, 0, 0, 457, 0, 0, 0, 0, 0,
-	0, 0, 0, 330, 0, 545, 0, 508, 516, 0,
-	509, 517, 510, 518, 511, 0, 512, 519, 513, 520,
-	514, 515, 521, 0, 0, 0, 334, 0, 0, 53,
-	0, 403, 0, -2, 351, 352, 353, -2, 0, 659,
-	384, -2, 0, 0, 0, 47, 48, 0, 0, 0,
-	0, 56, 856, 58, 59, 0, 0, 0, 167, 624,
-	625, 626, 622, 211, 0, 0, 155, 151, 95, 96,
-	97, 144, 99, 144, 144, 144, 144, 164, 164, 164,
-	164, 127, 128, 129, 130, 131, 0, 0, 114, 144,
-	144, 144, 118, 134, 135, 136, 137, 138, 139, 140,
-	141, 100, 101, 102, 103, 104, 105, 106, 107, 108,
-	146, 146, 146, 148, 148, 653, 73, 0, 881, 0,
-	881, 85, 0, 225, 0, 0, 0, 0, 0, 0,
-	0, 252, 630, 0, 881, 255, 256, 405, 661, 662,
-	260, 262, 263, 264, 265, 266, 267, 275, 279, 0,
-	300, 0, 0, 281, 0, 584, 0, 0, 0, 0,
-	0, 575, 577, 578, 0, 583, 37, 330, 0, 564,
-	0, 0, 0, 333, 33, 414, 415, 417, 438, 0,
-	440, 442, 344, 340, 0, 554, -2, 424, 425, 453,
-	454, 455, 0, 0, 0, 0, 451, 429, 430, 431,
-	432, 433, 0, 464, 465, 466, 467, 468, 469, 470,
-	471, 472, 473, 474, 475, 476, 538, 539, 0, 490,
-	540, 541, 542, 543, 491, 0, 484, 0, 0, 335,
-	336, 456, 0, 610, 0, 0, 0, 0, 0, 461,
-	556, 0, 461, 556, 0, 0, 0, 551, 548, 0,
-	0, 553, 0, 525, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 402, 0, 0, 0, 0, 0, 0,
-	388, 389, 395, 0, 0, 0, 0, 383, 0, 0,
-	360, 407, 824, 385, 0, 411, 0, 411, 50, 411,
-	52, 0, 406, 616, 57, 0, 0, 62, 63, 617,
-	618, 619, 620, 0, 86, 212, 214, 217, 218, 219,
-	90, 91, 92, 0, 0, 199, 0, 0, 193, 193,
-	0, 191, 192, 88, 158, 156, 0, 153, 152, 98,
-	0, 164, 164, 121, 122, 167, 0, 167, 167, 167,
-	0, 0, 115, 116, 117, 109, 0, 110, 111, 112,
-	0, 113, 0, 0, 881, 75, 632, 76, 880, 0,
-	0, 645, 226, 635, 636, 637, 638, 639, 640, 641,
-	642, 643, 644, 0, 77, 228, 230, 229, 0, 0,
-	0, 250, 881, 254, 297, 278, 0, 0, 298, 299,
-	288, 0, 581, 582, 0, 574, 32, 26, 0, 627,
-	628, 565, 566, 347, 439, 441, 443, 0, 334, 426,
-	451, 434, 0, 427, 0, 0, 489, 421, 492, 0,
-	0, 458, -2, 495, 496, 0, 0, 0, 0, 0,
-	531, 0, 0, 532, 0, 571, 0, 549, 0, 0,
-	507, 0, 526, 0, 0, 527, 528, 529, 530, 604,
-	0, 0, 595, 0, 0, 411, 612, 0, -2, 0,
-	392, 0, 0, 380, 387, 375, 396, 0, 398, 0,
-	400, 401, 354, 356, 0, 361, 362, 0, 358, 0,
-	0, 0, 0, 386, 571, 0, 411, 45, 46, 0,
-	60, 61, 0, 0, 67, 168, 169, 0, 215, 0,
-	0, 0, 186, 193, 193, 189, 194, 190, 0, 160,
-	0, 157, 94, 154, 0, 167, 167, 123, 0, 124,
-	125, 126, 0, 142, 0, 0, 0, 0, 654, 74,
-	220, 880, 233, 234, 235, 236, 237, 238, 239, 240,
-	241, 242, 243, 880, 0, 880, 646, 647, 648, 649,
-	0, 80, 0, 0, 0, 0, 253, 300, 301, 302,
-	585, 0, 27, 411, 0, 341, 555, 0, 428, 0,
-	452, 435, 493, 337, 0, 0, 0, 0, 0, 0,
-	0, 0, 546, 506, 552, 0, 554, 0, 0, 38,
-	0, 604, 594, 606, 608, 0, 0, 0, 600, 0,
-	370, 571, 0, 0, 378, 393, 394, 373, 0, 374,
-	0, 0, 397, 399, 382, 0, 0, 0, 0, 0,
-	0, 579, 412, 44, 64, 65, 66, 213, 216, 0,
-	195, 144, 198, 187, 188, 0, 162, 0, 159, 145,
-	119, 120, 165, 166, 164, 0, 164, 0, 149, 0,
-	881, 221, 222, 223, 224, 0, 227, 0, 78, 79,
-	0, 232, 251, 277, 567, 348, 494, 436, 497, 499,
-	498, 0, 0, 0, 0, 0, 0, 0, 550, 0,
-	0, 0, 39, 0, 609, -2, 0, 0, 0, 54,
-	0, 579, 613, 614, 372, 379, 381, 376, 0, 0,
-	363, 364, 365, 0, 0, 0, 0, 0, 384, 43,
-	178, 0, 197, 0, 368, 170, 163, 0, 167, 143,
-	167, 0, 0, 72, 0, 81, 82, 0, 0, 569,
-	0, 0, 0, 0, 0, 533, 505, 547, 0, 0,
-	0, 607, 0, 598, 0, 602, 601, 371, 42, 0,
-	357, 0, 0, 0, 0, 0, 407, 177, 179, 0,
-	184, 0, 196, 0, 0, 175, 0, 172, 174, 161,
-	132, 133, 147, 150, 0, 0, 0, 0, 586, 0,
-	0, 500, 502, 501, 503, 0, 0, 0, 522, 523,
-	0, 597, 0, 0, 377, 387, 0, 408, 409, 410,
-	359, 180, 181, 0, 185, 183, 0, 369, 93, 0,
-	171, 173, 0, 245, 0, 83, 84, 77, 34, 0,
-	570, 568, 504, 0, 0, 0, 605, -2, 603, 366,
-	367, 182, 0, 176, 244, 0, 0, 80, 587, 588,
-	0, 0, 0, 534, 0, 537, 0, 246, 0, 231,
-	0, 590, 0, 0, 593, 535, 0, 0, 589, 0,
-	592, 0, 200, 0, 591, 0, 201, 202, 0, 0,
-	536, 203, 0, 0, 0, 0, 0, 204, 206, 207,
-	0, 0, 205, 247, 248, 208, 209, 210,
+	21, 22, -2, 0, 0, 38, 326, 326, 326, 326,
+	326, 326, 0, 705, 688, 0, 0, 0, 0, -2,
+	313, 314, 0, 316, 317, 953, 953, 953, 953, 953,
+	0, 0, 953, 40, 46, 47, 0, 951, 1, 3,
+	633, 0, 30, 0, 32, 0, 406, 407, 714, 715,
+	819, 820, 821, 822, 823, 824, 825, 826, 827, 828,
+	829, 830, 831, 832, 833, 834, 835, 836, 837, 838,
+	839, 840, 841, 842, 843, 844, 845, 846, 847, 848,
+	849, 850, 851, 852, 853, 854, 855, 856, 857, 858,
+	859, 860, 861, 862, 863, 864, 865, 866, 867, 868,
+	869, 870, 871, 872, 873, 874, 875, 876, 877, 878,
+	879, 880, 881, 882, 883, 884, 885, 886, 887, 888,
+	889, 890, 891, 892, 893, 894, 895, 896, 897, 898,
+	899, 900, 901, 902, 903, 904, 905, 906, 907, 908,
+	909, 910, 911, 912, 913, 914, 915, 916, 917, 918,
+	919, 920, 921, 922, 923, 924, 925, 926, 927, 928,
+	929, 930, 931, 932, 933, 934, 935, 936, 937, 938,
+	939, 940, 941, 942, 943, 944, 945, 946, 947, 948,
+	949, 950, 0, 330, 333, 0, 336, 339, 328, 0,
+	688, 688, 0, 0, 76, 77, 0, 0, 0, 936,
+	0, 686, 686, 686, 706, 707, 710, 711, 0, 0,
+	0, 689, 0, 684, 0, 684, 684, 684, 0, 264,
+	422, 0, 0, 954, 0, 954, 954, 276, 954, 954,
+	279, 954, 0, 954, 0, 286, 288, 289, 290, 291,
+	0, 295, 954, 310, 311, 300, 312, 315, 318, 319,
+	320, 321, 322, 953, 953, 325, 0, 638, 0, 0,
+	0, 0, 31, 30, 0, 0, -2, 42, 326, 331,
+	332, 334, 335, -2, 337, 338, 342, 340, 341, 327,
+	0, 350, 354, 0, 431, 0, 438, 440, -2, -2,
+	0, 479, 480, 481, 482, 483, 0, 0, 0, 0,
+	0, 0, 0, 0, 840, 922, 923, 509, 510, 511,
+	512, 850, 894, 600, 601, 602, 603, 604, 605, 606,
+	607, 442, 443, 596, 597, 666, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 587, 0, 567, 567, 567,
+	567, 567, 567, 567, 567, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 55, 57, 422, 61,
+	0, 927, 670, -2, -2, 0, 0, 712, 713, -2,
+	830, -2, 718, 719, 720, 721, 722, 723, 724, 725,
+	726, 727, 728, 729, 730, 731, 732, 733, 734, 735,
+	736, 737, 738, 739, 740, 741, 742, 743, 744, 745,
+	746, 747, 748, 749, 750, 751, 752, 753, 754, 755,
+	756, 757, 758, 759, 760, 761, 762, 763, 764, 765,
+	766, 767, 768, 769, 770, 771, 772, 773, 774, 775,
+	776, 777, 778, 779, 780, 781, 782, 783, 784, 785,
+	786, 787, 788, 789, 790, 791, 792, 793, 794, 795,
+	796, 797, 798, 799, 800, 801, 802, 803, 804, 805,
+	806, 807, 808, 809, 810, 811, 812, 813, 814, 815,
+	816, 817, 818, 0, 0, 95, 0, 93, 0, 954,
+	0, 0, 0, 0, 0, 0, 954, 0, 0, 0,
+	0, 255, 0, 0, 0, 0, 0, 0, 0, 263,
+	0, 265, 954, 267, 955, 956, 954, 954, 954, 0,
+	954, 954, 274, 275, 277, 278, 280, 954, 954, 282,
+	0, 303, 301, 302, 297, 298, 0, 292, 293, 296,
+	323, 324, 43, 952, 24, 0, 0, 634, 0, 637,
+	626, 627, 630, 25, 33, 0, 0, 0, 384, 633,
+	0, 339, 633, 0, 344, 343, 329, 0, 351, 0,
+	0, 0, 355, 0, 358, 359, 0, 434, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 464, 465, 466, 467,
+	468, 469, 470, 439, 0, 457, 0, 498, 499, 500,
+	501, 502, 503, 504, 0, 506, 507, 508, 0, 0,
+	346, 0, 0, 477, 0, 0, 0, 0, 0, 0,
+	0, 0, 342, 0, 588, 0, 551, 559, 0, 552,
+	560, 553, 561, 554, 0, 555, 562, 556, 563, 557,
+	558, 564, 0, 0, 0, 346, 0, 0, 59, 0,
+	421, 0, -2, 364, 365, 366, 369, 0, 714, 400,
+	-2, 0, 0, 0, 53, 54, 0, 0, 0, 0,
+	62, 927, 64, 65, 0, 0, 0, 173, 679, 680,
+	681, 677, 217, 0, 0, 161, 157, 101, 102, 103,
+	150, 105, 150, 150, 150, 150, 170, 170, 170, 170,
+	133, 134, 135, 136, 137, 0, 0, 120, 150, 150,
+	150, 124, 140, 141, 142, 143, 144, 145, 146, 147,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 152,
+	152, 152, 154, 154, 708, 79, 0, 954, 0, 954,
+	91, 0, 231, 0, 0, 0, 0, 0, 0, 0,
+	258, 685, 0, 954, 261, 262, 423, 716, 717, 266,
+	268, 269, 270, 271, 272, 273, 281, 285, 0, 306,
+	0, 0, 287, 0, 639, 0, 0, 0, 0, 0,
+	629, 631, 632, 26, 0, 0, 0, 638, 41, 342,
+	638, 0, 608, 0, 0, 0, 345, 36, 432, 433,
+	435, 0, 0, 458, 460, 462, 356, 357, 352, 0,
+	598, -2, 444, 445, 473, 474, 475, 0, 0, 0,
+	0, 471, 449, 450, 451, 452, 453, 0, 484, 485,
+	486, 487, 488, 489, 490, 491, 492, 493, 494, 495,
+	496, 497, 581, 582, 0, 514, 583, 584, 585, 586,
+	515, 0, 0, 505, 0, 0, 0, 0, 347, 348,
+	476, 0, 665, 0, 0, 0, 0, 0, 481, 600,
+	0, 481, 600, 0, 0, 0, 594, 591, 0, 0,
+	596, 0, 568, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 420, 0, 0, 0, 0, 0, 404, 405,
+	411, 0, 367, 0, 0, 0, 0, 376, 425, 890,
+	401, 0, 429, 0, 429, 56, 429, 58, 0, 424,
+	671, 63, 0, 0, 68, 69, 672, 673, 674, 675,
+	0, 92, 218, 220, 223, 224, 225, 96, 97, 98,
+	0, 0, 205, 0, 0, 199, 199, 0, 197, 198,
+	94, 164, 162, 0, 159, 158, 104, 0, 170, 170,
+	127, 128, 173, 0, 173, 173, 173, 0, 0, 121,
+	122, 123, 115, 0, 116, 117, 118, 0, 119, 0,
+	0, 954, 81, 687, 82, 953, 0, 0, 700, 232,
+	690, 691, 692, 693, 694, 695, 696, 697, 698, 699,
+	0, 83, 234, 236, 235, 0, 0, 0, 256, 954,
+	260, 303, 284, 0, 0, 304, 305, 294, 0, 635,
+	636, 0, 628, 34, 0, 385, 27, 28, 0, 682,
+	683, 609, 610, 360, 0, 0, 459, 461, 463, 0,
+	346, 446, 471, 454, 0, 447, 0, 0, 513, 0,
+	441, 0, 0, 519, 0, 0, 478, -2, 536, 537,
+	0, 0, 0, 0, 0, 574, 0, 0, 575, 0,
+	625, 0, 592, 0, 0, 548, 0, 569, 0, 0,
+	570, 571, 572, 573, 659, 0, 0, 650, 0, 0,
+	429, 667, 0, -2, 0, 0, 408, 0, 0, 0,
+	0, 0, 396, 391, 418, 419, 368, 0, 661, 663,
+	0, 372, 0, 377, 378, 0, 374, 0, 0, 0,
+	0, 402, 625, 0, 429, 51, 52, 0, 66, 67,
+	0, 0, 73, 174, 175, 0, 221, 0, 0, 0,
+	192, 199, 199, 195, 200, 196, 0, 166, 0, 163,
+	100, 160, 0, 173, 173, 129, 0, 130, 131, 132,
+	0, 148, 0, 0, 0, 0, 709, 80, 226, 953,
+	239, 240, 241, 242, 243, 244, 245, 246, 247, 248,
+	249, 953, 0, 953, 701, 702, 703, 704, 0, 86,
+	0, 0, 0, 0, 259, 306, 307, 308, 640, 0,
+	0, 29, 429, 0, 436, 0, 353, 599, 0, 448,
+	0, 472, 455, 516, 517, 518, 0, 0, 0, 0,
+	520, 349, 0, 0, 0, 0, 0, 0, 0, 0,
+	589, 547, 595, 0, 598, 0, 0, 44, 0, 659,
+	649, 0, 0, 655, 0, 386, 625, 0, 0, 394,
+	403, 409, 410, 412, 0, 414, 0, 416, 0, 389,
+	0, 398, 0, 664, 398, 0, 0, 0, 0, 0,
+	0, 633, 430, 50, 70, 71, 72, 219, 222, 0,
+	201, 150, 204, 193, 194, 0, 168, 0, 165, 151,
+	125, 126, 171, 172, 170, 0, 170, 0, 155, 0,
+	954, 227, 228, 229, 230, 0, 233, 0, 84, 85,
+	0, 238, 257, 283, 0, 611, 361, 437, 521, 456,
+	549, 550, 0, 526, 0, 0, 538, 540, 539, 0,
+	0, 0, 0, 0, 0, 0, 593, 0, 0, 0,
+	45, -2, 0, 0, 0, 60, 0, 633, 668, 669,
+	388, 395, 0, 0, 390, 413, 415, 417, 397, 0,
+	399, 662, 0, 379, 380, 381, 0, 0, 0, 0,
+	0, 400, 49, 184, 0, 203, 0, 176, 169, 0,
+	173, 149, 173, 0, 0, 78, 0, 87, 88, 0,
+	0, 35, 623, 0, 0, 625, 0, 0, 526, 0,
+	0, 0, 0, 576, 546, 590, 0, 0, 0, 0,
+	653, 0, 657, 656, 387, 48, 392, 0, 370, 373,
+	0, 0, 0, 0, 0, 425, 183, 185, 0, 190,
+	0, 202, 0, 181, 0, 178, 180, 167, 138, 139,
+	153, 156, 0, 0, 0, 0, 641, 0, 0, 0,
+	528, 0, 0, 625, 541, 543, 542, 544, 0, 0,
+	0, 565, 566, 0, 652, 0, 0, 0, 0, 403,
+	0, 426, 427, 428, 375, 186, 187, 0, 191, 189,
+	0, 99, 0, 177, 179, 0, 251, 0, 89, 90,
+	83, 37, 0, 624, 612, 613, 615, 907, 838, 861,
+	522, 0, 0, 527, 0, 528, 545, 0, 0, 0,
+	660, -2, 658, 393, 0, 382, 383, 188, 0, 182,
+	250, 0, 0, 86, 642, 643, 0, 0, 0, 0,
+	0, 0, 0, 524, 529, 0, 0, 0, 0, 523,
+	0, 577, 0, 580, 371, 0, 252, 0, 237, 0,
+	645, 0, 0, 648, 614, 0, 0, 0, 0, 531,
+	535, 532, 534, 533, 525, 578, 0, 0, 644, 0,
+	647, 616, 617, 0, 619, 0, 622, 0, 0, 206,
+	0, 646, 618, 0, 621, 530, 0, 207, 208, 0,
+	0, 620, 579, 209, 0, 0, 0, 0, 0, 210,
+	212, 213, 0, 0, 211, 253, 254, 214, 215, 216,
 }
 
-var yyTok1 = [...]int{
+var yyTok1 = [...]int16{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 82, 3, 3, 3, 111, 103, 3,
-	59, 61, 108, 106, 60, 107, 124, 109, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 282,
-	91, 90, 92, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 89, 3, 3, 3, 119, 111, 3,
+	63, 69, 116, 114, 68, 115, 133, 117, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 305,
+	98, 97, 99, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 283, 3, 284, 113, 3, 3, 3, 3, 3,
+	3, 306, 3, 307, 121, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 102, 3, 114,
+	3, 3, 3, 3, 110, 3, 122,
 }
 
-var yyTok2 = [...]int{
+var yyTok2 = [...]int16{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
-	52, 53, 54, 55, 56, 57, 58, 62, 63, 64,
-	65, 66, 67, 68, 69, 70, 71, 72, 73, 74,
-	75, 76, 77, 78, 79, 80, 81, 83, 84, 85,
-	86, 87, 88, 89, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 104, 105, 110, 112, 115, 116, 117,
-	118, 119, 120, 121, 122, 123, 125, 126, 127, 128,
-	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
+	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
+	62, 64, 65, 66, 67, 70, 71, 72, 73, 74,
+	75, 76, 77, 78, 79, 80, 81, 82, 83, 84,
+	85, 86, 87, 88, 90, 91, 92, 93, 94, 95,
+	96, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 112, 113, 118, 120, 123, 124, 125, 126, 127,
+	128, 129, 130, 131, 132, 134, 135, 136, 137, 138,
 	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
 	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
 	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
@@ -2874,9 +3278,14 @@ var yyTok2 = [...]int{
 	269, 270, 271, 272, 273, 274,
 }
 
-var yyTok3 = [...]int{
+var yyTok3 = [...]uint16{
 	57600, 275, 57601, 276, 57602, 277, 57603, 278, 57604, 279,
-	57605, 280, 57606, 281, 0,
+	57605, 280, 57606, 281, 57607, 282, 57608, 283, 57609, 284,
+	57610, 285, 57611, 286, 57612, 287, 57613, 288, 57614, 289,
+	57615, 290, 57616, 291, 57617, 292, 57618, 293, 57619, 294,
+	57620, 295, 57621, 296, 57622, 297, 57623, 298, 57624, 299,
+	57625, 300, 57626, 301, 57627, 302, 57628, 303, 57629, 304,
+	0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -2957,9 +3366,9 @@ func yyErrorMessage(state, lookAhead int) string {
 	expected := make([]int, 0, 4)
 
 	// Look for shiftable tokens.
-	base := yyPact[state]
+	base := int(yyPact[state])
 	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
-		if n := base + tok; n >= 0 && n < yyLast && yyChk[yyAct[n]] == tok {
+		if n := base + tok; n >= 0 && n < yyLast && int(yyChk[int(yyAct[n])]) == tok {
 			if len(expected) == cap(expected) {
 				return res
 			}
@@ -2969,13 +3378,13 @@ func yyErrorMessage(state, lookAhead int) string {
 
 	if yyDef[state] == -2 {
 		i := 0
-		for yyExca[i] != -1 || yyExca[i+1] != state {
+		for yyExca[i] != -1 || int(yyExca[i+1]) != state {
 			i += 2
 		}
 
 		// Look for tokens that we accept or reduce.
 		for i += 2; yyExca[i] >= 0; i += 2 {
-			tok := yyExca[i]
+			tok := int(yyExca[i])
 			if tok < TOKSTART || yyExca[i+1] == 0 {
 				continue
 			}
@@ -3006,30 +3415,30 @@ func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
 	token = 0
 	char = lex.Lex(lval)
 	if char <= 0 {
-		token = yyTok1[0]
+		token = int(yyTok1[0])
 		goto out
 	}
 	if char < len(yyTok1) {
-		token = yyTok1[char]
+		token = int(yyTok1[char])
 		goto out
 	}
 	if char >= yyPrivate {
 		if char < yyPrivate+len(yyTok2) {
-			token = yyTok2[char-yyPrivate]
+			token = int(yyTok2[char-yyPrivate])
 			goto out
 		}
 	}
 	for i := 0; i < len(yyTok3); i += 2 {
-		token = yyTok3[i+0]
+		token = int(yyTok3[i+0])
 		if token == char {
-			token = yyTok3[i+1]
+			token = int(yyTok3[i+1])
 			goto out
 		}
 	}
 
 out:
 	if token == 0 {
-		token = yyTok2[1] /* unknown char */
+		token = int(yyTok2[1]) /* unknown char */
 	}
 	if yyDebug >= 3 {
 		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
@@ -3084,7 +3493,7 @@ yystack:
 	yyS[yyp].yys = yystate
 
 yynewstate:
-	yyn = yyPact[yystate]
+	yyn = int(yyPact[yystate])
 	if yyn <= yyFlag {
 		goto yydefault /* simple state */
 	}
@@ -3095,8 +3504,8 @@ yynewstate:
 	if yyn < 0 || yyn >= yyLast {
 		goto yydefault
 	}
-	yyn = yyAct[yyn]
-	if yyChk[yyn] == yytoken { /* valid shift */
+	yyn = int(yyAct[yyn])
+	if int(yyChk[yyn]) == yytoken { /* valid shift */
 		yyrcvr.char = -1
 		yytoken = -1
 		yyVAL = yyrcvr.lval
@@ -3109,7 +3518,7 @@ yynewstate:
 
 yydefault:
 	/* default state action */
-	yyn = yyDef[yystate]
+	yyn = int(yyDef[yystate])
 	if yyn == -2 {
 		if yyrcvr.char < 0 {
 			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
@@ -3118,18 +3527,18 @@ yydefault:
 		/* look through exception table */
 		xi := 0
 		for {
-			if yyExca[xi+0] == -1 && yyExca[xi+1] == yystate {
+			if yyExca[xi+0] == -1 && int(yyExca[xi+1]) == yystate {
 				break
 			}
 			xi += 2
 		}
 		for xi += 2; ; xi += 2 {
-			yyn = yyExca[xi+0]
+			yyn = int(yyExca[xi+0])
 			if yyn < 0 || yyn == yytoken {
 				break
 			}
 		}
-		yyn = yyExca[xi+1]
+		yyn = int(yyExca[xi+1])
 		if yyn < 0 {
 			goto ret0
 		}
@@ -3151,10 +3560,10 @@ yydefault:
 
 			/* find a state where "error" is a legal shift action */
 			for yyp >= 0 {
-				yyn = yyPact[yyS[yyp].yys] + yyErrCode
+				yyn = int(yyPact[yyS[yyp].yys]) + yyErrCode
 				if yyn >= 0 && yyn < yyLast {
-					yystate = yyAct[yyn] /* simulate a shift of "error" */
-					if yyChk[yystate] == yyErrCode {
+					yystate = int(yyAct[yyn]) /* simulate a shift of "error" */
+					if int(yyChk[yystate]) == yyErrCode {
 						goto yystack
 					}
 				}
@@ -3190,7 +3599,7 @@ yydefault:
 	yypt := yyp
 	_ = yypt // guard against "declared and not used"
 
-	yyp -= yyR2[yyn]
+	yyp -= int(yyR2[yyn])
 	// yyp is now the index of $0. Perform the default action. Iff the
 	// reduced production is ε, $1 is possibly out of range.
 	if yyp+1 >= len(yyS) {
@@ -3201,16 +3610,16 @@ yydefault:
 	yyVAL = yyS[yyp+1]
 
 	/* consult goto table to find next state */
-	yyn = yyR1[yyn]
-	yyg := yyPgo[yyn]
+	yyn = int(yyR1[yyn])
+	yyg := int(yyPgo[yyn])
 	yyj := yyg + yyS[yyp].yys + 1
 
 	if yyj >= yyLast {
-		yystate = yyAct[yyg]
+		yystate = int(yyAct[yyg])
 	} else {
-		yystate = yyAct[yyj]
-		if yyChk[yystate] != -yyn {
-			yystate = yyAct[yyg]
+		yystate = int(yyAct[yyj])
+		if int(yyChk[yystate]) != -yyn {
+			yystate = int(yyAct[yyg])
 		}
 	}
 	// dummy call; replaced with literal code
@@ -3218,35 +3627,35 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:340
+//line sql.y:372
 		{
 			setParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:345
+//line sql.y:377
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:346
+//line sql.y:378
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:350
+//line sql.y:382
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
 	case 23:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:372
+//line sql.y:404
 		{
 			setParseTree(yylex, nil)
 		}
 	case 24:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:378
+//line sql.y:410
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -3256,85 +3665,121 @@ yydefault:
 		}
 	case 25:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:386
+//line sql.y:418
 		{
 			yyVAL.selStmt = &With{CommonTableExpressions: yyDollar[2].commonTableExpressions, Select: yyDollar[4].selStmt}
 		}
 	case 26:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:422
+		{
+			yyVAL.selStmt = &With{Recursive: true, CommonTableExpressions: yyDollar[3].commonTableExpressions, Select: yyDollar[5].selStmt}
+		}
+	case 27:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:390
+//line sql.y:426
 		{
 			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].str}
 		}
-	case 27:
+	case 28:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:430
+		{
+			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].str}
+		}
+	case 29:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:394
+//line sql.y:434
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, SelectExprs: SelectExprs{Nextval{Expr: yyDollar[5].expr}}, From: TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}}
 		}
-	case 28:
+	case 30:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:399
+//line sql.y:439
 		{
 			yyVAL.bytes = nil
 		}
-	case 29:
+	case 31:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:403
+//line sql.y:443
 		{
 			yyVAL.bytes = []byte(",")
 		}
-	case 30:
+	case 32:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:410
+//line sql.y:450
 		{
 			yyVAL.commonTableExpressions = []*CommonTableExpression{yyDollar[1].commonTableExpression}
 		}
-	case 31:
+	case 33:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:414
+//line sql.y:454
 		{
 			yyVAL.commonTableExpressions = append(yyDollar[1].commonTableExpressions, yyDollar[3].commonTableExpression)
 		}
-	case 32:
+	case 34:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:420
+//line sql.y:460
 		{
 			yyVAL.commonTableExpression = &CommonTableExpression{Name: yyDollar[1].tableIdent, Select: yyDollar[4].selStmt}
 		}
-	case 33:
+	case 35:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:464
+		{
+			yyVAL.commonTableExpression = &CommonTableExpression{Name: yyDollar[1].tableIdent, Columns: yyDollar[3].columns, Select: yyDollar[7].selStmt}
+		}
+	case 36:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:427
+//line sql.y:471
 		{
 			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
 		}
-	case 34:
+	case 37:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:434
+//line sql.y:478
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SelectExprs: yyDollar[6].selectExprs, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr), Trigger: yyDollar[11].triggers}
 		}
-	case 35:
+	case 38:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:485
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 39:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:440
+//line sql.y:489
 		{
-			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
+			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt}
 		}
-	case 36:
+	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:446
+//line sql.y:495
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 37:
+	case 41:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:450
+//line sql.y:499
+		{
+			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt}
+		}
+	case 42:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:505
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 43:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:509
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 38:
+	case 44:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:457
+//line sql.y:516
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].ins
@@ -3346,9 +3791,9 @@ yydefault:
 			ins.OnDup = OnDup(yyDollar[7].updateExprs)
 			yyVAL.statement = ins
 		}
-	case 39:
+	case 45:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:469
+//line sql.y:528
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
@@ -3358,334 +3803,334 @@ yydefault:
 			}
 			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
 		}
-	case 40:
+	case 46:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:481
+//line sql.y:540
 		{
 			yyVAL.str = InsertStr
 		}
-	case 41:
+	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:485
+//line sql.y:544
 		{
 			yyVAL.str = ReplaceStr
 		}
-	case 42:
+	case 48:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:491
+//line sql.y:550
 		{
 			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, TableExprs: yyDollar[4].tableExprs, Exprs: yyDollar[6].updateExprs, Where: NewWhere(WhereStr, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
 		}
-	case 43:
+	case 49:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:497
+//line sql.y:556
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
 		}
-	case 44:
+	case 50:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:501
+//line sql.y:560
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr)}
 		}
-	case 45:
+	case 51:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:505
+//line sql.y:564
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
-	case 46:
+	case 52:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:509
+//line sql.y:568
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
-	case 47:
+	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:514
+//line sql.y:573
 		{
 		}
-	case 48:
+	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:515
+//line sql.y:574
 		{
 		}
-	case 49:
+	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:519
+//line sql.y:578
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 50:
+	case 56:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:523
+//line sql.y:582
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 51:
+	case 57:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:529
+//line sql.y:588
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 52:
+	case 58:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:533
+//line sql.y:592
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 53:
+	case 59:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:538
+//line sql.y:597
 		{
 			yyVAL.partitions = nil
 		}
-	case 54:
+	case 60:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:542
+//line sql.y:601
 		{
 			yyVAL.partitions = yyDollar[3].partitions
 		}
-	case 55:
+	case 61:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:548
+//line sql.y:607
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
 		}
-	case 56:
+	case 62:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:552
+//line sql.y:611
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[4].setExprs}
 		}
-	case 57:
+	case 63:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:556
+//line sql.y:615
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[5].setExprs}
 		}
-	case 58:
+	case 64:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:560
+//line sql.y:619
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[4].setExprs}
 		}
-	case 59:
+	case 65:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:566
+//line sql.y:625
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 60:
+	case 66:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:570
+//line sql.y:629
 		{
 			yyVAL.setExprs = append(yyVAL.setExprs, yyDollar[3].setExpr)
 		}
-	case 61:
+	case 67:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:576
+//line sql.y:635
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(yyDollar[3].str))}
 		}
-	case 62:
+	case 68:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:580
+//line sql.y:639
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(TxReadWrite))}
 		}
-	case 63:
+	case 69:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:584
+//line sql.y:643
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(TxReadOnly))}
 		}
-	case 64:
+	case 70:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:590
+//line sql.y:649
 		{
 			yyVAL.str = IsolationLevelRepeatableRead
 		}
-	case 65:
+	case 71:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:594
+//line sql.y:653
 		{
 			yyVAL.str = IsolationLevelReadCommitted
 		}
-	case 66:
+	case 72:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:598
+//line sql.y:657
 		{
 			yyVAL.str = IsolationLevelReadUncommitted
 		}
-	case 67:
+	case 73:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:602
+//line sql.y:661
 		{
 			yyVAL.str = IsolationLevelSerializable
 		}
-	case 68:
+	case 74:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:608
+//line sql.y:667
 		{
 			yyVAL.str = SessionStr
 		}
-	case 69:
+	case 75:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:612
+//line sql.y:671
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 70:
+	case 76:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:618
+//line sql.y:677
 		{
 			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
 			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 71:
+	case 77:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:623
+//line sql.y:682
 		{
 			// Create table [name] like [name]
 			yyDollar[1].ddl.OptLike = yyDollar[2].optLike
 			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 72:
+	case 78:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:629
+//line sql.y:688
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[7].tableName}
 		}
-	case 73:
+	case 79:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:634
+//line sql.y:693
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[3].tableName.ToViewName()}
 		}
-	case 74:
+	case 80:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:638
+//line sql.y:697
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[5].tableName.ToViewName()}
 		}
-	case 75:
+	case 81:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:642
+//line sql.y:701
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 76:
+	case 82:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:646
+//line sql.y:705
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 77:
+	case 83:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:651
+//line sql.y:710
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
-	case 78:
+	case 84:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:655
+//line sql.y:714
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 79:
+	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:661
+//line sql.y:720
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 80:
+	case 86:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:666
+//line sql.y:725
 		{
 			var v []VindexParam
 			yyVAL.vindexParams = v
 		}
-	case 81:
+	case 87:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:671
+//line sql.y:730
 		{
 			yyVAL.vindexParams = yyDollar[2].vindexParams
 		}
-	case 82:
+	case 88:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:677
+//line sql.y:736
 		{
 			yyVAL.vindexParams = make([]VindexParam, 0, 4)
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
 		}
-	case 83:
+	case 89:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:682
+//line sql.y:741
 		{
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[3].vindexParam)
 		}
-	case 84:
+	case 90:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:688
+//line sql.y:747
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
-	case 85:
+	case 91:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:694
+//line sql.y:753
 		{
 			yyVAL.ddl = &DDL{Action: CreateStr, Table: yyDollar[4].tableName}
 			setDDL(yylex, yyVAL.ddl)
 		}
-	case 86:
+	case 92:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:701
+//line sql.y:760
 		{
 			yyVAL.TableSpec = yyDollar[2].TableSpec
 			yyVAL.TableSpec.Options = yyDollar[4].str
 		}
-	case 87:
+	case 93:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:708
+//line sql.y:767
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
-	case 88:
+	case 94:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:712
+//line sql.y:771
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
-	case 89:
+	case 95:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:718
+//line sql.y:777
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
 		}
-	case 90:
+	case 96:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:723
+//line sql.y:782
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 		}
-	case 91:
+	case 97:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:727
+//line sql.y:786
 		{
 			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
 		}
-	case 92:
+	case 98:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:731
+//line sql.y:790
 		{
 			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
 		}
-	case 93:
+	case 99:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:737
+//line sql.y:796
 		{
 			yyDollar[2].columnType.NotNull = yyDollar[3].boolVal
 			yyDollar[2].columnType.Default = yyDollar[4].optVal
@@ -3695,814 +4140,814 @@ yydefault:
 			yyDollar[2].columnType.Comment = yyDollar[8].sqlVal
 			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
 		}
-	case 94:
+	case 100:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:748
+//line sql.y:807
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
 			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
 		}
-	case 98:
+	case 104:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:759
+//line sql.y:818
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].sqlVal
 		}
-	case 99:
+	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:764
+//line sql.y:823
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 100:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:770
+//line sql.y:829
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 101:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:774
+//line sql.y:833
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 102:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:778
+//line sql.y:837
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 103:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:782
+//line sql.y:841
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 104:
+	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:786
+//line sql.y:845
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 105:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:790
+//line sql.y:849
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 106:
+	case 112:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:794
+//line sql.y:853
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 107:
+	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:798
+//line sql.y:857
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 108:
+	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:802
+//line sql.y:861
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 109:
+	case 115:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:808
+//line sql.y:867
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 110:
+	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:814
+//line sql.y:873
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 111:
+	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:820
+//line sql.y:879
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 112:
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:826
+//line sql.y:885
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 113:
+	case 119:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:832
+//line sql.y:891
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 114:
+	case 120:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:840
+//line sql.y:899
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 115:
+	case 121:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:844
+//line sql.y:903
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 116:
+	case 122:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:848
+//line sql.y:907
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 117:
+	case 123:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:852
+//line sql.y:911
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 118:
+	case 124:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:856
+//line sql.y:915
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 119:
+	case 125:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:862
+//line sql.y:921
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 120:
+	case 126:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:866
+//line sql.y:925
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 121:
+	case 127:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:870
+//line sql.y:929
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 122:
+	case 128:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:874
+//line sql.y:933
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 123:
+	case 129:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:878
+//line sql.y:937
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 124:
+	case 130:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:882
+//line sql.y:941
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 125:
+	case 131:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:886
+//line sql.y:945
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 126:
+	case 132:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:890
+//line sql.y:949
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 127:
+	case 133:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:894
+//line sql.y:953
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 128:
+	case 134:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:898
+//line sql.y:957
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 129:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:902
+//line sql.y:961
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 130:
+	case 136:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:906
+//line sql.y:965
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 131:
+	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:910
+//line sql.y:969
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 132:
+	case 138:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:914
+//line sql.y:973
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 133:
+	case 139:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:919
+//line sql.y:978
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 134:
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:925
+//line sql.y:984
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 135:
+	case 141:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:929
+//line sql.y:988
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 136:
+	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:933
+//line sql.y:992
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 137:
+	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:937
+//line sql.y:996
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 138:
+	case 144:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:941
+//line sql.y:1000
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 139:
+	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:945
+//line sql.y:1004
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 140:
+	case 146:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:949
+//line sql.y:1008
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 141:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:953
+//line sql.y:1012
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 142:
+	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:959
+//line sql.y:1018
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
 		}
-	case 143:
+	case 149:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:964
+//line sql.y:1023
 		{
 			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
 		}
-	case 144:
+	case 150:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:969
+//line sql.y:1028
 		{
 			yyVAL.sqlVal = nil
 		}
-	case 145:
+	case 151:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:973
+//line sql.y:1032
 		{
 			yyVAL.sqlVal = NewIntVal(yyDollar[2].bytes)
 		}
-	case 146:
+	case 152:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:978
+//line sql.y:1037
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 147:
+	case 153:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:982
+//line sql.y:1041
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 148:
+	case 154:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:990
+//line sql.y:1049
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 149:
+	case 155:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:994
+//line sql.y:1053
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 			}
 		}
-	case 150:
+	case 156:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1000
+//line sql.y:1059
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 151:
+	case 157:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1008
+//line sql.y:1067
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 152:
+	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1012
+//line sql.y:1071
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 153:
+	case 159:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1017
+//line sql.y:1076
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 154:
+	case 160:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1021
+//line sql.y:1080
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 155:
+	case 161:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1027
+//line sql.y:1086
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 156:
+	case 162:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1031
+//line sql.y:1090
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 157:
+	case 163:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1035
+//line sql.y:1094
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 158:
+	case 164:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1040
+//line sql.y:1099
 		{
 			yyVAL.optVal = nil
 		}
-	case 159:
+	case 165:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1044
+//line sql.y:1103
 		{
 			yyVAL.optVal = yyDollar[2].expr
 		}
-	case 160:
+	case 166:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1049
+//line sql.y:1108
 		{
 			yyVAL.optVal = nil
 		}
-	case 161:
+	case 167:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1053
+//line sql.y:1112
 		{
 			yyVAL.optVal = yyDollar[3].expr
 		}
-	case 162:
+	case 168:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1058
+//line sql.y:1117
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 163:
+	case 169:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1062
+//line sql.y:1121
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 164:
+	case 170:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1067
+//line sql.y:1126
 		{
 			yyVAL.str = ""
 		}
-	case 165:
+	case 171:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1071
+//line sql.y:1130
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 166:
+	case 172:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1075
+//line sql.y:1134
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 167:
+	case 173:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1080
+//line sql.y:1139
 		{
 			yyVAL.str = ""
 		}
-	case 168:
+	case 174:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1084
+//line sql.y:1143
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 169:
+	case 175:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1088
+//line sql.y:1147
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 170:
+	case 176:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1093
+//line sql.y:1152
 		{
 			yyVAL.colKeyOpt = colKeyNone
 		}
-	case 171:
+	case 177:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1097
+//line sql.y:1156
 		{
 			yyVAL.colKeyOpt = colKeyPrimary
 		}
-	case 172:
+	case 178:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1101
+//line sql.y:1160
 		{
 			yyVAL.colKeyOpt = colKey
 		}
-	case 173:
+	case 179:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1105
+//line sql.y:1164
 		{
 			yyVAL.colKeyOpt = colKeyUniqueKey
 		}
-	case 174:
+	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1109
+//line sql.y:1168
 		{
 			yyVAL.colKeyOpt = colKeyUnique
 		}
-	case 175:
+	case 181:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1114
+//line sql.y:1173
 		{
 			yyVAL.sqlVal = nil
 		}
-	case 176:
+	case 182:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1118
+//line sql.y:1177
 		{
 			yyVAL.sqlVal = NewStrVal(yyDollar[2].bytes)
 		}
-	case 177:
+	case 183:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1124
+//line sql.y:1183
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
 		}
-	case 178:
+	case 184:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1128
+//line sql.y:1187
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns}
 		}
-	case 179:
+	case 185:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1134
+//line sql.y:1193
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 180:
+	case 186:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1138
+//line sql.y:1197
 		{
 			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
 		}
-	case 181:
+	case 187:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1144
+//line sql.y:1203
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].bytes)}
 		}
-	case 182:
+	case 188:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1148
+//line sql.y:1207
 		{
 			// should not be string
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
 		}
-	case 183:
+	case 189:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1153
+//line sql.y:1212
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
 		}
-	case 184:
+	case 190:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1159
+//line sql.y:1218
 		{
 			yyVAL.str = ""
 		}
-	case 185:
+	case 191:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1163
+//line sql.y:1222
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 186:
+	case 192:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1169
+//line sql.y:1228
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
-	case 187:
+	case 193:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1173
+//line sql.y:1232
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
-	case 188:
+	case 194:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1177
+//line sql.y:1236
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Unique: true}
 		}
-	case 189:
+	case 195:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1181
+//line sql.y:1240
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(yyDollar[2].str), Unique: true}
 		}
-	case 190:
+	case 196:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1185
+//line sql.y:1244
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
-	case 191:
+	case 197:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1191
+//line sql.y:1250
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 192:
+	case 198:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1195
+//line sql.y:1254
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 193:
+	case 199:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1200
+//line sql.y:1259
 		{
 			yyVAL.str = ""
 		}
-	case 194:
+	case 200:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1204
+//line sql.y:1263
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 195:
+	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1210
+//line sql.y:1269
 		{
 			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
 		}
-	case 196:
+	case 202:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1214
+//line sql.y:1273
 		{
 			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
 		}
-	case 197:
+	case 203:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1220
+//line sql.y:1279
 		{
 			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].sqlVal}
 		}
-	case 198:
+	case 204:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1226
+//line sql.y:1285
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].constraintInfo}
 		}
-	case 199:
+	case 205:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1230
+//line sql.y:1289
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
 		}
-	case 200:
+	case 206:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:1237
+//line sql.y:1296
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns}
 		}
-	case 201:
+	case 207:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1241
+//line sql.y:1300
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction}
 		}
-	case 202:
+	case 208:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1245
+//line sql.y:1304
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnUpdate: yyDollar[11].ReferenceAction}
 		}
-	case 203:
+	case 209:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1249
+//line sql.y:1308
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction, OnUpdate: yyDollar[12].ReferenceAction}
 		}
-	case 204:
+	case 210:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1255
+//line sql.y:1314
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 205:
+	case 211:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1261
+//line sql.y:1320
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 206:
+	case 212:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1267
+//line sql.y:1326
 		{
 			yyVAL.ReferenceAction = Restrict
 		}
-	case 207:
+	case 213:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1271
+//line sql.y:1330
 		{
 			yyVAL.ReferenceAction = Cascade
 		}
-	case 208:
+	case 214:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1275
+//line sql.y:1334
 		{
 			yyVAL.ReferenceAction = NoAction
 		}
-	case 209:
+	case 215:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1279
+//line sql.y:1338
 		{
 			yyVAL.ReferenceAction = SetDefault
 		}
-	case 210:
+	case 216:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1283
+//line sql.y:1342
 		{
 			yyVAL.ReferenceAction = SetNull
 		}
-	case 211:
+	case 217:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1288
+//line sql.y:1347
 		{
 			yyVAL.str = ""
 		}
-	case 212:
+	case 218:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1292
+//line sql.y:1351
 		{
 			yyVAL.str = " " + string(yyDollar[1].str)
 		}
-	case 213:
+	case 219:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1296
+//line sql.y:1355
 		{
 			yyVAL.str = string(yyDollar[1].str) + ", " + string(yyDollar[3].str)
 		}
-	case 214:
+	case 220:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1304
+//line sql.y:1363
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 215:
+	case 221:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1308
+//line sql.y:1367
 		{
 			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 216:
+	case 222:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1312
+//line sql.y:1371
 		{
 			yyVAL.str = yyDollar[1].str + "=" + yyDollar[3].str
 		}
-	case 217:
+	case 223:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1318
+//line sql.y:1377
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 218:
+	case 224:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1322
+//line sql.y:1381
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
-	case 219:
+	case 225:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1326
+//line sql.y:1385
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 220:
+	case 226:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1332
+//line sql.y:1391
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 221:
+	case 227:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1336
+//line sql.y:1395
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 222:
+	case 228:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1340
+//line sql.y:1399
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 223:
+	case 229:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1344
+//line sql.y:1403
 		{
 			// Change this to a rename statement
 			yyVAL.statement = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[4].tableName}, ToTables: TableNames{yyDollar[7].tableName}}
 		}
-	case 224:
+	case 230:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1349
+//line sql.y:1408
 		{
 			// Rename an index can just be an alter
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 225:
+	case 231:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1354
+//line sql.y:1413
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName()}
 		}
-	case 226:
+	case 232:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1358
+//line sql.y:1417
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, PartitionSpec: yyDollar[5].partSpec}
 		}
-	case 227:
+	case 233:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1362
+//line sql.y:1421
 		{
 			yyVAL.statement = &DDL{Action: CreateVindexStr, VindexSpec: &VindexSpec{
 				Name:   yyDollar[5].colIdent,
@@ -4510,29 +4955,29 @@ yydefault:
 				Params: yyDollar[7].vindexParams,
 			}}
 		}
-	case 228:
+	case 234:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1370
+//line sql.y:1429
 		{
 			yyVAL.statement = &DDL{Action: DropVindexStr, VindexSpec: &VindexSpec{
 				Name: yyDollar[5].colIdent,
 			}}
 		}
-	case 229:
+	case 235:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1376
+//line sql.y:1435
 		{
 			yyVAL.statement = &DDL{Action: AddVschemaTableStr, Table: yyDollar[5].tableName}
 		}
-	case 230:
+	case 236:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1380
+//line sql.y:1439
 		{
 			yyVAL.statement = &DDL{Action: DropVschemaTableStr, Table: yyDollar[5].tableName}
 		}
-	case 231:
+	case 237:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1384
+//line sql.y:1443
 		{
 			yyVAL.statement = &DDL{
 				Action: AddColVindexStr,
@@ -4545,9 +4990,9 @@ yydefault:
 				VindexCols: yyDollar[9].columns,
 			}
 		}
-	case 232:
+	case 238:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1397
+//line sql.y:1456
 		{
 			yyVAL.statement = &DDL{
 				Action: DropColVindexStr,
@@ -4557,59 +5002,59 @@ yydefault:
 				},
 			}
 		}
-	case 244:
+	case 250:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1422
+//line sql.y:1481
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
 		}
-	case 245:
+	case 251:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1428
+//line sql.y:1487
 		{
 			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
 		}
-	case 246:
+	case 252:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1432
+//line sql.y:1491
 		{
 			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
 		}
-	case 247:
+	case 253:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1438
+//line sql.y:1497
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
 		}
-	case 248:
+	case 254:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1442
+//line sql.y:1501
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
-	case 249:
+	case 255:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1448
+//line sql.y:1507
 		{
 			yyVAL.statement = yyDollar[3].ddl
 		}
-	case 250:
+	case 256:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1454
+//line sql.y:1513
 		{
 			yyVAL.ddl = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[1].tableName}, ToTables: TableNames{yyDollar[3].tableName}}
 		}
-	case 251:
+	case 257:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1458
+//line sql.y:1517
 		{
 			yyVAL.ddl = yyDollar[1].ddl
 			yyVAL.ddl.FromTables = append(yyVAL.ddl.FromTables, yyDollar[3].tableName)
 			yyVAL.ddl.ToTables = append(yyVAL.ddl.ToTables, yyDollar[5].tableName)
 		}
-	case 252:
+	case 258:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1466
+//line sql.y:1525
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -4617,16 +5062,16 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, FromTables: yyDollar[4].tableNames, IfExists: exists}
 		}
-	case 253:
+	case 259:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1474
+//line sql.y:1533
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableName}
 		}
-	case 254:
+	case 260:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1479
+//line sql.y:1538
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -4634,148 +5079,148 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, FromTables: TableNames{yyDollar[4].tableName.ToViewName()}, IfExists: exists}
 		}
-	case 255:
+	case 261:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1487
+//line sql.y:1546
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 256:
+	case 262:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1491
+//line sql.y:1550
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 257:
+	case 263:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1497
+//line sql.y:1556
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
 		}
-	case 258:
+	case 264:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1501
+//line sql.y:1560
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
 		}
-	case 259:
+	case 265:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1506
+//line sql.y:1565
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName}
 		}
-	case 260:
+	case 266:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1512
+//line sql.y:1571
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 261:
+	case 267:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1517
+//line sql.y:1576
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 262:
+	case 268:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1521
+//line sql.y:1580
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 263:
+	case 269:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1526
+//line sql.y:1585
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 264:
+	case 270:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1530
+//line sql.y:1589
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 265:
+	case 271:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1534
+//line sql.y:1593
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName}
 		}
-	case 266:
+	case 272:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1538
+//line sql.y:1597
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 267:
+	case 273:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1542
+//line sql.y:1601
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 268:
+	case 274:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1546
+//line sql.y:1605
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 269:
+	case 275:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1550
+//line sql.y:1609
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 270:
+	case 276:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1554
+//line sql.y:1613
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 271:
+	case 277:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1558
+//line sql.y:1617
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 272:
+	case 278:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1562
+//line sql.y:1621
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 273:
+	case 279:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1566
+//line sql.y:1625
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 274:
+	case 280:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1570
+//line sql.y:1629
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 275:
+	case 281:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1574
+//line sql.y:1633
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 276:
+	case 282:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1578
+//line sql.y:1637
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 277:
+	case 283:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1582
+//line sql.y:1641
 		{
 			showTablesOpt := &ShowTablesOpt{Full: yyDollar[2].str, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}
 			yyVAL.statement = &Show{Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt, OnTable: yyDollar[5].tableName}
 		}
-	case 278:
+	case 284:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1587
+//line sql.y:1646
 		{
 			// this is ugly, but I couldn't find a better way for now
 			if yyDollar[3].str == "processlist" {
@@ -4785,722 +5230,794 @@ yydefault:
 				yyVAL.statement = &Show{Type: yyDollar[3].str, ShowTablesOpt: showTablesOpt}
 			}
 		}
-	case 279:
+	case 285:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1597
+//line sql.y:1656
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 280:
+	case 286:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1601
+//line sql.y:1660
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 281:
+	case 287:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1605
+//line sql.y:1664
 		{
 			// Cannot dereference $4 directly, or else the parser stackcannot be pooled. See yyParsePooled
 			showCollationFilterOpt := yyDollar[4].expr
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowCollationFilterOpt: &showCollationFilterOpt}
 		}
-	case 282:
+	case 288:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1611
+//line sql.y:1670
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 283:
+	case 289:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1615
+//line sql.y:1674
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 284:
+	case 290:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1619
+//line sql.y:1678
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 285:
+	case 291:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1623
+//line sql.y:1682
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 286:
+	case 292:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1627
+//line sql.y:1686
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 287:
+	case 293:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1631
+//line sql.y:1690
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 288:
+	case 294:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1635
+//line sql.y:1694
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), OnTable: yyDollar[5].tableName}
 		}
-	case 289:
+	case 295:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1639
+//line sql.y:1698
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 290:
+	case 296:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1649
+//line sql.y:1708
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 291:
+	case 297:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1655
+//line sql.y:1714
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 292:
+	case 298:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1659
+//line sql.y:1718
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 293:
+	case 299:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1665
+//line sql.y:1724
 		{
 			yyVAL.str = ""
 		}
-	case 294:
+	case 300:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1669
+//line sql.y:1728
 		{
 			yyVAL.str = "full "
 		}
-	case 295:
+	case 301:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1675
+//line sql.y:1734
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 296:
+	case 302:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1679
+//line sql.y:1738
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 297:
+	case 303:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1685
+//line sql.y:1744
 		{
 			yyVAL.str = ""
 		}
-	case 298:
+	case 304:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1689
+//line sql.y:1748
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 299:
+	case 305:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1693
+//line sql.y:1752
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 300:
+	case 306:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1699
+//line sql.y:1758
 		{
 			yyVAL.showFilter = nil
 		}
-	case 301:
+	case 307:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1703
+//line sql.y:1762
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 302:
+	case 308:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1707
+//line sql.y:1766
 		{
 			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
 		}
-	case 303:
+	case 309:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1713
+//line sql.y:1772
 		{
 			yyVAL.str = ""
 		}
-	case 304:
+	case 310:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1717
+//line sql.y:1776
 		{
 			yyVAL.str = SessionStr
 		}
-	case 305:
+	case 311:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1721
+//line sql.y:1780
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 306:
+	case 312:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1727
+//line sql.y:1786
 		{
 			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
 		}
-	case 307:
+	case 313:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1731
+//line sql.y:1790
 		{
 			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
 		}
-	case 308:
+	case 314:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1737
+//line sql.y:1796
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 309:
+	case 315:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1741
+//line sql.y:1800
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 310:
+	case 316:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1747
+//line sql.y:1806
 		{
 			yyVAL.statement = &Commit{}
 		}
-	case 311:
+	case 317:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1753
+//line sql.y:1812
 		{
 			yyVAL.statement = &Rollback{}
 		}
-	case 312:
+	case 318:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1759
+//line sql.y:1818
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 313:
+	case 319:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1763
+//line sql.y:1822
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 314:
+	case 320:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1767
+//line sql.y:1826
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 315:
+	case 321:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1771
+//line sql.y:1830
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 316:
+	case 322:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1775
+//line sql.y:1834
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 317:
+	case 323:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1779
+//line sql.y:1838
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 318:
+	case 324:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1783
+//line sql.y:1842
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 319:
+	case 325:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1789
+//line sql.y:1848
 		{
 			yyVAL.statement = &DDL{Action: FlushStr}
 		}
-	case 320:
+	case 326:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1793
+//line sql.y:1852
 		{
 			setAllowComments(yylex, true)
 		}
-	case 321:
+	case 327:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1797
+//line sql.y:1856
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 322:
+	case 328:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1803
+//line sql.y:1862
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 323:
+	case 329:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1807
+//line sql.y:1866
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 324:
+	case 330:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1813
+//line sql.y:1872
 		{
 			yyVAL.str = UnionStr
 		}
-	case 325:
+	case 331:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1817
+//line sql.y:1876
 		{
 			yyVAL.str = UnionAllStr
 		}
-	case 326:
+	case 332:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1821
+//line sql.y:1880
 		{
 			yyVAL.str = UnionDistinctStr
 		}
-	case 327:
+	case 333:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1884
+		{
+			yyVAL.str = ExceptStr
+		}
+	case 334:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1888
+		{
+			yyVAL.str = ExceptAllStr
+		}
+	case 335:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1892
+		{
+			yyVAL.str = ExceptDistinctStr
+		}
+	case 336:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1898
+		{
+			yyVAL.str = IntersectStr
+		}
+	case 337:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1902
+		{
+			yyVAL.str = IntersectAllStr
+		}
+	case 338:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1906
+		{
+			yyVAL.str = IntersectDistinctStr
+		}
+	case 339:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1826
+//line sql.y:1911
 		{
 			yyVAL.str = ""
 		}
-	case 328:
+	case 340:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1830
+//line sql.y:1915
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 329:
+	case 341:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1834
+//line sql.y:1919
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 330:
+	case 342:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1839
+//line sql.y:1924
 		{
 			yyVAL.str = ""
 		}
-	case 331:
+	case 343:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1843
+//line sql.y:1928
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 332:
+	case 344:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1848
+//line sql.y:1933
 		{
 			yyVAL.str = ""
 		}
-	case 333:
+	case 345:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1852
+//line sql.y:1937
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 334:
+	case 346:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1857
+//line sql.y:1942
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 335:
+	case 347:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1861
+//line sql.y:1946
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 336:
+	case 348:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1867
+//line sql.y:1952
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 337:
+	case 349:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1871
+//line sql.y:1956
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 338:
+	case 350:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1877
+//line sql.y:1962
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 339:
+	case 351:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1881
+//line sql.y:1966
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 340:
+	case 352:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1885
+//line sql.y:1970
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
-	case 341:
+	case 353:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1889
+//line sql.y:1974
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
-	case 342:
+	case 354:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1894
+//line sql.y:1980
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 343:
+	case 355:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1898
+//line sql.y:1984
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 344:
+	case 356:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1902
+//line sql.y:1988
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 346:
+	case 357:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1992
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[2].bytes))
+		}
+	case 359:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1909
+//line sql.y:1999
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 347:
+	case 360:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1914
+//line sql.y:2004
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 348:
+	case 361:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1918
+//line sql.y:2008
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 349:
+	case 362:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1924
+//line sql.y:2014
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 350:
+	case 363:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1928
+//line sql.y:2018
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 353:
+	case 366:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1938
+//line sql.y:2028
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
-	case 354:
+	case 367:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2032
+		{
+			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery, As: yyDollar[2].tableIdent}
+		}
+	case 368:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1942
+//line sql.y:2036
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery, As: yyDollar[3].tableIdent}
 		}
-	case 355:
+	case 369:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1946
+//line sql.y:2040
 		{
 			// missed alias for subquery
 			yylex.Error("Every derived table must have its own alias")
 			return 1
 		}
-	case 356:
+	case 370:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2046
+		{
+			yyVAL.tableExpr = &AliasedTableExpr{Expr: &ValuesTable{Rows: yyDollar[3].values}, As: yyDollar[6].tableIdent}
+		}
+	case 371:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:2050
+		{
+			yyVAL.tableExpr = &AliasedTableExpr{Expr: &ValuesTable{Rows: yyDollar[3].values}, As: yyDollar[6].tableIdent, Columns: yyDollar[8].columns}
+		}
+	case 372:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1952
+//line sql.y:2054
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 357:
+	case 373:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1956
+//line sql.y:2058
 		{
 			yyVAL.tableExpr = &TableValuedFunction{Name: NewColIdent(string(yyDollar[1].bytes)), Args: yyDollar[3].tableValuedFunctionArguments, As: yyDollar[6].tableIdent}
 		}
-	case 358:
+	case 374:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1962
+//line sql.y:2064
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
 		}
-	case 359:
+	case 375:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1966
+//line sql.y:2068
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
 		}
-	case 360:
+	case 376:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1971
+//line sql.y:2073
 		{
 			yyVAL.tableValuedFunctionArguments = nil
 		}
-	case 361:
+	case 377:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1975
+//line sql.y:2077
 		{
 			yyVAL.tableValuedFunctionArguments = yyDollar[1].tableValuedFunctionArguments
 		}
-	case 362:
+	case 378:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1981
+//line sql.y:2083
 		{
 			yyVAL.tableValuedFunctionArguments = TableValuedFunctionArguments{yyDollar[1].tableValuedFunctionArgument}
 		}
-	case 363:
+	case 379:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1985
+//line sql.y:2087
 		{
 			yyVAL.tableValuedFunctionArguments = append(yyVAL.tableValuedFunctionArguments, yyDollar[3].tableValuedFunctionArgument)
 		}
-	case 364:
+	case 380:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1991
+//line sql.y:2093
 		{
 			yyVAL.tableValuedFunctionArgument = &TableValuedFunctionArgument{Name: yyDollar[1].colIdent, Value: yyDollar[3].tableValuedFunctionArgumentValue}
 		}
-	case 365:
+	case 381:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1997
+//line sql.y:2099
 		{
 			yyVAL.tableValuedFunctionArgumentValue = &ExprTableValuedFunctionArgumentValue{Expr: yyDollar[1].expr}
 		}
-	case 366:
+	case 382:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2001
+//line sql.y:2103
 		{
 			yyVAL.tableValuedFunctionArgumentValue = &TableDescriptorTableValuedFunctionArgumentValue{Table: yyDollar[3].tableExpr}
 		}
-	case 367:
+	case 383:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2005
+//line sql.y:2107
 		{
 			yyVAL.tableValuedFunctionArgumentValue = &FieldDescriptorTableValuedFunctionArgumentValue{Field: yyDollar[3].colName}
 		}
-	case 368:
+	case 384:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2011
+//line sql.y:2113
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 369:
+	case 385:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2015
+//line sql.y:2117
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 370:
+	case 386:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2021
+//line sql.y:2123
 		{
 			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
 		}
-	case 371:
+	case 387:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2025
+//line sql.y:2127
 		{
 			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
 		}
-	case 372:
+	case 388:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2038
+//line sql.y:2140
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Strategy: yyDollar[2].str, Join: yyDollar[3].str, RightExpr: yyDollar[4].tableExpr, Condition: yyDollar[5].joinCondition}
 		}
-	case 373:
+	case 389:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2042
+//line sql.y:2144
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 374:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2046
+	case 390:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2148
 		{
-			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
+			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Strategy: yyDollar[2].str, Join: yyDollar[3].str, RightExpr: yyDollar[4].tableExpr, Condition: yyDollar[5].joinCondition}
 		}
-	case 375:
+	case 391:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2050
+//line sql.y:2152
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 376:
+	case 392:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2056
+//line sql.y:2158
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 377:
+	case 393:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2058
+//line sql.y:2160
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
-	case 378:
+	case 394:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2062
+//line sql.y:2164
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 379:
+	case 395:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2064
+//line sql.y:2166
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 380:
+	case 396:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2068
+//line sql.y:2170
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 381:
+	case 397:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2070
+//line sql.y:2172
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 382:
+	case 398:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2073
+//line sql.y:2175
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 383:
+	case 399:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2075
+//line sql.y:2177
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 384:
+	case 400:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2078
+//line sql.y:2181
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 385:
+	case 401:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2082
+//line sql.y:2185
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 386:
+	case 402:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2086
+//line sql.y:2189
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 387:
+	case 403:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2091
+//line sql.y:2194
 		{
 			yyVAL.str = UndefinedJoinStrategy
 		}
-	case 388:
+	case 404:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2093
+//line sql.y:2196
 		{
 			yyVAL.str = LookupJoinStrategy
 		}
-	case 389:
+	case 405:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2095
+//line sql.y:2198
 		{
 			yyVAL.str = StreamJoinStrategy
 		}
-	case 391:
+	case 407:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2100
+//line sql.y:2203
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 392:
+	case 408:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2106
+//line sql.y:2209
 		{
 			yyVAL.str = JoinStr
 		}
-	case 393:
+	case 409:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2110
+//line sql.y:2213
 		{
 			yyVAL.str = JoinStr
 		}
-	case 394:
+	case 410:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2114
+//line sql.y:2217
 		{
 			yyVAL.str = JoinStr
 		}
-	case 395:
+	case 411:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2120
+//line sql.y:2223
 		{
 			yyVAL.str = StraightJoinStr
 		}
-	case 396:
+	case 412:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2126
+//line sql.y:2229
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 397:
+	case 413:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2130
+//line sql.y:2233
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 398:
+	case 414:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2134
+//line sql.y:2237
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 399:
+	case 415:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2138
+//line sql.y:2241
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 400:
+	case 416:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2144
+//line sql.y:2245
+		{
+			yyVAL.str = FullJoinStr
+		}
+	case 417:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2249
+		{
+			yyVAL.str = FullJoinStr
+		}
+	case 418:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2255
 		{
 			yyVAL.str = NaturalJoinStr
 		}
-	case 401:
+	case 419:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2148
+//line sql.y:2259
 		{
 			if yyDollar[2].str == LeftJoinStr {
 				yyVAL.str = NaturalLeftJoinStr
@@ -5508,477 +6025,495 @@ yydefault:
 				yyVAL.str = NaturalRightJoinStr
 			}
 		}
-	case 402:
+	case 420:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2158
+//line sql.y:2269
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 403:
+	case 421:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2162
+//line sql.y:2273
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 404:
+	case 422:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2168
+//line sql.y:2279
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 405:
+	case 423:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2172
+//line sql.y:2283
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 406:
+	case 424:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2178
+//line sql.y:2289
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 407:
+	case 425:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2183
+//line sql.y:2294
 		{
 			yyVAL.indexHints = nil
 		}
-	case 408:
+	case 426:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2187
+//line sql.y:2298
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].columns}
 		}
-	case 409:
+	case 427:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2191
+//line sql.y:2302
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].columns}
 		}
-	case 410:
+	case 428:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2195
+//line sql.y:2306
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].columns}
 		}
-	case 411:
+	case 429:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2200
+//line sql.y:2311
 		{
 			yyVAL.expr = nil
 		}
-	case 412:
+	case 430:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2204
+//line sql.y:2315
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 413:
+	case 431:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2210
+//line sql.y:2321
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 414:
+	case 432:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2214
+//line sql.y:2325
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 415:
+	case 433:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2218
+//line sql.y:2329
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 416:
+	case 434:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2222
+//line sql.y:2333
 		{
 			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
 		}
-	case 417:
+	case 435:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2226
+//line sql.y:2337
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
 		}
-	case 418:
+	case 436:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2341
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: IsDistinctFromStr, Right: yyDollar[5].expr}
+		}
+	case 437:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2345
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: IsNotDistinctFromStr, Right: yyDollar[6].expr}
+		}
+	case 438:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2230
+//line sql.y:2349
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 419:
+	case 439:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2234
+//line sql.y:2353
 		{
 			yyVAL.expr = &Default{ColName: yyDollar[2].str}
 		}
-	case 420:
+	case 440:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2240
+//line sql.y:2359
 		{
 			yyVAL.str = ""
 		}
-	case 421:
+	case 441:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2244
+//line sql.y:2363
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 422:
+	case 442:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2250
+//line sql.y:2369
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 423:
+	case 443:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2254
+//line sql.y:2373
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 424:
+	case 444:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2260
+//line sql.y:2379
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
 		}
-	case 425:
+	case 445:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2264
+//line sql.y:2383
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
 		}
-	case 426:
+	case 446:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2268
+//line sql.y:2387
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
 		}
-	case 427:
+	case 447:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2272
+//line sql.y:2391
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
-	case 428:
+	case 448:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2276
+//line sql.y:2395
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
-	case 429:
+	case 449:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2280
+//line sql.y:2399
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeRegexpStr, Right: yyDollar[3].expr}
 		}
-	case 430:
+	case 450:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2284
+//line sql.y:2403
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeRegexpCaseInsensitiveStr, Right: yyDollar[3].expr}
 		}
-	case 431:
+	case 451:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2288
+//line sql.y:2407
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeRegexpStr, Right: yyDollar[3].expr}
 		}
-	case 432:
+	case 452:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2292
+//line sql.y:2411
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeRegexpCaseInsensitiveStr, Right: yyDollar[3].expr}
 		}
-	case 433:
+	case 453:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2296
+//line sql.y:2415
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
 		}
-	case 434:
+	case 454:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2300
+//line sql.y:2419
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
 		}
-	case 435:
+	case 455:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2304
+//line sql.y:2423
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
-	case 436:
+	case 456:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2308
+//line sql.y:2427
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
-	case 437:
+	case 457:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2312
+//line sql.y:2431
 		{
 			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
-	case 438:
+	case 458:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2318
+//line sql.y:2437
 		{
 			yyVAL.str = IsNullStr
 		}
-	case 439:
+	case 459:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2322
+//line sql.y:2441
 		{
 			yyVAL.str = IsNotNullStr
 		}
-	case 440:
+	case 460:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2326
+//line sql.y:2445
 		{
 			yyVAL.str = IsTrueStr
 		}
-	case 441:
+	case 461:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2330
+//line sql.y:2449
 		{
 			yyVAL.str = IsNotTrueStr
 		}
-	case 442:
+	case 462:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2334
+//line sql.y:2453
 		{
 			yyVAL.str = IsFalseStr
 		}
-	case 443:
+	case 463:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2338
+//line sql.y:2457
 		{
 			yyVAL.str = IsNotFalseStr
 		}
-	case 444:
+	case 464:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2344
+//line sql.y:2463
 		{
 			yyVAL.str = EqualStr
 		}
-	case 445:
+	case 465:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2348
+//line sql.y:2467
 		{
 			yyVAL.str = LessThanStr
 		}
-	case 446:
+	case 466:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2352
+//line sql.y:2471
 		{
 			yyVAL.str = GreaterThanStr
 		}
-	case 447:
+	case 467:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2356
+//line sql.y:2475
 		{
 			yyVAL.str = LessEqualStr
 		}
-	case 448:
+	case 468:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2360
+//line sql.y:2479
 		{
 			yyVAL.str = GreaterEqualStr
 		}
-	case 449:
+	case 469:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2364
+//line sql.y:2483
 		{
 			yyVAL.str = NotEqualStr
 		}
-	case 450:
+	case 470:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2368
+//line sql.y:2487
 		{
 			yyVAL.str = NullSafeEqualStr
 		}
-	case 451:
+	case 471:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2373
+//line sql.y:2492
 		{
 			yyVAL.expr = nil
 		}
-	case 452:
+	case 472:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2377
+//line sql.y:2496
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 453:
+	case 473:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2383
+//line sql.y:2502
 		{
 			yyVAL.colTuple = yyDollar[1].valTuple
 		}
-	case 454:
+	case 474:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2387
+//line sql.y:2506
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
-	case 455:
+	case 475:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2391
+//line sql.y:2510
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
-	case 456:
+	case 476:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2397
+//line sql.y:2516
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 457:
+	case 477:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2403
+//line sql.y:2522
 		{
 			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
-	case 458:
+	case 478:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2407
+//line sql.y:2526
 		{
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
-	case 459:
+	case 479:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2413
+//line sql.y:2532
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 460:
+	case 480:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2417
+//line sql.y:2536
 		{
 			yyVAL.expr = yyDollar[1].boolVal
 		}
-	case 461:
+	case 481:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2421
+//line sql.y:2540
 		{
 			yyVAL.expr = yyDollar[1].colName
 		}
-	case 462:
+	case 482:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2425
+//line sql.y:2544
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 463:
+	case 483:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2429
+//line sql.y:2548
 		{
 			yyVAL.expr = yyDollar[1].subquery
 		}
-	case 464:
+	case 484:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2433
+//line sql.y:2552
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
 		}
-	case 465:
+	case 485:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2437
+//line sql.y:2556
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
 		}
-	case 466:
+	case 486:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2441
+//line sql.y:2560
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ConcatStr, Right: yyDollar[3].expr}
+		}
+	case 487:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2564
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
 		}
-	case 467:
+	case 488:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2445
+//line sql.y:2568
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
 		}
-	case 468:
+	case 489:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2449
+//line sql.y:2572
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
 		}
-	case 469:
+	case 490:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2453
+//line sql.y:2576
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
 		}
-	case 470:
+	case 491:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2457
+//line sql.y:2580
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
 		}
-	case 471:
+	case 492:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2461
+//line sql.y:2584
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
 		}
-	case 472:
+	case 493:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2465
+//line sql.y:2588
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 473:
+	case 494:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2469
+//line sql.y:2592
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 474:
+	case 495:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2473
+//line sql.y:2596
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
 		}
-	case 475:
+	case 496:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2477
+//line sql.y:2600
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
 		}
-	case 476:
+	case 497:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2481
+//line sql.y:2604
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
 		}
-	case 477:
+	case 498:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2485
+//line sql.y:2608
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 478:
+	case 499:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2489
+//line sql.y:2612
 		{
 			yyVAL.expr = &UnaryExpr{Operator: UBinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 479:
+	case 500:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2493
+//line sql.y:2616
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Utf8mb4Str, Expr: yyDollar[2].expr}
 		}
-	case 480:
+	case 501:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2497
+//line sql.y:2620
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -5986,9 +6521,9 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UPlusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 481:
+	case 502:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2505
+//line sql.y:2628
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -6002,21 +6537,21 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 482:
+	case 503:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2519
+//line sql.y:2642
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
 		}
-	case 483:
+	case 504:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2523
+//line sql.y:2646
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
 		}
-	case 484:
+	case 505:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2527
+//line sql.y:2650
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -6024,447 +6559,595 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
 		}
-	case 489:
+	case 506:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2658
+		{
+			yyVAL.expr = &TypedLiteral{Type: DateStr, Val: yyDollar[2].bytes}
+		}
+	case 507:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2662
+		{
+			yyVAL.expr = &TypedLiteral{Type: TimeStr, Val: yyDollar[2].bytes}
+		}
+	case 508:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2666
+		{
+			yyVAL.expr = &TypedLiteral{Type: TimestampStr, Val: yyDollar[2].bytes}
+		}
+	case 513:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2539
+//line sql.y:2674
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ArrayElement, Right: yyDollar[3].expr}
 		}
-	case 490:
+	case 514:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2543
+//line sql.y:2678
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[1].expr, Type: yyDollar[3].convertType}
 		}
-	case 491:
+	case 515:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2547
+//line sql.y:2682
 		{
 			yyVAL.expr = &ObjectFieldAccess{Object: yyDollar[1].expr, Field: yyDollar[3].colIdent}
 		}
-	case 492:
+	case 516:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2686
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("at_time_zone"), Exprs: SelectExprs{&AliasedExpr{Expr: yyDollar[1].expr}, &AliasedExpr{Expr: yyDollar[5].expr}}}
+		}
+	case 517:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2692
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 518:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2696
+		{
+			yyVAL.expr = yyDollar[1].colName
+		}
+	case 519:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2557
+//line sql.y:2706
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
 		}
-	case 493:
+	case 520:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2561
+//line sql.y:2710
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 494:
+	case 521:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2565
+//line sql.y:2714
 		{
 			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
 		}
-	case 495:
+	case 522:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:2718
+		{
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs, Filter: yyDollar[8].expr}
+		}
+	case 523:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:2722
+		{
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs, Filter: yyDollar[9].expr}
+		}
+	case 524:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:2726
+		{
+			yyVAL.expr = &WindowExpr{Func: &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}, PartitionBy: yyDollar[7].exprs, OrderBy: yyDollar[8].orderBy, Frame: yyDollar[9].windowFrame}
+		}
+	case 525:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:2730
+		{
+			yyVAL.expr = &WindowExpr{Func: &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}, PartitionBy: yyDollar[8].exprs, OrderBy: yyDollar[9].orderBy, Frame: yyDollar[10].windowFrame}
+		}
+	case 526:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2735
+		{
+			yyVAL.exprs = nil
+		}
+	case 527:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2739
+		{
+			yyVAL.exprs = yyDollar[3].exprs
+		}
+	case 528:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2744
+		{
+			yyVAL.windowFrame = nil
+		}
+	case 529:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2748
+		{
+			unit := NewColIdent(string(yyDollar[1].bytes)).Lowered()
+			if unit != RowsStr && unit != RangeStr {
+				yylex.Error("expected ROWS or RANGE window frame")
+				return 1
+			}
+			yyVAL.windowFrame = &WindowFrame{Unit: unit, Start: yyDollar[2].frameBound, End: &FrameBound{Type: CurrentRowStr}}
+		}
+	case 530:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2757
+		{
+			unit := NewColIdent(string(yyDollar[1].bytes)).Lowered()
+			if unit != RowsStr && unit != RangeStr {
+				yylex.Error("expected ROWS or RANGE window frame")
+				return 1
+			}
+			yyVAL.windowFrame = &WindowFrame{Unit: unit, Start: yyDollar[3].frameBound, End: yyDollar[5].frameBound}
+		}
+	case 531:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2768
+		{
+			yyVAL.frameBound = &FrameBound{Type: UnboundedPrecedingStr}
+		}
+	case 532:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2772
+		{
+			yyVAL.frameBound = &FrameBound{Type: PrecedingStr, Offset: NewIntVal(yyDollar[1].bytes)}
+		}
+	case 533:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2776
+		{
+			yyVAL.frameBound = &FrameBound{Type: CurrentRowStr}
+		}
+	case 534:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2780
+		{
+			yyVAL.frameBound = &FrameBound{Type: FollowingStr, Offset: NewIntVal(yyDollar[1].bytes)}
+		}
+	case 535:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2784
+		{
+			yyVAL.frameBound = &FrameBound{Type: UnboundedFollowingStr}
+		}
+	case 536:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2575
+//line sql.y:2794
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 496:
+	case 537:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2579
+//line sql.y:2798
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 497:
+	case 538:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2583
+//line sql.y:2802
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 498:
+	case 539:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2587
+//line sql.y:2806
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 499:
+	case 540:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2591
+//line sql.y:2810
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
-	case 500:
+	case 541:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2595
+//line sql.y:2814
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 501:
+	case 542:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2599
+//line sql.y:2818
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 502:
+	case 543:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2603
+//line sql.y:2822
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 503:
+	case 544:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2607
+//line sql.y:2826
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 504:
+	case 545:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2611
+//line sql.y:2830
 		{
 			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
 		}
-	case 505:
+	case 546:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2615
+//line sql.y:2834
 		{
 			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str}
 		}
-	case 506:
+	case 547:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2619
+//line sql.y:2838
 		{
 			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
 		}
-	case 507:
+	case 548:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2623
+//line sql.y:2842
 		{
 			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
 		}
-	case 508:
+	case 549:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2846
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("date_part"), Exprs: SelectExprs{&AliasedExpr{Expr: NewStrVal([]byte(yyDollar[3].colIdent.Lowered()))}, &AliasedExpr{Expr: yyDollar[5].expr}}}
+		}
+	case 550:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2850
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("position"), Exprs: SelectExprs{&AliasedExpr{Expr: yyDollar[3].expr}, &AliasedExpr{Expr: yyDollar[5].expr}}}
+		}
+	case 551:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2633
+//line sql.y:2860
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
-	case 509:
+	case 552:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2637
+//line sql.y:2864
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
-	case 510:
+	case 553:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2641
+//line sql.y:2868
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
-	case 511:
+	case 554:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2646
+//line sql.y:2873
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
-	case 512:
+	case 555:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2651
+//line sql.y:2878
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
 		}
-	case 513:
+	case 556:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2656
+//line sql.y:2883
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
-	case 514:
+	case 557:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2662
+//line sql.y:2889
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
 		}
-	case 515:
+	case 558:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2667
+//line sql.y:2894
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
 		}
-	case 516:
+	case 559:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2672
+//line sql.y:2899
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 517:
+	case 560:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2676
+//line sql.y:2903
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 518:
+	case 561:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2680
+//line sql.y:2907
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].expr}
 		}
-	case 519:
+	case 562:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2685
+//line sql.y:2912
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].expr}
 		}
-	case 520:
+	case 563:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2690
+//line sql.y:2917
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 521:
+	case 564:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2695
+//line sql.y:2922
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].expr}
 		}
-	case 522:
+	case 565:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2699
+//line sql.y:2926
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 523:
+	case 566:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2703
+//line sql.y:2930
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 526:
+	case 569:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2713
+//line sql.y:2940
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 527:
+	case 570:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2723
+//line sql.y:2950
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 528:
+	case 571:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2727
+//line sql.y:2954
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 529:
+	case 572:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2731
+//line sql.y:2958
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 530:
+	case 573:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2735
+//line sql.y:2962
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 531:
+	case 574:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2739
+//line sql.y:2966
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 532:
+	case 575:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2743
+//line sql.y:2970
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 533:
+	case 576:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2749
+//line sql.y:2976
 		{
 			yyVAL.str = ""
 		}
-	case 534:
+	case 577:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2753
+//line sql.y:2980
 		{
 			yyVAL.str = BooleanModeStr
 		}
-	case 535:
+	case 578:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2757
+//line sql.y:2984
 		{
 			yyVAL.str = NaturalLanguageModeStr
 		}
-	case 536:
+	case 579:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2761
+//line sql.y:2988
 		{
 			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
 		}
-	case 537:
+	case 580:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2765
+//line sql.y:2992
 		{
 			yyVAL.str = QueryExpansionStr
 		}
-	case 538:
+	case 581:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2771
+//line sql.y:2998
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 539:
+	case 582:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2775
+//line sql.y:3002
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 540:
+	case 583:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2781
+//line sql.y:3008
 		{
 			yyVAL.convertType = &ConvertTypeSimple{Name: string(yyDollar[1].bytes)}
 		}
-	case 541:
+	case 584:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2785
+//line sql.y:3012
 		{
 			yyVAL.convertType = &ConvertTypeSimple{Name: string(yyDollar[1].bytes)}
 		}
-	case 542:
+	case 585:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2789
+//line sql.y:3016
 		{
 			yyVAL.convertType = &ConvertTypeList{}
 		}
-	case 543:
+	case 586:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2793
+//line sql.y:3020
 		{
 			yyVAL.convertType = &ConvertTypeObject{}
 		}
-	case 544:
+	case 587:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2798
+//line sql.y:3025
 		{
 			yyVAL.expr = nil
 		}
-	case 545:
+	case 588:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2802
+//line sql.y:3029
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 546:
+	case 589:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2807
+//line sql.y:3034
 		{
 			yyVAL.str = string("")
 		}
-	case 547:
+	case 590:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2811
+//line sql.y:3038
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
-	case 548:
+	case 591:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2817
+//line sql.y:3044
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 549:
+	case 592:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2821
+//line sql.y:3048
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 550:
+	case 593:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2827
+//line sql.y:3054
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 551:
+	case 594:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2832
+//line sql.y:3059
 		{
 			yyVAL.expr = nil
 		}
-	case 552:
+	case 595:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2836
+//line sql.y:3063
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 553:
+	case 596:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2842
+//line sql.y:3069
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 554:
+	case 597:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3075
+		{
+			yyVAL.colName = &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 598:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2846
+//line sql.y:3079
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 555:
+	case 599:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2850
+//line sql.y:3083
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 556:
+	case 600:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2856
+//line sql.y:3089
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 557:
+	case 601:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2860
+//line sql.y:3093
 		{
 			yyVAL.expr = NewHexVal(yyDollar[1].bytes)
 		}
-	case 558:
+	case 602:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2864
+//line sql.y:3097
 		{
 			yyVAL.expr = NewBitVal(yyDollar[1].bytes)
 		}
-	case 559:
+	case 603:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2868
+//line sql.y:3101
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 560:
+	case 604:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2872
+//line sql.y:3105
 		{
 			yyVAL.expr = NewFloatVal(yyDollar[1].bytes)
 		}
-	case 561:
+	case 605:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2876
+//line sql.y:3109
 		{
 			yyVAL.expr = NewHexNum(yyDollar[1].bytes)
 		}
-	case 562:
+	case 606:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2880
+//line sql.y:3113
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 563:
+	case 607:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2884
+//line sql.y:3117
 		{
 			yyVAL.expr = &NullVal{}
 		}
-	case 564:
+	case 608:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2890
+//line sql.y:3123
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -6473,287 +7156,360 @@ yydefault:
 			}
 			yyVAL.expr = NewIntVal([]byte("1"))
 		}
-	case 565:
+	case 609:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2899
+//line sql.y:3132
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 566:
+	case 610:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2903
+//line sql.y:3136
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 567:
+	case 611:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2908
+//line sql.y:3141
 		{
 			yyVAL.exprs = nil
 		}
-	case 568:
+	case 612:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2912
+//line sql.y:3145
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 569:
+	case 613:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3151
+		{
+			yyVAL.exprs = Exprs{yyDollar[1].expr}
+		}
+	case 614:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3155
+		{
+			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
+		}
+	case 615:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3161
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 616:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3165
+		{
+			yyVAL.expr = &GroupingSetsExpr{Type: RollupStr, Exprs: yyDollar[3].exprs}
+		}
+	case 617:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3169
+		{
+			yyVAL.expr = &GroupingSetsExpr{Type: CubeStr, Exprs: yyDollar[3].exprs}
+		}
+	case 618:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3173
+		{
+			yyVAL.expr = &GroupingSetsExpr{Type: GroupingSetsStr, Sets: yyDollar[4].exprsList}
+		}
+	case 619:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3179
+		{
+			yyVAL.exprsList = []Exprs{yyDollar[1].exprs}
+		}
+	case 620:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3183
+		{
+			yyVAL.exprsList = append(yyDollar[1].exprsList, yyDollar[3].exprs)
+		}
+	case 621:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3189
+		{
+			yyVAL.exprs = Exprs{}
+		}
+	case 622:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3193
+		{
+			switch expr := yyDollar[1].expr.(type) {
+			case ValTuple:
+				yyVAL.exprs = Exprs(expr)
+			case *ParenExpr:
+				yyVAL.exprs = Exprs{expr.Expr}
+			default:
+				yyVAL.exprs = Exprs{expr}
+			}
+		}
+	case 623:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2917
+//line sql.y:3205
 		{
 			yyVAL.expr = nil
 		}
-	case 570:
+	case 624:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2921
+//line sql.y:3209
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 571:
+	case 625:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2926
+//line sql.y:3214
 		{
 			yyVAL.orderBy = nil
 		}
-	case 572:
+	case 626:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2930
+//line sql.y:3218
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 573:
+	case 627:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2936
+//line sql.y:3224
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 574:
+	case 628:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2940
+//line sql.y:3228
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 575:
+	case 629:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2946
+//line sql.y:3234
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
 		}
-	case 576:
+	case 630:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2951
+//line sql.y:3239
 		{
 			yyVAL.str = AscScr
 		}
-	case 577:
+	case 631:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2955
+//line sql.y:3243
 		{
 			yyVAL.str = AscScr
 		}
-	case 578:
+	case 632:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2959
+//line sql.y:3247
 		{
 			yyVAL.str = DescScr
 		}
-	case 579:
+	case 633:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2964
+//line sql.y:3252
 		{
 			yyVAL.limit = nil
 		}
-	case 580:
+	case 634:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2968
+//line sql.y:3256
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 581:
+	case 635:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2972
+//line sql.y:3260
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 582:
+	case 636:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2976
+//line sql.y:3264
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 583:
+	case 637:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3268
+		{
+			yyVAL.limit = &Limit{Offset: yyDollar[2].expr}
+		}
+	case 638:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2981
+//line sql.y:3273
 		{
 			yyVAL.str = ""
 		}
-	case 584:
+	case 639:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2985
+//line sql.y:3277
 		{
 			yyVAL.str = ForUpdateStr
 		}
-	case 585:
+	case 640:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2989
+//line sql.y:3281
 		{
 			yyVAL.str = ShareModeStr
 		}
-	case 586:
+	case 641:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2994
+//line sql.y:3286
 		{
 			yyVAL.triggers = nil
 		}
-	case 587:
+	case 642:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2998
+//line sql.y:3290
 		{
 			yyVAL.triggers = yyDollar[2].triggers
 		}
-	case 588:
+	case 643:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3004
+//line sql.y:3296
 		{
 			yyVAL.triggers = []Trigger{yyDollar[1].trigger}
 		}
-	case 589:
+	case 644:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3008
+//line sql.y:3300
 		{
 			yyVAL.triggers = append(yyDollar[1].triggers, yyDollar[3].trigger)
 		}
-	case 590:
+	case 645:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3014
+//line sql.y:3306
 		{
 			yyVAL.trigger = &WatermarkTrigger{}
 		}
-	case 591:
+	case 646:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3018
+//line sql.y:3310
 		{
 			yyVAL.trigger = &EndOfStreamTrigger{}
 		}
-	case 592:
+	case 647:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3022
+//line sql.y:3314
 		{
 			yyVAL.trigger = &DelayTrigger{Delay: yyDollar[3].expr}
 		}
-	case 593:
+	case 648:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3026
+//line sql.y:3318
 		{
 			yyVAL.trigger = &CountingTrigger{Count: yyDollar[2].expr}
 		}
-	case 594:
+	case 649:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3039
+//line sql.y:3331
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
-	case 595:
+	case 650:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3043
+//line sql.y:3335
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 596:
+	case 651:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3047
+//line sql.y:3339
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Rows: yyDollar[2].selStmt}
 		}
-	case 597:
+	case 652:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3052
+//line sql.y:3344
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
-	case 598:
+	case 653:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3056
+//line sql.y:3348
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 599:
+	case 654:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3060
+//line sql.y:3352
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].selStmt}
 		}
-	case 600:
+	case 655:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3067
+//line sql.y:3359
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 601:
+	case 656:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3071
+//line sql.y:3363
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 602:
+	case 657:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3075
+//line sql.y:3367
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 603:
+	case 658:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3079
+//line sql.y:3371
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 604:
+	case 659:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3084
+//line sql.y:3376
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 605:
+	case 660:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3088
+//line sql.y:3380
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 606:
+	case 661:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3094
+//line sql.y:3386
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 607:
+	case 662:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3098
+//line sql.y:3390
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 608:
+	case 663:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3104
+//line sql.y:3396
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 609:
+	case 664:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3108
+//line sql.y:3400
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 610:
+	case 665:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3114
+//line sql.y:3406
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 611:
+	case 666:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3120
+//line sql.y:3412
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = &ParenExpr{yyDollar[1].valTuple[0]}
@@ -6761,312 +7517,312 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 612:
+	case 667:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3130
+//line sql.y:3422
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 613:
+	case 668:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3134
+//line sql.y:3426
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 614:
+	case 669:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3140
+//line sql.y:3432
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 615:
+	case 670:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3146
+//line sql.y:3438
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 616:
+	case 671:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3150
+//line sql.y:3442
 		{
 			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
 		}
-	case 617:
+	case 672:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3156
+//line sql.y:3448
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("on"))}
 		}
-	case 618:
+	case 673:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3160
+//line sql.y:3452
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("off"))}
 		}
-	case 619:
+	case 674:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3164
+//line sql.y:3456
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: yyDollar[3].expr}
 		}
-	case 620:
+	case 675:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3168
+//line sql.y:3460
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Expr: yyDollar[2].expr}
 		}
-	case 622:
+	case 677:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3175
+//line sql.y:3467
 		{
 			yyVAL.bytes = []byte("charset")
 		}
-	case 624:
+	case 679:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3182
+//line sql.y:3474
 		{
 			yyVAL.expr = NewStrVal([]byte(yyDollar[1].colIdent.String()))
 		}
-	case 625:
+	case 680:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3186
+//line sql.y:3478
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 626:
+	case 681:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3190
+//line sql.y:3482
 		{
 			yyVAL.expr = &Default{}
 		}
-	case 629:
+	case 684:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3199
+//line sql.y:3491
 		{
 			yyVAL.byt = 0
 		}
-	case 630:
+	case 685:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3201
+//line sql.y:3493
 		{
 			yyVAL.byt = 1
 		}
-	case 631:
+	case 686:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3204
+//line sql.y:3496
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 632:
+	case 687:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3206
+//line sql.y:3498
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 633:
+	case 688:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3209
+//line sql.y:3501
 		{
 			yyVAL.str = ""
 		}
-	case 634:
+	case 689:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3211
+//line sql.y:3503
 		{
 			yyVAL.str = IgnoreStr
 		}
-	case 635:
+	case 690:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3215
+//line sql.y:3507
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 636:
+	case 691:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3217
+//line sql.y:3509
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 637:
+	case 692:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3219
+//line sql.y:3511
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 638:
+	case 693:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3221
+//line sql.y:3513
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 639:
+	case 694:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3223
+//line sql.y:3515
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 640:
+	case 695:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3225
+//line sql.y:3517
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 641:
+	case 696:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3227
+//line sql.y:3519
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 642:
+	case 697:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3229
+//line sql.y:3521
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 643:
+	case 698:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3231
+//line sql.y:3523
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 644:
+	case 699:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3233
+//line sql.y:3525
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 645:
+	case 700:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3236
+//line sql.y:3528
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 646:
+	case 701:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3238
+//line sql.y:3530
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 647:
+	case 702:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3240
+//line sql.y:3532
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 648:
+	case 703:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3244
+//line sql.y:3536
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 649:
+	case 704:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3246
+//line sql.y:3538
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 650:
+	case 705:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3249
+//line sql.y:3541
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 651:
+	case 706:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3251
+//line sql.y:3543
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 652:
+	case 707:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3253
+//line sql.y:3545
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 653:
+	case 708:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3256
+//line sql.y:3548
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 654:
+	case 709:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3258
+//line sql.y:3550
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 655:
+	case 710:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3262
+//line sql.y:3554
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 656:
+	case 711:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3266
+//line sql.y:3558
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 658:
+	case 713:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3273
+//line sql.y:3565
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 659:
+	case 714:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3279
+//line sql.y:3571
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 660:
+	case 715:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3283
+//line sql.y:3575
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 662:
+	case 717:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3290
+//line sql.y:3582
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 878:
+	case 951:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3531
+//line sql.y:3841
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 879:
+	case 952:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3540
+//line sql.y:3850
 		{
 			decNesting(yylex)
 		}
-	case 880:
+	case 953:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3545
+//line sql.y:3855
 		{
 			skipToEnd(yylex)
 		}
-	case 881:
+	case 954:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3550
+//line sql.y:3860
 		{
 			skipToEnd(yylex)
 		}
-	case 882:
+	case 955:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3554
+//line sql.y:3864
 		{
 			skipToEnd(yylex)
 		}
-	case 883:
+	case 956:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3558
+//line sql.y:3868
 		{
 			skipToEnd(yylex)
 		}
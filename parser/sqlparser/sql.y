@@ -86,6 +86,7 @@ func skipToEnd(yylex interface{}) {
   indexHints    *IndexHints
   expr          Expr
   exprs         Exprs
+  exprsList     []Exprs
   boolVal       BoolVal
   sqlVal        *SQLVal
   colTuple      ColTuple
@@ -95,6 +96,8 @@ func skipToEnd(yylex interface{}) {
   whens         []*When
   when          *When
   orderBy       OrderBy
+  windowFrame   *WindowFrame
+  frameBound    *FrameBound
   order         *Order
   limit         *Limit
   triggers 			[]Trigger
@@ -132,16 +135,34 @@ func skipToEnd(yylex interface{}) {
 }
 
 %token LEX_ERROR
-%left <bytes> UNION
-%token <bytes> SELECT STREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR WATERMARK DELAY COUNTING AFTER
+%left <bytes> UNION EXCEPT
+%left <bytes> INTERSECT
+%token <bytes> SELECT STREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT FOR WATERMARK DELAY COUNTING AFTER
 %token <bytes> ALL DISTINCT AS EXISTS ASC DESC INTO DUPLICATE KEY DEFAULT SET LOCK UNLOCK KEYS
 %token <bytes> VALUES LAST_INSERT_ID
 %token <bytes> NEXT VALUE SHARE MODE
 %token <bytes> SQL_NO_CACHE SQL_CACHE
 %left <bytes> JOIN STRAIGHT_JOIN LOOKUP LEFT RIGHT INNER OUTER CROSS NATURAL USE FORCE
 %left <bytes> ON USING
+// A DATE, TIME or TIMESTAMP keyword followed by a string is a typed literal,
+// not a column with a string alias. The %prec override on these keywords
+// makes the parser shift the string.
+%nonassoc <bytes> TYPED_LITERAL_KEYWORD
+%nonassoc <bytes> STRING
+// Similarly, an EXTRACT, POSITION, CUBE or ROLLUP keyword followed by an opening parenthesis
+// is the special syntax using that keyword, not a column.
+%nonassoc <bytes> FUNCTION_KEYWORD
+%nonassoc '('
+// OFFSET is a non-reserved keyword, so it could also be an alias given without AS.
+// The %prec override on missing aliases makes the parser start an OFFSET clause instead.
+%nonassoc <bytes> OFFSET
+%nonassoc <bytes> NO_ALIAS
+// FILTER is a non-reserved keyword as well. Following a function call, it starts
+// the FILTER clause of the call, instead of being an alias.
+%nonassoc <bytes> NO_FILTER
+%nonassoc <bytes> FILTER
 %token <empty> '(' ',' ')'
-%token <bytes> ID HEX STRING INTEGRAL FLOAT HEXNUM VALUE_ARG LIST_ARG COMMENT COMMENT_KEYWORD BIT_LITERAL LIST_TYPE OBJECT_TYPE
+%token <bytes> ID HEX INTEGRAL FLOAT HEXNUM VALUE_ARG LIST_ARG COMMENT COMMENT_KEYWORD BIT_LITERAL LIST_TYPE OBJECT_TYPE
 %token <bytes> NULL TRUE FALSE OFF
 
 // Precedence dictated by mysql. But the vitess grammar is simplified.
@@ -153,6 +174,7 @@ func skipToEnd(yylex interface{}) {
 %right <bytes> NOT '!'
 %left <bytes> BETWEEN CASE WHEN THEN ELSE END OF
 %left <bytes> '=' '<' '>' LE GE NE NULL_SAFE_EQUAL IS LIKE REGEXP IN RIGHTARROW
+%left <bytes> CONCAT_OP
 %left <bytes> '|'
 %left <bytes> '&'
 %left <bytes> SHIFT_LEFT SHIFT_RIGHT
@@ -161,6 +183,7 @@ func skipToEnd(yylex interface{}) {
 %left <bytes> '^'
 %right <bytes> '~' NOT_LIKE_REGEXP LIKE_REGEXP_CASE_INSENSITIVE NOT_LIKE_REGEXP_CASE_INSENSITIVE UNARY
 %left <bytes> COLLATE
+%left <bytes> AT
 %right <bytes> BINARY UNDERSCORE_BINARY UNDERSCORE_UTF8MB4
 %right <bytes> INTERVAL
 %nonassoc <bytes> '.'
@@ -176,6 +199,10 @@ func skipToEnd(yylex interface{}) {
 %token <bytes> ACTION CASCADE CONSTRAINT FOREIGN NO REFERENCES RESTRICT
 %token <bytes> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE
 %token <bytes> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
+%token <bytes> OVER UNBOUNDED PRECEDING FOLLOWING CURRENT ROW
+%token <bytes> GROUPING SETS ROLLUP CUBE
+%token <bytes> RECURSIVE
+%token <bytes> EXTRACT ZONE POSITION
 %token <bytes> VINDEX VINDEXES
 %token <bytes> STATUS VARIABLES WARNINGS
 
@@ -217,7 +244,7 @@ func skipToEnd(yylex interface{}) {
 %token <bytes> UNUSED
 
 %type <statement> command
-%type <selStmt> select_statement base_select union_lhs union_rhs
+%type <selStmt> select_statement base_select union_lhs intersect_lhs union_rhs
 %type <bytes> comma_opt
 %type <commonTableExpression> cte
 %type <commonTableExpressions> cte_list
@@ -227,7 +254,7 @@ func skipToEnd(yylex interface{}) {
 %type <statement> analyze_statement show_statement use_statement other_statement
 %type <statement> begin_statement commit_statement rollback_statement
 %type <bytes2> comment_opt comment_list
-%type <str> union_op insert_or_replace
+%type <str> union_op intersect_op insert_or_replace
 %type <str> distinct_opt straight_join_opt cache_opt match_option separator_opt
 %type <expr> like_escape_opt
 %type <selectExprs> select_expression_list select_expression_list_opt
@@ -249,7 +276,7 @@ func skipToEnd(yylex interface{}) {
 %type <boolVal> boolean_value
 %type <str> compare
 %type <ins> insert_data
-%type <expr> value value_expression num_val
+%type <expr> value value_expression num_val time_zone
 %type <expr> function_call_keyword function_call_nonkeyword function_call_generic function_call_conflict func_datetime_precision
 %type <str> is_suffix
 %type <colTuple> col_tuple
@@ -262,9 +289,14 @@ func skipToEnd(yylex interface{}) {
 %type <whens> when_expression_list
 %type <when> when_expression
 %type <expr> expression_opt else_expression_opt
-%type <exprs> group_by_opt
+%type <exprs> group_by_opt group_by_list grouping_set
+%type <expr> group_by_element
+%type <exprsList> grouping_set_list
 %type <expr> having_opt
 %type <orderBy> order_by_opt order_list
+%type <exprs> partition_by_opt
+%type <windowFrame> window_frame_opt
+%type <frameBound> frame_bound
 %type <order> order
 %type <str> asc_desc_opt
 %type <limit> limit_opt
@@ -386,7 +418,15 @@ select_statement:
 	{
 		$$ = &With{CommonTableExpressions: $2, Select: $4}
 	}
-| union_lhs union_op union_rhs order_by_opt limit_opt lock_opt
+| WITH RECURSIVE cte_list comma_opt select_statement
+	{
+		$$ = &With{Recursive: true, CommonTableExpressions: $3, Select: $5}
+	}
+| union_lhs union_op intersect_lhs order_by_opt limit_opt lock_opt
+  {
+    $$ = &Union{Type: $2, Left: $1, Right: $3, OrderBy: $4, Limit: $5, Lock: $6}
+  }
+| intersect_lhs intersect_op union_rhs order_by_opt limit_opt lock_opt
   {
     $$ = &Union{Type: $2, Left: $1, Right: $3, OrderBy: $4, Limit: $5, Lock: $6}
   }
@@ -420,6 +460,10 @@ cte:
 	{
 		$$ = &CommonTableExpression{Name: $1, Select: $4}
 	}
+|	table_alias openb column_list closeb AS openb select_statement closeb
+	{
+		$$ = &CommonTableExpression{Name: $1, Columns: $3, Select: $7}
+	}
 
 
 stream_statement:
@@ -435,10 +479,25 @@ base_select:
     $$ = &Select{Comments: Comments($2), Cache: $3, Distinct: $4, Hints: $5, SelectExprs: $6, From: $7, Where: NewWhere(WhereStr, $8), GroupBy: GroupBy($9), Having: NewWhere(HavingStr, $10), Trigger: $11}
   }
 
+// INTERSECT binds more tightly than UNION and EXCEPT, so the operands of those are intersections.
 union_lhs:
-	openb select_statement closeb
+  intersect_lhs
   {
-    $$ = &ParenSelect{Select: $2}
+    $$ = $1
+  }
+| union_lhs union_op intersect_lhs
+  {
+    $$ = &Union{Type: $2, Left: $1, Right: $3}
+  }
+
+intersect_lhs:
+  union_rhs
+  {
+    $$ = $1
+  }
+| intersect_lhs intersect_op union_rhs
+  {
+    $$ = &Union{Type: $2, Left: $1, Right: $3}
   }
 
 union_rhs:
@@ -1821,6 +1880,32 @@ union_op:
   {
     $$ = UnionDistinctStr
   }
+| EXCEPT
+  {
+    $$ = ExceptStr
+  }
+| EXCEPT ALL
+  {
+    $$ = ExceptAllStr
+  }
+| EXCEPT DISTINCT
+  {
+    $$ = ExceptDistinctStr
+  }
+
+intersect_op:
+  INTERSECT
+  {
+    $$ = IntersectStr
+  }
+| INTERSECT ALL
+  {
+    $$ = IntersectAllStr
+  }
+| INTERSECT DISTINCT
+  {
+    $$ = IntersectDistinctStr
+  }
 
 cache_opt:
 {
@@ -1891,6 +1976,7 @@ select_expression:
   }
 
 as_ci_opt:
+  %prec NO_ALIAS
   {
     $$ = ColIdent{}
   }
@@ -1902,6 +1988,10 @@ as_ci_opt:
   {
     $$ = $2
   }
+| AS AT
+  {
+    $$ = NewColIdent(string($2))
+  }
 
 col_alias:
   sql_id
@@ -1938,16 +2028,28 @@ table_factor:
   {
     $$ = $1
   }
-| subquery as_opt table_id
+| subquery table_id
+  {
+    $$ = &AliasedTableExpr{Expr:$1, As: $2}
+  }
+| subquery AS table_id
   {
     $$ = &AliasedTableExpr{Expr:$1, As: $3}
   }
-| subquery
+| subquery %prec NO_ALIAS
   {
     // missed alias for subquery
     yylex.Error("Every derived table must have its own alias")
     return 1
   }
+| openb VALUES tuple_list closeb as_opt table_id
+  {
+    $$ = &AliasedTableExpr{Expr: &ValuesTable{Rows: $3}, As: $6}
+  }
+| openb VALUES tuple_list closeb as_opt table_id openb column_list closeb
+  {
+    $$ = &AliasedTableExpr{Expr: &ValuesTable{Rows: $3}, As: $6, Columns: $8}
+  }
 | openb table_references closeb
   {
     $$ = &ParenTableExpr{Exprs: $2}
@@ -2042,9 +2144,9 @@ join_table:
   {
     $$ = &JoinTableExpr{LeftExpr: $1, Join: $2, RightExpr: $3, Condition: $4}
   }
-| table_reference outer_join table_reference join_condition
+| table_reference strategy_opt outer_join table_reference join_condition
   {
-    $$ = &JoinTableExpr{LeftExpr: $1, Join: $2, RightExpr: $3, Condition: $4}
+    $$ = &JoinTableExpr{LeftExpr: $1, Strategy: $2, Join: $3, RightExpr: $4, Condition: $5}
   }
 | table_reference natural_join table_factor
   {
@@ -2075,6 +2177,7 @@ as_opt:
   { $$ = struct{}{} }
 
 as_opt_id:
+  %prec NO_ALIAS
   {
     $$ = NewTableIdent("")
   }
@@ -2138,6 +2241,14 @@ outer_join:
   {
     $$ = RightJoinStr
   }
+| FULL JOIN
+  {
+    $$ = FullJoinStr
+  }
+| FULL OUTER JOIN
+  {
+    $$ = FullJoinStr
+  }
 
 natural_join:
  NATURAL JOIN
@@ -2226,6 +2337,14 @@ expression:
   {
     $$ = &IsExpr{Operator: $3, Expr: $1}
   }
+| expression IS DISTINCT FROM value_expression
+  {
+    $$ = &ComparisonExpr{Left: $1, Operator: IsDistinctFromStr, Right: $5}
+  }
+| expression IS NOT DISTINCT FROM value_expression
+  {
+    $$ = &ComparisonExpr{Left: $1, Operator: IsNotDistinctFromStr, Right: $6}
+  }
 | value_expression
   {
     $$ = $1
@@ -2437,6 +2556,10 @@ value_expression:
   {
     $$ = &BinaryExpr{Left: $1, Operator: BitOrStr, Right: $3}
   }
+| value_expression CONCAT_OP value_expression
+  {
+    $$ = &BinaryExpr{Left: $1, Operator: ConcatStr, Right: $3}
+  }
 | value_expression '^' value_expression
   {
     $$ = &BinaryExpr{Left: $1, Operator: BitXorStr, Right: $3}
@@ -2531,6 +2654,18 @@ value_expression:
     // will be non-trivial because of grammar conflicts.
     $$ = &IntervalExpr{Expr: $2, Unit: $3.String()}
   }
+| DATE STRING
+  {
+    $$ = &TypedLiteral{Type: DateStr, Val: $2}
+  }
+| TIME STRING
+  {
+    $$ = &TypedLiteral{Type: TimeStr, Val: $2}
+  }
+| TIMESTAMP STRING
+  {
+    $$ = &TypedLiteral{Type: TimestampStr, Val: $2}
+  }
 | function_call_generic
 | function_call_keyword
 | function_call_nonkeyword
@@ -2547,17 +2682,31 @@ value_expression:
   {
     $$ = &ObjectFieldAccess{Object: $1, Field: $3}
   }
+| value_expression AT TIME ZONE time_zone
+  {
+    $$ = &FuncExpr{Name: NewColIdent("at_time_zone"), Exprs: SelectExprs{&AliasedExpr{Expr: $1}, &AliasedExpr{Expr: $5}}}
+  }
+
+time_zone:
+  value
+  {
+    $$ = $1
+  }
+| column_name
+  {
+    $$ = $1
+  }
 
 /*
   Regular function calls without special token or syntax, guaranteed to not
   introduce side effects due to being a simple identifier
 */
 function_call_generic:
-  sql_id openb select_expression_list_opt closeb
+  sql_id openb select_expression_list_opt closeb %prec NO_FILTER
   {
     $$ = &FuncExpr{Name: $1, Exprs: $3}
   }
-| sql_id openb DISTINCT select_expression_list closeb
+| sql_id openb DISTINCT select_expression_list closeb %prec NO_FILTER
   {
     $$ = &FuncExpr{Name: $1, Distinct: true, Exprs: $4}
   }
@@ -2565,6 +2714,76 @@ function_call_generic:
   {
     $$ = &FuncExpr{Qualifier: $1, Name: $3, Exprs: $5}
   }
+| sql_id openb select_expression_list_opt closeb FILTER openb WHERE expression closeb
+  {
+    $$ = &FuncExpr{Name: $1, Exprs: $3, Filter: $8}
+  }
+| sql_id openb DISTINCT select_expression_list closeb FILTER openb WHERE expression closeb
+  {
+    $$ = &FuncExpr{Name: $1, Distinct: true, Exprs: $4, Filter: $9}
+  }
+| sql_id openb select_expression_list_opt closeb OVER openb partition_by_opt order_by_opt window_frame_opt closeb
+  {
+    $$ = &WindowExpr{Func: &FuncExpr{Name: $1, Exprs: $3}, PartitionBy: $7, OrderBy: $8, Frame: $9}
+  }
+| sql_id openb DISTINCT select_expression_list closeb OVER openb partition_by_opt order_by_opt window_frame_opt closeb
+  {
+    $$ = &WindowExpr{Func: &FuncExpr{Name: $1, Distinct: true, Exprs: $4}, PartitionBy: $8, OrderBy: $9, Frame: $10}
+  }
+
+partition_by_opt:
+  {
+    $$ = nil
+  }
+| PARTITION BY expression_list
+  {
+    $$ = $3
+  }
+
+window_frame_opt:
+  {
+    $$ = nil
+  }
+| ID frame_bound
+  {
+    unit := NewColIdent(string($1)).Lowered()
+    if unit != RowsStr && unit != RangeStr {
+      yylex.Error("expected ROWS or RANGE window frame")
+      return 1
+    }
+    $$ = &WindowFrame{Unit: unit, Start: $2, End: &FrameBound{Type: CurrentRowStr}}
+  }
+| ID BETWEEN frame_bound AND frame_bound
+  {
+    unit := NewColIdent(string($1)).Lowered()
+    if unit != RowsStr && unit != RangeStr {
+      yylex.Error("expected ROWS or RANGE window frame")
+      return 1
+    }
+    $$ = &WindowFrame{Unit: unit, Start: $3, End: $5}
+  }
+
+frame_bound:
+  UNBOUNDED PRECEDING
+  {
+    $$ = &FrameBound{Type: UnboundedPrecedingStr}
+  }
+| INTEGRAL PRECEDING
+  {
+    $$ = &FrameBound{Type: PrecedingStr, Offset: NewIntVal($1)}
+  }
+| CURRENT ROW
+  {
+    $$ = &FrameBound{Type: CurrentRowStr}
+  }
+| INTEGRAL FOLLOWING
+  {
+    $$ = &FrameBound{Type: FollowingStr, Offset: NewIntVal($1)}
+  }
+| UNBOUNDED FOLLOWING
+  {
+    $$ = &FrameBound{Type: UnboundedFollowingStr}
+  }
 
 /*
   Function calls using reserved keywords, with dedicated grammar rules
@@ -2623,6 +2842,14 @@ function_call_keyword:
   {
     $$ = &ValuesFuncExpr{Name: $3}
   }
+| EXTRACT openb sql_id FROM expression closeb
+  {
+    $$ = &FuncExpr{Name: NewColIdent("date_part"), Exprs: SelectExprs{&AliasedExpr{Expr: NewStrVal([]byte($3.Lowered()))}, &AliasedExpr{Expr: $5}}}
+  }
+| POSITION openb value_expression IN value_expression closeb
+  {
+    $$ = &FuncExpr{Name: NewColIdent("position"), Exprs: SelectExprs{&AliasedExpr{Expr: $3}, &AliasedExpr{Expr: $5}}}
+  }
 
 /*
   Function calls using non reserved keywords but with special syntax forms.
@@ -2842,6 +3069,12 @@ column_name:
   {
     $$ = &ColName{Name: $1}
   }
+// AT can't be an alias given without AS, as that would be ambiguous with AT TIME ZONE,
+// so it's reserved, but can still be used as a column name.
+| AT
+  {
+    $$ = &ColName{Name: NewColIdent(string($1))}
+  }
 | table_id '.' reserved_sql_id
   {
     $$ = &ColName{Qualifier: TableName{Name: $1}, Name: $3}
@@ -2908,11 +3141,66 @@ group_by_opt:
   {
     $$ = nil
   }
-| GROUP BY expression_list
+| GROUP BY group_by_list
   {
     $$ = $3
   }
 
+group_by_list:
+  group_by_element
+  {
+    $$ = Exprs{$1}
+  }
+| group_by_list ',' group_by_element
+  {
+    $$ = append($1, $3)
+  }
+
+group_by_element:
+  expression
+  {
+    $$ = $1
+  }
+| ROLLUP openb expression_list closeb
+  {
+    $$ = &GroupingSetsExpr{Type: RollupStr, Exprs: $3}
+  }
+| CUBE openb expression_list closeb
+  {
+    $$ = &GroupingSetsExpr{Type: CubeStr, Exprs: $3}
+  }
+| GROUPING SETS openb grouping_set_list closeb
+  {
+    $$ = &GroupingSetsExpr{Type: GroupingSetsStr, Sets: $4}
+  }
+
+grouping_set_list:
+  grouping_set
+  {
+    $$ = []Exprs{$1}
+  }
+| grouping_set_list ',' grouping_set
+  {
+    $$ = append($1, $3)
+  }
+
+grouping_set:
+  openb closeb
+  {
+    $$ = Exprs{}
+  }
+| expression
+  {
+    switch expr := $1.(type) {
+    case ValTuple:
+      $$ = Exprs(expr)
+    case *ParenExpr:
+      $$ = Exprs{expr.Expr}
+    default:
+      $$ = Exprs{expr}
+    }
+  }
+
 having_opt:
   {
     $$ = nil
@@ -2976,6 +3264,10 @@ limit_opt:
   {
     $$ = &Limit{Offset: $4, Rowcount: $2}
   }
+| OFFSET expression
+  {
+    $$ = &Limit{Offset: $2}
+  }
 
 lock_opt:
   {
@@ -3305,6 +3597,7 @@ reserved_keyword:
 | AND
 | AS
 | ASC
+| AT
 | AUTO_INCREMENT
 | BETWEEN
 | BINARY
@@ -3330,6 +3623,7 @@ reserved_keyword:
 | DROP
 | ELSE
 | ESCAPE
+| EXCEPT
 | EXISTS
 | EXPLAIN
 | FALSE
@@ -3344,6 +3638,7 @@ reserved_keyword:
 | INDEX
 | INNER
 | INSERT
+| INTERSECT
 | INTERVAL
 | INTO
 | IS
@@ -3367,6 +3662,8 @@ reserved_keyword:
 | OR
 | ORDER
 | OUTER
+| OVER
+| RECURSIVE
 | REGEXP
 | RENAME
 | REPLACE
@@ -3425,7 +3722,9 @@ non_reserved_keyword:
 | COMMIT
 | COMMITTED
 | COUNTING
-| DATE
+| CUBE %prec FUNCTION_KEYWORD
+| CURRENT
+| DATE %prec TYPED_LITERAL_KEYWORD
 | DATETIME
 | DECIMAL
 | DELAY
@@ -3435,14 +3734,18 @@ non_reserved_keyword:
 | END
 | ENUM
 | EXPANSION
+| EXTRACT %prec FUNCTION_KEYWORD
 | FLOAT_TYPE
 | FIELDS
+| FILTER
 | FLUSH
+| FOLLOWING
 | FOREIGN
 | FULLTEXT
 | GEOMETRY
 | GEOMETRYCOLLECTION
 | GLOBAL
+| GROUPING
 | INT
 | INTEGER
 | ISOLATION
@@ -3475,6 +3778,8 @@ non_reserved_keyword:
 | PLUGINS
 | POINT
 | POLYGON
+| POSITION %prec FUNCTION_KEYWORD
+| PRECEDING
 | PRIMARY
 | PROCEDURE
 | QUERY
@@ -3486,9 +3791,12 @@ non_reserved_keyword:
 | REPEATABLE
 | RESTRICT
 | ROLLBACK
+| ROLLUP %prec FUNCTION_KEYWORD
+| ROW
 | SCHEMAS
 | SESSION
 | SERIALIZABLE
+| SETS
 | SHARE
 | SIGNED
 | SMALLINT
@@ -3498,13 +3806,14 @@ non_reserved_keyword:
 | TABLES
 | TEXT
 | THAN
-| TIME
-| TIMESTAMP
+| TIME %prec TYPED_LITERAL_KEYWORD
+| TIMESTAMP %prec TYPED_LITERAL_KEYWORD
 | TINYBLOB
 | TINYINT
 | TINYTEXT
 | TRANSACTION
 | TRUNCATE
+| UNBOUNDED
 | UNCOMMITTED
 | UNSIGNED
 | UNUSED
@@ -3525,6 +3834,7 @@ non_reserved_keyword:
 | WRITE
 | YEAR
 | ZEROFILL
+| ZONE
 
 openb:
   '('
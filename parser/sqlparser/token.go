@@ -47,6 +47,7 @@ type Tokenizer struct {
 	lastChar            uint16
 	Position            int
 	lastToken           []byte
+	concatSeen          bool
 	LastError           error
 	posVarIndex         int
 	ParseTree           Statement
@@ -102,6 +103,7 @@ var keywords = map[string]int{
 	"as":                  AS,
 	"asc":                 ASC,
 	"asensitive":          UNUSED,
+	"at":                  AT,
 	"auto_increment":      AUTO_INCREMENT,
 	"before":              UNUSED,
 	"begin":               BEGIN,
@@ -137,6 +139,8 @@ var keywords = map[string]int{
 	"continue":            UNUSED,
 	"convert":             CONVERT,
 	"counting":            COUNTING,
+	"cube":                CUBE,
+	"current":             CURRENT,
 	"substr":              SUBSTR,
 	"substring":           SUBSTRING,
 	"create":              CREATE,
@@ -180,17 +184,21 @@ var keywords = map[string]int{
 	"enum":                ENUM,
 	"escape":              ESCAPE,
 	"escaped":             UNUSED,
+	"except":              EXCEPT,
 	"exists":              EXISTS,
 	"exit":                UNUSED,
 	"explain":             EXPLAIN,
 	"expansion":           EXPANSION,
+	"extract":             EXTRACT,
 	"false":               FALSE,
 	"fetch":               UNUSED,
 	"fields":              FIELDS,
+	"filter":              FILTER,
 	"float":               FLOAT_TYPE,
 	"float4":              UNUSED,
 	"float8":              UNUSED,
 	"flush":               FLUSH,
+	"following":           FOLLOWING,
 	"for":                 FOR,
 	"force":               FORCE,
 	"foreign":             FOREIGN,
@@ -204,6 +212,7 @@ var keywords = map[string]int{
 	"global":              GLOBAL,
 	"grant":               UNUSED,
 	"group":               GROUP,
+	"grouping":            GROUPING,
 	"group_concat":        GROUP_CONCAT,
 	"having":              HAVING,
 	"high_priority":       UNUSED,
@@ -226,6 +235,7 @@ var keywords = map[string]int{
 	"int4":                UNUSED,
 	"int8":                UNUSED,
 	"integer":             INTEGER,
+	"intersect":           INTERSECT,
 	"interval":            INTERVAL,
 	"into":                INTO,
 	"io_after_gtids":      UNUSED,
@@ -298,10 +308,13 @@ var keywords = map[string]int{
 	"out":                 UNUSED,
 	"outer":               OUTER,
 	"outfile":             UNUSED,
+	"over":                OVER,
 	"partition":           PARTITION,
 	"plugins":             PLUGINS,
 	"point":               POINT,
 	"polygon":             POLYGON,
+	"position":            POSITION,
+	"preceding":           PRECEDING,
 	"precision":           UNUSED,
 	"primary":             PRIMARY,
 	"processlist":         PROCESSLIST,
@@ -311,6 +324,7 @@ var keywords = map[string]int{
 	"reads":               UNUSED,
 	"read_write":          UNUSED,
 	"real":                REAL,
+	"recursive":           RECURSIVE,
 	"references":          REFERENCES,
 	"regexp":              REGEXP,
 	"release":             UNUSED,
@@ -328,6 +342,8 @@ var keywords = map[string]int{
 	"right":               RIGHT,
 	"rlike":               REGEXP,
 	"rollback":            ROLLBACK,
+	"rollup":              ROLLUP,
+	"row":                 ROW,
 	"schema":              SCHEMA,
 	"schemas":             SCHEMAS,
 	"second_microsecond":  UNUSED,
@@ -337,6 +353,7 @@ var keywords = map[string]int{
 	"serializable":        SERIALIZABLE,
 	"session":             SESSION,
 	"set":                 SET,
+	"sets":                SETS,
 	"share":               SHARE,
 	"show":                SHOW,
 	"signal":              UNUSED,
@@ -379,6 +396,7 @@ var keywords = map[string]int{
 	"trigger":             TRIGGER,
 	"true":                TRUE,
 	"truncate":            TRUNCATE,
+	"unbounded":           UNBOUNDED,
 	"uncommitted":         UNCOMMITTED,
 	"undo":                UNUSED,
 	"union":               UNION,
@@ -419,6 +437,7 @@ var keywords = map[string]int{
 	"year":                YEAR,
 	"year_month":          UNUSED,
 	"zerofill":            ZEROFILL,
+	"zone":                ZONE,
 }
 
 // keywordStrings contains the reverse mapping of token to keyword strings
@@ -463,6 +482,9 @@ func (tkn *Tokenizer) Lex(lval *yySymType) int {
 		// Parse function to see how this is handled.
 		tkn.partialDDL = nil
 	}
+	if typ == CONCAT_OP {
+		tkn.concatSeen = true
+	}
 	lval.bytes = val
 	tkn.lastToken = val
 	return typ
@@ -476,6 +498,10 @@ func (tkn *Tokenizer) Error(err string) {
 	} else {
 		fmt.Fprintf(buf, "%s at position %v", err, tkn.Position)
 	}
+	if tkn.concatSeen {
+		// || used to be a logical OR, which binds less tightly than comparisons.
+		buf.WriteString(", note that || concatenates its operands, so use OR or parentheses to combine comparisons with it")
+	}
 	tkn.LastError = errors.New(buf.String())
 
 	// Try and re-sync to the next statement
@@ -575,7 +601,7 @@ func (tkn *Tokenizer) Scan() (int, []byte) {
 		case '|':
 			if tkn.lastChar == '|' {
 				tkn.next()
-				return OR, nil
+				return CONCAT_OP, nil
 			}
 			return int(ch), nil
 		case '?':
@@ -597,6 +623,14 @@ func (tkn *Tokenizer) Scan() (int, []byte) {
 				tkn.next()
 				return LIST_ARG, nil
 			}
+			if 'a' <= tkn.lastChar && tkn.lastChar <= 'z' || 'A' <= tkn.lastChar && tkn.lastChar <= 'Z' || tkn.lastChar == '_' {
+				return tkn.scanParameter(':')
+			}
+			return int(ch), nil
+		case '$':
+			if isDigit(tkn.lastChar) {
+				return tkn.scanParameter('$')
+			}
 			return int(ch), nil
 		case '/':
 			switch tkn.lastChar {
@@ -804,6 +838,17 @@ func (tkn *Tokenizer) scanBindVar() (int, []byte) {
 	return token, buffer.Bytes()
 }
 
+// scanParameter scans a :name or $1 query parameter, the prefix has already been consumed.
+func (tkn *Tokenizer) scanParameter(prefix byte) (int, []byte) {
+	buffer := &bytes2.Buffer{}
+	buffer.WriteByte(prefix)
+	for 'a' <= tkn.lastChar && tkn.lastChar <= 'z' || 'A' <= tkn.lastChar && tkn.lastChar <= 'Z' || tkn.lastChar == '_' || isDigit(tkn.lastChar) {
+		buffer.WriteByte(byte(tkn.lastChar))
+		tkn.next()
+	}
+	return VALUE_ARG, buffer.Bytes()
+}
+
 func (tkn *Tokenizer) scanMantissa(base int, buffer *bytes2.Buffer) {
 	for digitVal(tkn.lastChar) < base {
 		tkn.consumeNext(buffer)
@@ -1012,6 +1057,7 @@ func (tkn *Tokenizer) reset() {
 	tkn.partialDDL = nil
 	tkn.specialComment = nil
 	tkn.posVarIndex = 0
+	tkn.concatSeen = false
 	tkn.nesting = 0
 	tkn.SkipToEnd = false
 }
@@ -195,3 +195,39 @@ func TestSplitStatement(t *testing.T) {
 		}
 	}
 }
+
+func TestParameters(t *testing.T) {
+	testcases := []struct {
+		in  string
+		id  int
+		out string
+	}{{
+		in:  ":name",
+		id:  VALUE_ARG,
+		out: ":name",
+	}, {
+		in:  ":min_price2 ",
+		id:  VALUE_ARG,
+		out: ":min_price2",
+	}, {
+		in:  "$1",
+		id:  VALUE_ARG,
+		out: "$1",
+	}, {
+		in:  "$12)",
+		id:  VALUE_ARG,
+		out: "$12",
+	}, {
+		in:  "::",
+		id:  LIST_ARG,
+		out: "",
+	}}
+
+	for _, tcase := range testcases {
+		tkn := NewStringTokenizer(tcase.in)
+		id, out := tkn.Scan()
+		if tcase.id != id || string(out) != tcase.out {
+			t.Errorf("Scan(%s): %d, %s, want %d, %s", tcase.in, id, out, tcase.id, tcase.out)
+		}
+	}
+}
@@ -42,6 +42,9 @@ func ExplainNode(node Node, withTypeInfo bool) *graph.Node {
 
 		for i := range node.GroupBy.Aggregates {
 			out.AddChild(node.GroupBy.Aggregates[i].Name, ExplainExpr(node.GroupBy.AggregateExpressions[i], withTypeInfo))
+			if node.GroupBy.AggregateFilters[i] != nil {
+				out.AddChild(fmt.Sprintf("%s_filter", node.GroupBy.Aggregates[i].Name), ExplainExpr(*node.GroupBy.AggregateFilters[i], withTypeInfo))
+			}
 		}
 
 		out.AddChild("key", ExplainExpr(Expression{
@@ -54,6 +57,12 @@ func ExplainNode(node Node, withTypeInfo bool) *graph.Node {
 
 	case NodeTypeStreamJoin:
 		out = graph.NewNode("join")
+		if node.StreamJoin.JoinType != StreamJoinTypeInner {
+			out.AddField("type", node.StreamJoin.JoinType.String())
+		}
+		for i := range node.StreamJoin.Predicates {
+			out.AddChild(fmt.Sprintf("predicate_%d", i), ExplainExpr(node.StreamJoin.Predicates[i], withTypeInfo))
+		}
 		out.AddChild("right", ExplainNode(node.StreamJoin.Right, withTypeInfo))
 		out.AddChild("left", ExplainNode(node.StreamJoin.Left, withTypeInfo))
 		out.AddChild("right_key", ExplainExpr(Expression{
@@ -71,6 +80,9 @@ func ExplainNode(node Node, withTypeInfo bool) *graph.Node {
 
 	case NodeTypeLookupJoin:
 		out = graph.NewNode("lookup join")
+		if node.LookupJoin.Outer {
+			out.AddField("type", "left_outer")
+		}
 		out.AddChild("source", ExplainNode(node.LookupJoin.Source, withTypeInfo))
 		out.AddChild("joined", ExplainNode(node.LookupJoin.Joined, withTypeInfo))
 
@@ -94,6 +106,9 @@ func ExplainNode(node Node, withTypeInfo bool) *graph.Node {
 			}
 		}
 
+		if node.OrderBy.Limit != nil {
+			out.AddChild("limit", ExplainExpr(*node.OrderBy.Limit, withTypeInfo))
+		}
 		out.AddChild("source", ExplainNode(node.OrderBy.Source, withTypeInfo))
 
 	case NodeTypeRequalifier:
@@ -128,9 +143,86 @@ func ExplainNode(node Node, withTypeInfo bool) *graph.Node {
 
 	case NodeTypeLimit:
 		out = graph.NewNode("limit")
-		out.AddChild("limit", ExplainExpr(node.Limit.Limit, withTypeInfo))
+		if node.Limit.Limit != nil {
+			out.AddChild("limit", ExplainExpr(*node.Limit.Limit, withTypeInfo))
+		}
+		if node.Limit.Offset != nil {
+			out.AddChild("offset", ExplainExpr(*node.Limit.Offset, withTypeInfo))
+		}
 		out.AddChild("source", ExplainNode(node.Limit.Source, withTypeInfo))
 
+	case NodeTypeUnionAll:
+		out = graph.NewNode("union all")
+		out.AddChild("first", ExplainNode(node.UnionAll.First, withTypeInfo))
+		out.AddChild("second", ExplainNode(node.UnionAll.Second, withTypeInfo))
+
+	case NodeTypeValues:
+		out = graph.NewNode("values")
+		for i := range node.Values.Rows {
+			row := graph.NewNode("row")
+			for j := range node.Values.Rows[i] {
+				row.AddChild(node.Schema.Fields[j].Name, ExplainExpr(node.Values.Rows[i][j], withTypeInfo))
+			}
+			out.AddChild(fmt.Sprintf("row_%d", i), row)
+		}
+
+	case NodeTypeSetOperation:
+		name := "intersect"
+		if node.SetOperation.Except {
+			name = "except"
+		}
+		if node.SetOperation.All {
+			name += " all"
+		}
+		out = graph.NewNode(name)
+		out.AddChild("first", ExplainNode(node.SetOperation.First, withTypeInfo))
+		out.AddChild("second", ExplainNode(node.SetOperation.Second, withTypeInfo))
+
+	case NodeTypeWindow:
+		out = graph.NewNode("window")
+		out.AddField("function", node.Window.Function)
+		out.AddField("frame", node.Window.Frame.String())
+		for i := range node.Window.Arguments {
+			out.AddChild(fmt.Sprintf("argument_%d", i), ExplainExpr(node.Window.Arguments[i], withTypeInfo))
+		}
+		if len(node.Window.PartitionBy) > 0 {
+			out.AddChild("partition_by", ExplainExpr(Expression{
+				ExpressionType: ExpressionTypeTuple,
+				Tuple: &Tuple{
+					Arguments: node.Window.PartitionBy,
+				},
+			}, withTypeInfo))
+		}
+		for i := range node.Window.OrderBy {
+			direction := "asc"
+			if node.Window.DirectionMultipliers[i] == -1 {
+				direction = "desc"
+			}
+			out.AddChild(fmt.Sprintf("order_by_%d_%s", i, direction), ExplainExpr(node.Window.OrderBy[i], withTypeInfo))
+		}
+		out.AddChild("source", ExplainNode(node.Window.Source, withTypeInfo))
+
+	case NodeTypeGroupingSets:
+		out = graph.NewNode("grouping sets")
+		for i := range node.GroupingSets.Key {
+			out.AddChild(fmt.Sprintf("key_%d", i), ExplainExpr(node.GroupingSets.Key[i], withTypeInfo))
+		}
+		for i := range node.GroupingSets.Sets {
+			out.AddField(fmt.Sprintf("set_%d", i), fmt.Sprint(node.GroupingSets.Sets[i]))
+		}
+		out.AddChild("source", ExplainNode(node.GroupingSets.Source, withTypeInfo))
+
+	case NodeTypeRecursiveCTE:
+		out = graph.NewNode("recursive cte")
+		out.AddField("name", node.RecursiveCTE.Name)
+		out.AddField("distinct", fmt.Sprint(node.RecursiveCTE.Distinct))
+		out.AddChild("anchor", ExplainNode(node.RecursiveCTE.Anchor, withTypeInfo))
+		out.AddChild("recursive", ExplainNode(node.RecursiveCTE.Recursive, withTypeInfo))
+
+	case NodeTypeRecursiveCTEReference:
+		out = graph.NewNode("recursive cte reference")
+		out.AddField("name", node.RecursiveCTEReference.Name)
+
 	default:
 		panic("unexhaustive node type match")
 	}
@@ -212,6 +304,14 @@ func ExplainExpr(expr Expression, withTypeInfo bool) *graph.Node {
 		out.AddChild("object", ExplainExpr(expr.ObjectFieldAccess.Object, withTypeInfo))
 		out.AddField("field", expr.ObjectFieldAccess.Field)
 
+	case ExpressionTypeCase:
+		out = graph.NewNode("case")
+		for i := range expr.Case.Conditions {
+			out.AddChild(fmt.Sprintf("when_%d", i), ExplainExpr(expr.Case.Conditions[i], withTypeInfo))
+			out.AddChild(fmt.Sprintf("then_%d", i), ExplainExpr(expr.Case.Values[i], withTypeInfo))
+		}
+		out.AddChild("else", ExplainExpr(expr.Case.Else, withTypeInfo))
+
 	default:
 		panic("unexhaustive expression type match")
 	}
@@ -25,6 +25,7 @@ type Expression struct {
 	TypeAssertion     *TypeAssertion
 	Cast              *Cast
 	ObjectFieldAccess *ObjectFieldAccess
+	Case              *Case
 }
 
 type ExpressionType int
@@ -41,6 +42,7 @@ const (
 	ExpressionTypeTypeAssertion
 	ExpressionTypeCast
 	ExpressionTypeObjectFieldAccess
+	ExpressionTypeCase
 )
 
 func (t ExpressionType) String() string {
@@ -67,6 +69,8 @@ func (t ExpressionType) String() string {
 		return "cast"
 	case ExpressionTypeObjectFieldAccess:
 		return "object_field_access"
+	case ExpressionTypeCase:
+		return "case"
 	}
 	return "unknown"
 }
@@ -121,6 +125,12 @@ type ObjectFieldAccess struct {
 	Field  string
 }
 
+type Case struct {
+	Conditions []Expression
+	Values     []Expression
+	Else       Expression
+}
+
 func (expr *Expression) Materialize(ctx context.Context, env Environment) (execution.Expression, error) {
 	switch expr.ExpressionType {
 	case ExpressionTypeVariable:
@@ -263,6 +273,34 @@ func (expr *Expression) Materialize(ctx context.Context, env Environment) (execu
 		}
 
 		return execution.NewObjectFieldAccess(object, fieldIndex), nil
+	case ExpressionTypeCase:
+		conditions := make([]execution.Expression, len(expr.Case.Conditions))
+		for i := range expr.Case.Conditions {
+			expression, err := expr.Case.Conditions[i].Materialize(ctx, env)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize CASE condition with index %d: %w", i, err)
+			}
+			conditions[i] = expression
+		}
+		values := make([]execution.Expression, len(expr.Case.Values))
+		for i := range expr.Case.Values {
+			expression, err := expr.Case.Values[i].Materialize(ctx, env)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize CASE value with index %d: %w", i, err)
+			}
+			values[i] = expression
+		}
+		elseValue, err := expr.Case.Else.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize CASE else value: %w", err)
+		}
+		sourceTypes := make([]octosql.Type, len(expr.Case.Values)+1)
+		for i := range expr.Case.Values {
+			sourceTypes[i] = expr.Case.Values[i].Type
+		}
+		sourceTypes[len(expr.Case.Values)] = expr.Case.Else.Type
+
+		return execution.NewCase(conditions, values, elseValue, execution.NewObjectLayoutFixer(expr.Type, sourceTypes)), nil
 	}
 
 	panic("unexhaustive expression type match")
@@ -290,6 +328,21 @@ func (expr Expression) SplitByAnd() []Expression {
 	return parts
 }
 
+// IsDeterministic returns false if the expression contains a call to a non-deterministic function.
+func (expr Expression) IsDeterministic() bool {
+	deterministic := true
+	t := Transformers{
+		ExpressionTransformer: func(expr Expression) Expression {
+			if expr.ExpressionType == ExpressionTypeFunctionCall && expr.FunctionCall.FunctionDescriptor.NonDeterministic {
+				deterministic = false
+			}
+			return expr
+		},
+	}
+	t.TransformExpr(expr)
+	return deterministic
+}
+
 func (expr Expression) VariablesUsed() []string {
 	acc := make(map[string]struct{})
 	expr.variablesUsed(acc)
@@ -329,6 +382,26 @@ func (expr Expression) variablesUsed(acc map[string]struct{}) {
 	case ExpressionTypeCast:
 		expr.Cast.Expression.variablesUsed(acc)
 		return
+	case ExpressionTypeCoalesce:
+		for _, arg := range expr.Coalesce.Arguments {
+			arg.variablesUsed(acc)
+		}
+		return
+	case ExpressionTypeTuple:
+		for _, arg := range expr.Tuple.Arguments {
+			arg.variablesUsed(acc)
+		}
+		return
+	case ExpressionTypeObjectFieldAccess:
+		expr.ObjectFieldAccess.Object.variablesUsed(acc)
+		return
+	case ExpressionTypeCase:
+		for i := range expr.Case.Conditions {
+			expr.Case.Conditions[i].variablesUsed(acc)
+			expr.Case.Values[i].variablesUsed(acc)
+		}
+		expr.Case.Else.variablesUsed(acc)
+		return
 	}
 
 	panic("unexhaustive expression type match")
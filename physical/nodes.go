@@ -15,18 +15,25 @@ type Node struct {
 
 	NodeType NodeType
 	// Only one of the below may be non-null.
-	Datasource          *Datasource
-	Distinct            *Distinct
-	Filter              *Filter
-	GroupBy             *GroupBy
-	LookupJoin          *LookupJoin
-	StreamJoin          *StreamJoin
-	Map                 *Map
-	OrderBy             *OrderBy
-	Requalifier         *Requalifier
-	TableValuedFunction *TableValuedFunction
-	Unnest              *Unnest
-	Limit               *Limit
+	Datasource            *Datasource
+	Distinct              *Distinct
+	Filter                *Filter
+	GroupBy               *GroupBy
+	LookupJoin            *LookupJoin
+	StreamJoin            *StreamJoin
+	Map                   *Map
+	OrderBy               *OrderBy
+	Requalifier           *Requalifier
+	TableValuedFunction   *TableValuedFunction
+	Unnest                *Unnest
+	Limit                 *Limit
+	UnionAll              *UnionAll
+	Window                *Window
+	GroupingSets          *GroupingSets
+	RecursiveCTE          *RecursiveCTE
+	RecursiveCTEReference *RecursiveCTEReference
+	SetOperation          *SetOperation
+	Values                *Values
 }
 
 type Schema struct {
@@ -75,6 +82,13 @@ const (
 	NodeTypeTableValuedFunction
 	NodeTypeUnnest
 	NodeTypeLimit
+	NodeTypeUnionAll
+	NodeTypeWindow
+	NodeTypeGroupingSets
+	NodeTypeRecursiveCTE
+	NodeTypeRecursiveCTEReference
+	NodeTypeSetOperation
+	NodeTypeValues
 )
 
 func (t NodeType) String() string {
@@ -103,6 +117,20 @@ func (t NodeType) String() string {
 		return "unnest"
 	case NodeTypeLimit:
 		return "limit"
+	case NodeTypeUnionAll:
+		return "union_all"
+	case NodeTypeWindow:
+		return "window"
+	case NodeTypeGroupingSets:
+		return "grouping_sets"
+	case NodeTypeRecursiveCTE:
+		return "recursive_cte"
+	case NodeTypeRecursiveCTEReference:
+		return "recursive_cte_reference"
+	case NodeTypeSetOperation:
+		return "set_operation"
+	case NodeTypeValues:
+		return "values"
 	}
 	return "unknown"
 }
@@ -168,7 +196,9 @@ type GroupBy struct {
 	Source               Node
 	Aggregates           []Aggregate
 	AggregateExpressions []Expression
-	Key                  []Expression
+	// AggregateFilters contains the FILTER predicate of each aggregate, or nil if it has none.
+	AggregateFilters []*Expression
+	Key              []Expression
 	// Either index to Key, or -1, if none.
 	KeyEventTimeIndex int
 	Trigger           Trigger
@@ -183,10 +213,151 @@ type Aggregate struct {
 type StreamJoin struct {
 	Left, Right       Node
 	LeftKey, RightKey []Expression
+	JoinType          StreamJoinType
+	// Conditions apart from the key a pair of records has to satisfy to match.
+	// Only used by outer, semi and anti joins, inner joins keep those in a filter above the join.
+	Predicates []Expression
+}
+
+type StreamJoinType int
+
+const (
+	StreamJoinTypeInner StreamJoinType = iota
+	StreamJoinTypeLeftOuter
+	StreamJoinTypeRightOuter
+	StreamJoinTypeFullOuter
+	// Semi and anti joins only output the left side.
+	StreamJoinTypeLeftSemi
+	StreamJoinTypeLeftAnti
+)
+
+func (t StreamJoinType) String() string {
+	switch t {
+	case StreamJoinTypeInner:
+		return "inner"
+	case StreamJoinTypeLeftOuter:
+		return "left_outer"
+	case StreamJoinTypeRightOuter:
+		return "right_outer"
+	case StreamJoinTypeFullOuter:
+		return "full_outer"
+	case StreamJoinTypeLeftSemi:
+		return "left_semi"
+	case StreamJoinTypeLeftAnti:
+		return "left_anti"
+	}
+	return "unknown"
 }
 
 type LookupJoin struct {
 	Source, Joined Node
+	// Outer lookup joins emit the source record padded with nulls if there are no joined records for it.
+	Outer bool
+}
+
+type Window struct {
+	Source               Node
+	PartitionBy          []Expression
+	OrderBy              []Expression
+	DirectionMultipliers []int
+	Function             string
+	Arguments            []Expression
+	// Aggregate is only set for aggregate window functions.
+	Aggregate         *Aggregate
+	Frame             WindowFrame
+	KeyEventTimeIndex int
+	Trigger           Trigger
+}
+
+// GroupingSets emits each source record once for each grouping set.
+// The source values are followed by the Key values, which are null if not part of the set, and the index of the set.
+type GroupingSets struct {
+	Source Node
+	Key    []Expression
+	// Sets contains the indices of the Key expressions which are part of each set.
+	Sets [][]int
+}
+
+// RecursiveCTE runs the Recursive node on the records produced by the previous iteration until no new records are produced.
+// The Recursive node reads those records using a RecursiveCTEReference with the same Name.
+type RecursiveCTE struct {
+	Name      string
+	Anchor    Node
+	Recursive Node
+	Distinct  bool
+}
+
+type RecursiveCTEReference struct {
+	Name string
+}
+
+type WindowFrame struct {
+	Rows       bool
+	Start, End WindowFrameBound
+}
+
+func (frame WindowFrame) String() string {
+	unit := "range"
+	if frame.Rows {
+		unit = "rows"
+	}
+	return fmt.Sprintf("%s between %s and %s", unit, frame.Start.String(), frame.End.String())
+}
+
+type WindowFrameBound struct {
+	Type   WindowFrameBoundType
+	Offset int
+}
+
+func (bound WindowFrameBound) String() string {
+	switch bound.Type {
+	case WindowFrameBoundTypePreceding, WindowFrameBoundTypeFollowing:
+		return fmt.Sprintf("%d %s", bound.Offset, bound.Type.String())
+	}
+	return bound.Type.String()
+}
+
+type WindowFrameBoundType int
+
+const (
+	WindowFrameBoundTypeUnboundedPreceding WindowFrameBoundType = iota
+	WindowFrameBoundTypePreceding
+	WindowFrameBoundTypeCurrentRow
+	WindowFrameBoundTypeFollowing
+	WindowFrameBoundTypeUnboundedFollowing
+)
+
+func (t WindowFrameBoundType) String() string {
+	switch t {
+	case WindowFrameBoundTypeUnboundedPreceding:
+		return "unbounded preceding"
+	case WindowFrameBoundTypePreceding:
+		return "preceding"
+	case WindowFrameBoundTypeCurrentRow:
+		return "current row"
+	case WindowFrameBoundTypeFollowing:
+		return "following"
+	case WindowFrameBoundTypeUnboundedFollowing:
+		return "unbounded following"
+	}
+	return "unknown"
+}
+
+func (t WindowFrameBoundType) Materialize() nodes.WindowFrameBoundType {
+	switch t {
+	case WindowFrameBoundTypeUnboundedPreceding:
+		return nodes.WindowFrameBoundTypeUnboundedPreceding
+	case WindowFrameBoundTypePreceding:
+		return nodes.WindowFrameBoundTypePreceding
+	case WindowFrameBoundTypeCurrentRow:
+		return nodes.WindowFrameBoundTypeCurrentRow
+	case WindowFrameBoundTypeFollowing:
+		return nodes.WindowFrameBoundTypeFollowing
+	case WindowFrameBoundTypeUnboundedFollowing:
+		return nodes.WindowFrameBoundTypeUnboundedFollowing
+	}
+
+	panic("unexhaustive window frame bound type match")
 }
 
 type Map struct {
@@ -198,6 +369,8 @@ type OrderBy struct {
 	Source               Node
 	Key                  []Expression
 	DirectionMultipliers []int
+	// Limit is optional. If set, only the first Limit records are kept, so it's only valid on sources with no retractions.
+	Limit *Expression
 }
 
 type Requalifier struct {
@@ -260,9 +433,26 @@ type Unnest struct {
 	Field  string
 }
 
+// Limit and Offset are both optional, but at least one of them is set.
 type Limit struct {
 	Source Node
-	Limit  Expression
+	Limit  *Expression
+	Offset *Expression
+}
+
+type UnionAll struct {
+	First, Second Node
+}
+
+type Values struct {
+	Rows [][]Expression
+}
+
+// SetOperation is an INTERSECT, or an EXCEPT if Except is set.
+type SetOperation struct {
+	First, Second Node
+	Except        bool
+	All           bool
 }
 
 func (node *Node) Materialize(ctx context.Context, env Environment) (execution.Node, error) {
@@ -314,8 +504,10 @@ func (node *Node) Materialize(ctx context.Context, env Environment) (execution.N
 			key[i] = expr
 		}
 		aggregates := make([]func() nodes.Aggregate, len(node.GroupBy.Aggregates))
+		aggregatesHaveEmptyValue := make([]bool, len(node.GroupBy.Aggregates))
 		for i := range node.GroupBy.Aggregates {
 			aggregates[i] = node.GroupBy.Aggregates[i].AggregateDescriptor.Prototype
+			aggregatesHaveEmptyValue[i] = node.GroupBy.Aggregates[i].AggregateDescriptor.HasEmptyValue
 		}
 		expressions := make([]execution.Expression, len(node.GroupBy.AggregateExpressions))
 		for i := range node.GroupBy.AggregateExpressions {
@@ -325,12 +517,23 @@ func (node *Node) Materialize(ctx context.Context, env Environment) (execution.N
 			}
 			expressions[i] = expr
 		}
+		filters := make([]execution.Expression, len(node.GroupBy.AggregateFilters))
+		for i := range node.GroupBy.AggregateFilters {
+			if node.GroupBy.AggregateFilters[i] == nil {
+				continue
+			}
+			expr, err := node.GroupBy.AggregateFilters[i].Materialize(ctx, env.WithRecordSchema(node.GroupBy.Source.Schema))
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize group by aggregate filter with index %d: %w", i, err)
+			}
+			filters[i] = expr
+		}
 		if node.GroupBy.Trigger.TriggerType == TriggerTypeEndOfStream {
-			return nodes.NewSimpleGroupBy(aggregates, expressions, key, source), nil
+			return nodes.NewSimpleGroupBy(aggregates, aggregatesHaveEmptyValue, expressions, filters, key, source), nil
 		}
 		trigger := node.GroupBy.Trigger.Materialize(ctx, env)
 
-		return nodes.NewCustomTriggerGroupBy(aggregates, expressions, key, node.GroupBy.KeyEventTimeIndex, source, trigger), nil
+		return nodes.NewCustomTriggerGroupBy(aggregates, aggregatesHaveEmptyValue, expressions, filters, key, node.GroupBy.KeyEventTimeIndex, source, trigger), nil
 	case NodeTypeStreamJoin:
 		left, err := node.StreamJoin.Left.Materialize(ctx, env)
 		if err != nil {
@@ -358,7 +561,33 @@ func (node *Node) Materialize(ctx context.Context, env Environment) (execution.N
 			rightKeyExprs[i] = expr
 		}
 
-		return nodes.NewStreamJoin(left, right, leftKeyExprs, rightKeyExprs), nil
+		if node.StreamJoin.JoinType == StreamJoinTypeInner {
+			return nodes.NewStreamJoin(left, right, leftKeyExprs, rightKeyExprs), nil
+		}
+
+		var predicate execution.Expression
+		if len(node.StreamJoin.Predicates) > 0 {
+			predicateEnv := env.WithRecordSchema(NewSchema(
+				append(node.StreamJoin.Left.Schema.Fields[:len(node.StreamJoin.Left.Schema.Fields):len(node.StreamJoin.Left.Schema.Fields)], node.StreamJoin.Right.Schema.Fields...),
+				-1,
+			))
+			predicates := make([]execution.Expression, len(node.StreamJoin.Predicates))
+			for i := range node.StreamJoin.Predicates {
+				expr, err := node.StreamJoin.Predicates[i].Materialize(ctx, predicateEnv)
+				if err != nil {
+					return nil, fmt.Errorf("couldn't materialize stream join predicate with index %d: %w", i, err)
+				}
+				predicates[i] = expr
+			}
+			predicate = execution.NewAnd(predicates)
+		}
+		if node.StreamJoin.JoinType == StreamJoinTypeLeftSemi || node.StreamJoin.JoinType == StreamJoinTypeLeftAnti {
+			return nodes.NewSemiStreamJoin(left, right, leftKeyExprs, rightKeyExprs, predicate, node.StreamJoin.JoinType == StreamJoinTypeLeftAnti), nil
+		}
+		leftOuter := node.StreamJoin.JoinType == StreamJoinTypeLeftOuter || node.StreamJoin.JoinType == StreamJoinTypeFullOuter
+		rightOuter := node.StreamJoin.JoinType == StreamJoinTypeRightOuter || node.StreamJoin.JoinType == StreamJoinTypeFullOuter
+
+		return nodes.NewOuterStreamJoin(left, right, leftKeyExprs, rightKeyExprs, predicate, leftOuter, rightOuter, len(node.StreamJoin.Left.Schema.Fields), len(node.StreamJoin.Right.Schema.Fields)), nil
 	case NodeTypeLookupJoin:
 		source, err := node.LookupJoin.Source.Materialize(ctx, env)
 		if err != nil {
@@ -369,6 +598,9 @@ func (node *Node) Materialize(ctx context.Context, env Environment) (execution.N
 			return nil, fmt.Errorf("couldn't materialize right join source: %w", err)
 		}
 
+		if node.LookupJoin.Outer {
+			return nodes.NewOuterLookupJoin(source, joined, len(node.LookupJoin.Joined.Schema.Fields)), nil
+		}
 		return nodes.NewLookupJoin(source, joined), nil
 	case NodeTypeMap:
 		source, err := node.Map.Source.Materialize(ctx, env)
@@ -398,7 +630,13 @@ func (node *Node) Materialize(ctx context.Context, env Environment) (execution.N
 			}
 			keyExprs[i] = expr
 		}
-		return nodes.NewBatchOrderBy(source, keyExprs, node.OrderBy.DirectionMultipliers), nil
+		var limit execution.Expression
+		if node.OrderBy.Limit != nil {
+			if limit, err = node.OrderBy.Limit.Materialize(ctx, env); err != nil {
+				return nil, fmt.Errorf("couldn't materialize order by limit expression: %w", err)
+			}
+		}
+		return nodes.NewBatchOrderBy(source, keyExprs, node.OrderBy.DirectionMultipliers, limit), nil
 	case NodeTypeRequalifier:
 		return node.Requalifier.Source.Materialize(ctx, env)
 
@@ -430,11 +668,155 @@ func (node *Node) Materialize(ctx context.Context, env Environment) (execution.N
 		if err != nil {
 			return nil, fmt.Errorf("couldn't materialize limit source: %w", err)
 		}
-		limit, err := node.Limit.Limit.Materialize(ctx, env)
+		var limit, offset execution.Expression
+		if node.Limit.Limit != nil {
+			if limit, err = node.Limit.Limit.Materialize(ctx, env); err != nil {
+				return nil, fmt.Errorf("couldn't materialize limit expression: %w", err)
+			}
+		}
+		if node.Limit.Offset != nil {
+			if offset, err = node.Limit.Offset.Materialize(ctx, env); err != nil {
+				return nil, fmt.Errorf("couldn't materialize offset expression: %w", err)
+			}
+		}
+		return nodes.NewLimit(source, limit, offset), nil
+
+	case NodeTypeUnionAll:
+		first, err := node.UnionAll.First.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize first union source: %w", err)
+		}
+		second, err := node.UnionAll.Second.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize second union source: %w", err)
+		}
+		return nodes.NewUnionAll(first, second), nil
+	case NodeTypeSetOperation:
+		first, err := node.SetOperation.First.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize first set operation source: %w", err)
+		}
+		second, err := node.SetOperation.Second.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize second set operation source: %w", err)
+		}
+		if node.SetOperation.Except {
+			return nodes.NewExcept(first, second, node.SetOperation.All), nil
+		}
+		return nodes.NewIntersect(first, second, node.SetOperation.All), nil
+	case NodeTypeValues:
+		rows := make([][]execution.Expression, len(node.Values.Rows))
+		for i := range node.Values.Rows {
+			rows[i] = make([]execution.Expression, len(node.Values.Rows[i]))
+			for j := range node.Values.Rows[i] {
+				expr, err := node.Values.Rows[i][j].Materialize(ctx, env)
+				if err != nil {
+					return nil, fmt.Errorf("couldn't materialize value with index %d of row %d: %w", j, i, err)
+				}
+				rows[i][j] = expr
+			}
+		}
+		return nodes.NewValues(rows), nil
+	case NodeTypeWindow:
+		source, err := node.Window.Source.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize window source: %w", err)
+		}
+		partitionBy := make([]execution.Expression, len(node.Window.PartitionBy))
+		for i := range node.Window.PartitionBy {
+			expr, err := node.Window.PartitionBy[i].Materialize(ctx, env.WithRecordSchema(node.Window.Source.Schema))
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize window partition by expression with index %d: %w", i, err)
+			}
+			partitionBy[i] = expr
+		}
+		orderBy := make([]execution.Expression, len(node.Window.OrderBy))
+		for i := range node.Window.OrderBy {
+			expr, err := node.Window.OrderBy[i].Materialize(ctx, env.WithRecordSchema(node.Window.Source.Schema))
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize window order by expression with index %d: %w", i, err)
+			}
+			orderBy[i] = expr
+		}
+		arguments := make([]execution.Expression, len(node.Window.Arguments))
+		for i := range node.Window.Arguments {
+			expr, err := node.Window.Arguments[i].Materialize(ctx, env.WithRecordSchema(node.Window.Source.Schema))
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize window function argument with index %d: %w", i, err)
+			}
+			arguments[i] = expr
+		}
+		function := node.Window.Function
+		var aggregatePrototype func() nodes.Aggregate
+		if node.Window.Aggregate != nil {
+			function = "aggregate"
+			aggregatePrototype = node.Window.Aggregate.AggregateDescriptor.Prototype
+		}
+		frame := nodes.WindowFrame{
+			Rows: node.Window.Frame.Rows,
+			Start: nodes.WindowFrameBound{
+				Type:   node.Window.Frame.Start.Type.Materialize(),
+				Offset: node.Window.Frame.Start.Offset,
+			},
+			End: nodes.WindowFrameBound{
+				Type:   node.Window.Frame.End.Type.Materialize(),
+				Offset: node.Window.Frame.End.Offset,
+			},
+		}
+		trigger := node.Window.Trigger.Materialize(ctx, env)
+
+		return nodes.NewWindow(source, partitionBy, orderBy, node.Window.DirectionMultipliers, arguments, function, aggregatePrototype, frame, node.Window.KeyEventTimeIndex, trigger), nil
+
+	case NodeTypeGroupingSets:
+		source, err := node.GroupingSets.Source.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize grouping sets source: %w", err)
+		}
+
+		key := make([]execution.Expression, len(node.GroupingSets.Key))
+		for i := range node.GroupingSets.Key {
+			expr, err := node.GroupingSets.Key[i].Materialize(ctx, env.WithRecordSchema(node.GroupingSets.Source.Schema))
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize grouping sets key expression with index %d: %w", i, err)
+			}
+			key[i] = expr
+		}
+
+		return nodes.NewGroupingSets(source, key, node.GroupingSets.Sets), nil
+
+	case NodeTypeRecursiveCTE:
+		anchor, err := node.RecursiveCTE.Anchor.Materialize(ctx, env)
 		if err != nil {
-			return nil, fmt.Errorf("couldn't materialize limit expression: %w", err)
+			return nil, fmt.Errorf("couldn't materialize recursive common table expression anchor: %w", err)
 		}
-		return nodes.NewLimit(source, limit), nil
+
+		table := nodes.NewRecursiveCTEWorkingTable()
+		recursiveEnv := env
+		recursiveEnv.RecursiveCTEWorkingTables = make(map[string]*nodes.RecursiveCTEWorkingTable)
+		for k, v := range env.RecursiveCTEWorkingTables {
+			recursiveEnv.RecursiveCTEWorkingTables[k] = v
+		}
+		recursiveEnv.RecursiveCTEWorkingTables[node.RecursiveCTE.Name] = table
+
+		recursive, err := node.RecursiveCTE.Recursive.Materialize(ctx, recursiveEnv)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize recursive common table expression recursive part: %w", err)
+		}
+
+		maxDepth := DefaultMaxRecursionDepth
+		if configured, ok := env.PhysicalConfig["max_recursion_depth"].(int); ok {
+			maxDepth = configured
+		}
+
+		return nodes.NewRecursiveCTE(anchor, recursive, table, node.RecursiveCTE.Distinct, maxDepth), nil
+
+	case NodeTypeRecursiveCTEReference:
+		table, ok := env.RecursiveCTEWorkingTables[node.RecursiveCTEReference.Name]
+		if !ok {
+			panic(fmt.Sprintf("recursive common table expression '%s' not found, should be caught in typecheck", node.RecursiveCTEReference.Name))
+		}
+
+		return nodes.NewRecursiveCTEReference(table), nil
 	}
 
 	panic(fmt.Sprintf("unexhaustive node type match: %d", node.NodeType))
@@ -17,8 +17,13 @@ type Environment struct {
 	Functions       map[string]FunctionDetails
 	PhysicalConfig  map[string]interface{}
 	VariableContext *VariableContext
+	// RecursiveCTEWorkingTables is used to pass the working tables of recursive common table expressions to their references.
+	RecursiveCTEWorkingTables map[string]*nodes.RecursiveCTEWorkingTable
 }
 
+// DefaultMaxRecursionDepth is used if the max_recursion_depth physical config value isn't set.
+const DefaultMaxRecursionDepth = 1000
+
 func (env Environment) WithRecordSchema(schema Schema) Environment {
 	newEnv := env
 	newEnv.VariableContext = newEnv.VariableContext.WithRecordSchema(schema)
@@ -47,6 +52,9 @@ type AggregateDescriptor struct {
 	OutputType   octosql.Type
 	TypeFn       func(octosql.Type) (octosql.Type, bool)
 	Prototype    func() nodes.Aggregate
+	// HasEmptyValue aggregates, like count, are triggered even if no values were aggregated, e.g. because of a FILTER.
+	// Others output NULL then.
+	HasEmptyValue bool
 }
 
 type DatasourceRepository struct {
@@ -101,4 +109,10 @@ type FunctionDescriptor struct {
 	TypeFn        func([]octosql.Type) (octosql.Type, bool) `json:"-"`
 	Strict        bool
 	Function      func([]octosql.Value) (octosql.Value, error) `json:"-"`
+	// TypecheckFn is used by functions whose output type or implementation depend on more than the argument types,
+	// e.g. on the value of a constant argument. It returns the output type and the implementation.
+	TypecheckFn func([]Expression) (octosql.Type, func([]octosql.Value) (octosql.Value, error), bool) `json:"-"`
+	// NonDeterministic functions may return different values for the same arguments, e.g. uuid().
+	// The optimizer mustn't move calls to them anywhere they could be evaluated a different number of times.
+	NonDeterministic bool
 }
@@ -60,6 +60,13 @@ func (t *Transformers) TransformNode(node Node) Node {
 		for i := range node.GroupBy.AggregateExpressions {
 			aggregateExpressions[i] = t.TransformExpr(node.GroupBy.AggregateExpressions[i])
 		}
+		aggregateFilters := make([]*Expression, len(node.GroupBy.AggregateFilters))
+		for i := range node.GroupBy.AggregateFilters {
+			if node.GroupBy.AggregateFilters[i] != nil {
+				filter := t.TransformExpr(*node.GroupBy.AggregateFilters[i])
+				aggregateFilters[i] = &filter
+			}
+		}
 		key := make([]Expression, len(node.GroupBy.Key))
 		for i := range node.GroupBy.Key {
 			key[i] = t.TransformExpr(node.GroupBy.Key[i])
@@ -72,6 +79,7 @@ func (t *Transformers) TransformNode(node Node) Node {
 				Source:               t.TransformNode(node.GroupBy.Source),
 				Aggregates:           aggregates,
 				AggregateExpressions: aggregateExpressions,
+				AggregateFilters:     aggregateFilters,
 				Key:                  key,
 				KeyEventTimeIndex:    node.GroupBy.KeyEventTimeIndex,
 				Trigger:              node.GroupBy.Trigger,
@@ -87,14 +95,21 @@ func (t *Transformers) TransformNode(node Node) Node {
 			rightKey[i] = t.TransformExpr(node.StreamJoin.RightKey[i])
 		}
 
+		predicates := make([]Expression, len(node.StreamJoin.Predicates))
+		for i := range node.StreamJoin.Predicates {
+			predicates[i] = t.TransformExpr(node.StreamJoin.Predicates[i])
+		}
+
 		out = Node{
 			Schema:   schema,
 			NodeType: node.NodeType,
 			StreamJoin: &StreamJoin{
-				Left:     t.TransformNode(node.StreamJoin.Left),
-				Right:    t.TransformNode(node.StreamJoin.Right),
-				LeftKey:  leftKey,
-				RightKey: rightKey,
+				Left:       t.TransformNode(node.StreamJoin.Left),
+				Right:      t.TransformNode(node.StreamJoin.Right),
+				LeftKey:    leftKey,
+				RightKey:   rightKey,
+				JoinType:   node.StreamJoin.JoinType,
+				Predicates: predicates,
 			},
 		}
 	case NodeTypeLookupJoin:
@@ -104,6 +119,7 @@ func (t *Transformers) TransformNode(node Node) Node {
 			LookupJoin: &LookupJoin{
 				Source: t.TransformNode(node.LookupJoin.Source),
 				Joined: t.TransformNode(node.LookupJoin.Joined),
+				Outer:  node.LookupJoin.Outer,
 			},
 		}
 	case NodeTypeMap:
@@ -127,6 +143,11 @@ func (t *Transformers) TransformNode(node Node) Node {
 		}
 		directionMultipliers := make([]int, len(node.OrderBy.DirectionMultipliers))
 		copy(directionMultipliers, node.OrderBy.DirectionMultipliers)
+		var limit *Expression
+		if node.OrderBy.Limit != nil {
+			expr := t.TransformExpr(*node.OrderBy.Limit)
+			limit = &expr
+		}
 
 		out = Node{
 			Schema:   schema,
@@ -135,6 +156,7 @@ func (t *Transformers) TransformNode(node Node) Node {
 				Source:               t.TransformNode(node.OrderBy.Source),
 				Key:                  keyExprs,
 				DirectionMultipliers: directionMultipliers,
+				Limit:                limit,
 			},
 		}
 	case NodeTypeRequalifier:
@@ -195,14 +217,121 @@ func (t *Transformers) TransformNode(node Node) Node {
 			},
 		}
 	case NodeTypeLimit:
+		var limit, offset *Expression
+		if node.Limit.Limit != nil {
+			expr := t.TransformExpr(*node.Limit.Limit)
+			limit = &expr
+		}
+		if node.Limit.Offset != nil {
+			expr := t.TransformExpr(*node.Limit.Offset)
+			offset = &expr
+		}
 		out = Node{
 			Schema:   schema,
 			NodeType: node.NodeType,
 			Limit: &Limit{
 				Source: t.TransformNode(node.Limit.Source),
-				Limit:  node.Limit.Limit,
+				Limit:  limit,
+				Offset: offset,
+			},
+		}
+	case NodeTypeUnionAll:
+		out = Node{
+			Schema:   schema,
+			NodeType: node.NodeType,
+			UnionAll: &UnionAll{
+				First:  t.TransformNode(node.UnionAll.First),
+				Second: t.TransformNode(node.UnionAll.Second),
+			},
+		}
+	case NodeTypeValues:
+		rows := make([][]Expression, len(node.Values.Rows))
+		for i := range node.Values.Rows {
+			rows[i] = make([]Expression, len(node.Values.Rows[i]))
+			for j := range node.Values.Rows[i] {
+				rows[i][j] = t.TransformExpr(node.Values.Rows[i][j])
+			}
+		}
+		out = Node{
+			Schema:   schema,
+			NodeType: node.NodeType,
+			Values: &Values{
+				Rows: rows,
+			},
+		}
+	case NodeTypeSetOperation:
+		out = Node{
+			Schema:   schema,
+			NodeType: node.NodeType,
+			SetOperation: &SetOperation{
+				First:  t.TransformNode(node.SetOperation.First),
+				Second: t.TransformNode(node.SetOperation.Second),
+				Except: node.SetOperation.Except,
+				All:    node.SetOperation.All,
 			},
 		}
+	case NodeTypeWindow:
+		partitionBy := make([]Expression, len(node.Window.PartitionBy))
+		for i := range node.Window.PartitionBy {
+			partitionBy[i] = t.TransformExpr(node.Window.PartitionBy[i])
+		}
+		orderBy := make([]Expression, len(node.Window.OrderBy))
+		for i := range node.Window.OrderBy {
+			orderBy[i] = t.TransformExpr(node.Window.OrderBy[i])
+		}
+		arguments := make([]Expression, len(node.Window.Arguments))
+		for i := range node.Window.Arguments {
+			arguments[i] = t.TransformExpr(node.Window.Arguments[i])
+		}
+
+		out = Node{
+			Schema:   schema,
+			NodeType: node.NodeType,
+			Window: &Window{
+				Source:               t.TransformNode(node.Window.Source),
+				PartitionBy:          partitionBy,
+				OrderBy:              orderBy,
+				DirectionMultipliers: node.Window.DirectionMultipliers,
+				Function:             node.Window.Function,
+				Arguments:            arguments,
+				Aggregate:            node.Window.Aggregate,
+				Frame:                node.Window.Frame,
+				KeyEventTimeIndex:    node.Window.KeyEventTimeIndex,
+				Trigger:              node.Window.Trigger,
+			},
+		}
+	case NodeTypeGroupingSets:
+		key := make([]Expression, len(node.GroupingSets.Key))
+		for i := range node.GroupingSets.Key {
+			key[i] = t.TransformExpr(node.GroupingSets.Key[i])
+		}
+
+		out = Node{
+			Schema:   schema,
+			NodeType: node.NodeType,
+			GroupingSets: &GroupingSets{
+				Source: t.TransformNode(node.GroupingSets.Source),
+				Key:    key,
+				Sets:   node.GroupingSets.Sets,
+			},
+		}
+	case NodeTypeRecursiveCTE:
+		out = Node{
+			Schema:   schema,
+			NodeType: node.NodeType,
+			RecursiveCTE: &RecursiveCTE{
+				Name:      node.RecursiveCTE.Name,
+				Anchor:    t.TransformNode(node.RecursiveCTE.Anchor),
+				Recursive: t.TransformNode(node.RecursiveCTE.Recursive),
+				Distinct:  node.RecursiveCTE.Distinct,
+			},
+		}
+	case NodeTypeRecursiveCTEReference:
+		out = Node{
+			Schema:                schema,
+			NodeType:              node.NodeType,
+			RecursiveCTEReference: &RecursiveCTEReference{Name: node.RecursiveCTEReference.Name},
+		}
 
 	default:
 		panic("unexhaustive node type match")
@@ -337,6 +466,25 @@ func (t *Transformers) TransformExpr(expr Expression) Expression {
 				Field:  expr.ObjectFieldAccess.Field,
 			},
 		}
+	case ExpressionTypeCase:
+		conditions := make([]Expression, len(expr.Case.Conditions))
+		for i := range expr.Case.Conditions {
+			conditions[i] = t.TransformExpr(expr.Case.Conditions[i])
+		}
+		values := make([]Expression, len(expr.Case.Values))
+		for i := range expr.Case.Values {
+			values[i] = t.TransformExpr(expr.Case.Values[i])
+		}
+
+		out = Expression{
+			Type:           expr.Type,
+			ExpressionType: expr.ExpressionType,
+			Case: &Case{
+				Conditions: conditions,
+				Values:     values,
+				Else:       t.TransformExpr(expr.Case.Else),
+			},
+		}
 	default:
 		panic("unexhaustive expression type match")
 	}